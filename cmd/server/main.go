@@ -2,7 +2,7 @@ package main
 
 import (
 	"context"
-	"log"
+	"log/slog"
 	"net"
 	"net/http"
 	"os"
@@ -16,40 +16,76 @@ import (
 	internal_grpc "github.com/DarRo9/pvz_service/internal/grpc"
 	"github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1"
 	handler "github.com/DarRo9/pvz_service/internal/handler"
+	"github.com/DarRo9/pvz_service/internal/health"
+	"github.com/DarRo9/pvz_service/internal/logger"
 	internal_middleware "github.com/DarRo9/pvz_service/internal/middleware"
 	"github.com/DarRo9/pvz_service/internal/repository"
 	"github.com/DarRo9/pvz_service/internal/service"
-	"github.com/go-chi/chi/middleware"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+	"github.com/DarRo9/pvz_service/internal/webhook"
 	"github.com/go-chi/chi/v5"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	grpc_health "google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
 )
 
 func main() {
+	slog.SetDefault(logger.New(os.Stdout, logger.ParseLevel(os.Getenv("LOG_LEVEL"))))
 
-	dbCfg := db.DatabaseConfig{
-		Host:     os.Getenv("DB_HOST"),
-		Port:     os.Getenv("DB_PORT"),
-		User:     os.Getenv("DB_USER"),
-		Password: os.Getenv("DB_PASSWORD"),
-		Name:     os.Getenv("DB_NAME"),
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrate(os.Args[2:]))
 	}
 
-	db, err := db.NewDatabase(&dbCfg)
+	shutdownTracing, err := tracing.Init(context.Background(), os.Getenv(tracing.ExporterEnv))
 	if err != nil {
-		log.Fatalf("Error connecting to the database:", err)
-		return
+		slog.Error("failed to init tracing", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(shutdownCtx); err != nil {
+			slog.Error("Tracing shutdown error", "error", err)
+		}
+	}()
+
+	schemaVersion, err := db.LatestMigrationVersion()
+	if err != nil {
+		slog.Error("failed to read migrations", "error", err)
+		os.Exit(1)
+	}
+
+	db, err := db.NewDatabase(context.Background(), databaseConfigFromEnv())
+	if err != nil {
+		slog.Error("Error connecting to the database", "error", err)
+		os.Exit(1)
 	}
 	defer db.Close()
 
+	if os.Getenv("MIGRATE_ON_START") == "true" {
+		if err := applyMigrations(context.Background(), db); err != nil {
+			slog.Error("failed to apply migrations", "error", err)
+			os.Exit(1)
+		}
+	}
+
 	config, err := config.LoadConfig(
 		"config/config.yaml",
 	)
 	if err != nil {
-		log.Fatalf("failed to load config: %v", err)
+		slog.Error("failed to load config", "error", err)
+		os.Exit(1)
 	}
 	repo := repository.NewPostgresRepository(db)
 	service := service.NewService(repo, config)
+	if err := service.SeedDictionaries(context.Background()); err != nil {
+		slog.Error("failed to seed dictionaries", "error", err)
+		os.Exit(1)
+	}
+	dispatcher := webhook.NewDispatcher(repo, config.Webhooks)
+	checker := health.NewChecker(repo, int64(schemaVersion), config.Health)
 	httpHandler := handler.NewHTTPHandler(service)
 	grpcHandler := internal_grpc.NewGRPCHandler(service)
 
@@ -65,42 +101,69 @@ func main() {
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		startHTTPServer(ctx, httpHandler)
+		startHTTPServer(ctx, httpHandler, service)
 	}()
 
 	// Запускаем gRPC сервер
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		startGRPCServer(ctx, grpcHandler)
+		startGRPCServer(ctx, grpcHandler, service, checker)
 	}()
 
 	// Запускаем Metrics сервер
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		startMetricsServer(ctx)
+		startMetricsServer(ctx, checker)
+	}()
+
+	// Запускаем очистку истекших ключей идемпотентности
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		service.RunIdempotencyCleanup(ctx)
 	}()
 
-	log.Println("Servers started")
+	// Запускаем очистку отозванных токенов с истекшим сроком действия
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		service.RunTokenCleanup(ctx)
+	}()
+
+	// Запускаем доставку вебхуков
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		dispatcher.Run(ctx)
+	}()
+
+	slog.Info("Servers started")
 
 	<-done
-	log.Println("Servers stopping...")
+	slog.Info("Servers stopping...")
 
 	cancel()
 
 	wg.Wait()
-	log.Println("Servers stopped")
+	slog.Info("Servers stopped")
 }
 
-func startMetricsServer(ctx context.Context) {
+func startMetricsServer(ctx context.Context, checker *health.Checker) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.HandleFunc("/healthz", health.LivenessHandler)
+	mux.HandleFunc("/readyz", checker.ReadinessHandler)
+
 	srv := &http.Server{
 		Addr:    ":9000",
-		Handler: promhttp.Handler(),
+		Handler: mux,
 	}
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Metrics server error: %s\n", err)
+			slog.Error("Metrics server error", "error", err)
+			os.Exit(1)
 		}
 	}()
 
@@ -110,13 +173,13 @@ func startMetricsServer(ctx context.Context) {
 	defer cancel()
 
 	if err := srv.Shutdown(shutdownCtx); err != nil {
-		log.Printf("Metrics server shutdown error: %+v\n", err)
+		slog.Error("Metrics server shutdown error", "error", err)
 	} else {
-		log.Println("Metrics server stopped gracefully")
+		slog.Info("Metrics server stopped gracefully")
 	}
 }
 
-func startHTTPServer(ctx context.Context, h *handler.HTTPHandler) {
+func startHTTPServer(ctx context.Context, h *handler.HTTPHandler, service service.ServiceInterface) {
 	r := chi.NewRouter()
 
 	wrapper := handler.ServerInterfaceWrapper{
@@ -127,20 +190,61 @@ func startHTTPServer(ctx context.Context, h *handler.HTTPHandler) {
 		},
 	}
 
-	r.Use(middleware.Logger)
+	r.Use(internal_middleware.RequestIDMiddleware)
+	r.Use(internal_middleware.TracingMiddleware)
+	r.Use(internal_middleware.LoggingMiddleware)
 	r.Use(internal_middleware.PrometheusMiddleware)
 	r.Post("/dummyLogin", wrapper.PostDummyLogin)
 	r.Post("/login", wrapper.PostLogin)
 	r.Post("/register", wrapper.PostRegister)
+	r.Post("/token", wrapper.PostToken)
+	r.Post("/token/refresh", wrapper.PostTokenRefresh)
 
 	r.Route("/", func(r chi.Router) {
-		r.Use(internal_middleware.AuthMiddleware)
-		r.Post("/products", wrapper.PostProducts)
+		r.Use(internal_middleware.AuthMiddleware(service))
+		idempotent := r.With(internal_middleware.IdempotencyMiddleware(service))
+		r.Get("/audit", wrapper.GetAudit)
+		r.Get("/cities", wrapper.GetCities)
+		r.Post("/cities", wrapper.PostCities)
+		r.Delete("/cities/{name}", wrapper.DeleteCitiesName)
+		r.Get("/export/receptions.csv", wrapper.GetExportReceptionsCsv)
+		r.Get("/export/receptions.xlsx", wrapper.GetExportReceptionsXlsx)
+		r.Post("/logout", wrapper.PostLogout)
+		r.Get("/product_types", wrapper.GetProductTypes)
+		r.Post("/product_types", wrapper.PostProductTypes)
+		r.Delete("/product_types/{name}", wrapper.DeleteProductTypesName)
+		r.Get("/products", wrapper.GetProducts)
+		idempotent.Post("/products", wrapper.PostProducts)
+		idempotent.Post("/products/batch", wrapper.PostProductsBatch)
+		r.Get("/products/{productId}/history", wrapper.GetProductsProductIdHistory)
+		r.Post("/products/{productId}/issue", wrapper.PostProductsProductIdIssue)
+		r.Post("/products/{productId}/return", wrapper.PostProductsProductIdReturn)
 		r.Get("/pvz", wrapper.GetPvz)
-		r.Post("/pvz", wrapper.PostPvz)
-		r.Post("/pvz/{pvzId}/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
-		r.Post("/pvz/{pvzId}/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
-		r.Post("/receptions", wrapper.PostReceptions)
+		idempotent.Post("/pvz", wrapper.PostPvz)
+		r.Get("/pvz/nearby", wrapper.GetPvzNearby)
+		r.Get("/pvz/{pvzId}", wrapper.GetPvzPvzId)
+		r.Patch("/pvz/{pvzId}", wrapper.PatchPvzPvzId)
+		r.Post("/pvz/{pvzId}/deactivate", wrapper.PostPvzPvzIdDeactivate)
+		idempotent.Post("/pvz/{pvzId}/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+		idempotent.Post("/pvz/{pvzId}/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+		r.Get("/pvz/{pvzId}/employees", wrapper.GetPvzPvzIdEmployees)
+		r.Post("/pvz/{pvzId}/employees", wrapper.PostPvzPvzIdEmployees)
+		r.Delete("/pvz/{pvzId}/employees/{userId}", wrapper.DeletePvzPvzIdEmployeesUserId)
+		r.Get("/pvz/{pvzId}/receptions", wrapper.GetPvzPvzIdReceptions)
+		idempotent.Post("/receptions", wrapper.PostReceptions)
+		r.Get("/receptions/{receptionId}", wrapper.GetReceptionsReceptionId)
+		r.Post("/receptions/{receptionId}/reopen", wrapper.PostReceptionsReceptionIdReopen)
+		r.Get("/receptions/{receptionId}/corrections", wrapper.GetReceptionsReceptionIdCorrections)
+		idempotent.Post("/receptions/{receptionId}/corrections", wrapper.PostReceptionsReceptionIdCorrections)
+		r.Get("/receptions/{receptionId}/manifest", wrapper.GetReceptionsReceptionIdManifest)
+		r.Put("/receptions/{receptionId}/manifest", wrapper.PutReceptionsReceptionIdManifest)
+		r.Get("/receptions/{receptionId}/reconciliation", wrapper.GetReceptionsReceptionIdReconciliation)
+		r.Post("/receptions/{receptionId}/reconciliation/acknowledge", wrapper.PostReceptionsReceptionIdReconciliationAcknowledge)
+		r.Get("/reports/products", wrapper.GetReportsProducts)
+		r.Get("/reports/receptions", wrapper.GetReportsReceptions)
+		r.Get("/webhooks", wrapper.GetWebhooks)
+		r.Post("/webhooks", wrapper.PostWebhooks)
+		r.Delete("/webhooks/{webhookId}", wrapper.DeleteWebhooksWebhookId)
 	})
 
 	srv := &http.Server{
@@ -150,7 +254,8 @@ func startHTTPServer(ctx context.Context, h *handler.HTTPHandler) {
 
 	go func() {
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("HTTP server error: %s\n", err)
+			slog.Error("HTTP server error", "error", err)
+			os.Exit(1)
 		}
 	}()
 
@@ -160,28 +265,45 @@ func startHTTPServer(ctx context.Context, h *handler.HTTPHandler) {
 	defer cancel()
 
 	if err := srv.Shutdown(shutdownCtx); err != nil {
-		log.Printf("HTTP server shutdown error: %+v\n", err)
+		slog.Error("HTTP server shutdown error", "error", err)
 	} else {
-		log.Println("HTTP server stopped gracefully")
+		slog.Info("HTTP server stopped gracefully")
 	}
 }
 
-func startGRPCServer(ctx context.Context, userHandler *internal_grpc.GRPCHandler) {
-	grpcServer := grpc.NewServer()
+func startGRPCServer(ctx context.Context, userHandler *internal_grpc.GRPCHandler, service service.ServiceInterface, checker *health.Checker) {
+	grpcServer := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(
+			internal_grpc.LoggingUnaryInterceptor(),
+			internal_grpc.AuthUnaryInterceptor(service),
+		),
+		grpc.ChainStreamInterceptor(
+			internal_grpc.LoggingStreamInterceptor(),
+			internal_grpc.AuthStreamInterceptor(service),
+		),
+	)
 	pvz_v1.RegisterPVZServiceServer(grpcServer, userHandler)
 
+	healthServer := grpc_health.NewServer()
+	grpc_health_v1.RegisterHealthServer(grpcServer, healthServer)
+	go checker.UpdateGRPCStatus(ctx, healthServer, pvz_v1.PVZService_ServiceDesc.ServiceName)
+
 	lis, err := net.Listen("tcp", ":3000")
 	if err != nil {
-		log.Fatalf("failed to listen: %v", err)
+		slog.Error("failed to listen", "error", err)
+		os.Exit(1)
 	}
 
 	go func() {
 		if err := grpcServer.Serve(lis); err != nil {
-			log.Fatalf("gRPC server error: %s\n", err)
+			slog.Error("gRPC server error", "error", err)
+			os.Exit(1)
 		}
 	}()
 
 	<-ctx.Done()
+	healthServer.Shutdown()
 
 	stopped := make(chan struct{})
 	go func() {
@@ -191,9 +313,9 @@ func startGRPCServer(ctx context.Context, userHandler *internal_grpc.GRPCHandler
 
 	select {
 	case <-stopped:
-		log.Println("gRPC server stopped gracefully")
+		slog.Info("gRPC server stopped gracefully")
 	case <-time.After(5 * time.Second):
 		grpcServer.Stop()
-		log.Println("gRPC server stopped (force)")
+		slog.Info("gRPC server stopped (force)")
 	}
 }
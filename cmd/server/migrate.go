@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/db"
+	"github.com/jmoiron/sqlx"
+)
+
+const migrateUsage = "usage: server migrate up | down [N] | status | version"
+
+func databaseConfigFromEnv() *db.DatabaseConfig {
+	cfg := &db.DatabaseConfig{
+		Host:     os.Getenv("DB_HOST"),
+		Port:     os.Getenv("DB_PORT"),
+		User:     os.Getenv("DB_USER"),
+		Password: os.Getenv("DB_PASSWORD"),
+		Name:     os.Getenv("DB_NAME"),
+	}
+	if timeout, err := time.ParseDuration(os.Getenv("DB_CONNECT_TIMEOUT")); err == nil {
+		cfg.ConnectTimeout = timeout
+	}
+	return cfg
+}
+
+// applyMigrations применяет встроенные миграции при старте сервера (MIGRATE_ON_START=true)
+func applyMigrations(ctx context.Context, database *sqlx.DB) error {
+	migrator, err := db.NewMigrator(ctx, database)
+	if err != nil {
+		return err
+	}
+	defer migrator.Close()
+
+	if err := migrator.Up(); err != nil {
+		return err
+	}
+
+	version, _, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+	slog.InfoContext(ctx, "Migrations applied", "version", version)
+	return nil
+}
+
+// runMigrate выполняет подкоманду migrate и возвращает код завершения процесса
+func runMigrate(args []string) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	ctx := context.Background()
+	database, err := db.NewDatabase(ctx, databaseConfigFromEnv())
+	if err != nil {
+		slog.Error("Error connecting to the database", "error", err)
+		return 1
+	}
+	defer database.Close()
+
+	migrator, err := db.NewMigrator(ctx, database)
+	if err != nil {
+		slog.Error("failed to create migrator", "error", err)
+		return 1
+	}
+	defer migrator.Close()
+
+	switch args[0] {
+	case "up":
+		err = migrator.Up()
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil {
+				fmt.Fprintln(os.Stderr, migrateUsage)
+				return 2
+			}
+		}
+		err = migrator.Down(steps)
+	case "status":
+		err = printMigrationStatus(migrator)
+	case "version":
+		err = printMigrationVersion(migrator)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+	if err != nil {
+		slog.Error("Migration command failed", "command", args[0], "error", err)
+		return 1
+	}
+
+	if args[0] == "up" || args[0] == "down" {
+		if err := printMigrationVersion(migrator); err != nil {
+			slog.Error("Migration command failed", "command", args[0], "error", err)
+			return 1
+		}
+	}
+	return 0
+}
+
+func printMigrationStatus(migrator *db.Migrator) error {
+	statuses, err := migrator.Status()
+	if err != nil {
+		return err
+	}
+	for _, status := range statuses {
+		state := "pending"
+		if status.Applied {
+			state = "applied"
+		}
+		fmt.Printf("%06d %-8s %s\n", status.Version, state, status.Name)
+	}
+	return nil
+}
+
+func printMigrationVersion(migrator *db.Migrator) error {
+	version, dirty, err := migrator.Version()
+	if err != nil {
+		return err
+	}
+	if dirty {
+		fmt.Printf("%d (dirty)\n", version)
+		return nil
+	}
+	fmt.Println(version)
+	return nil
+}
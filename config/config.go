@@ -1,10 +1,47 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"time"
 
+	"github.com/spf13/viper"
+)
+
+// Cities и ProductTypes используются только для начального заполнения справочников в БД
 type Config struct {
-	Cities       []string `mapstructure:"cities"`
-	ProductTypes []string `mapstructure:"product_types"`
+	Cities       []string          `mapstructure:"cities"`
+	ProductTypes []string          `mapstructure:"product_types"`
+	Dictionaries DictionaryConfig  `mapstructure:"dictionaries"`
+	Webhooks     WebhookConfig     `mapstructure:"webhooks"`
+	Health       HealthConfig      `mapstructure:"health"`
+	Idempotency  IdempotencyConfig `mapstructure:"idempotency"`
+	Tokens       TokenConfig       `mapstructure:"tokens"`
+}
+
+type DictionaryConfig struct {
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+type WebhookConfig struct {
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	Timeout      time.Duration `mapstructure:"timeout"`
+	BatchSize    int           `mapstructure:"batch_size"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	BaseBackoff  time.Duration `mapstructure:"base_backoff"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff"`
+}
+
+type HealthConfig struct {
+	CheckTimeout       time.Duration `mapstructure:"check_timeout"`
+	GRPCStatusInterval time.Duration `mapstructure:"grpc_status_interval"`
+}
+
+type IdempotencyConfig struct {
+	TTL             time.Duration `mapstructure:"ttl"`
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+}
+
+type TokenConfig struct {
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
 }
 
 func LoadConfig(path string) (*Config, error) {
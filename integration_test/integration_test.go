@@ -11,7 +11,15 @@ import (
 )
 
 func RequestToken(t *testing.T, role string) string {
-	data := map[string]string{"role": role}
+	return requestDummyToken(t, map[string]string{"role": role})
+}
+
+// RequestEmployeeToken выдает тестовый токен сотрудника, закрепленного за ПВЗ
+func RequestEmployeeToken(t *testing.T, pvzID string) string {
+	return requestDummyToken(t, map[string]string{"role": "employee", "pvzId": pvzID})
+}
+
+func requestDummyToken(t *testing.T, data map[string]string) string {
 	body, _ := json.Marshal(data)
 
 	resp, err := http.Post("http://localhost:8080/dummyLogin", "application/json", bytes.NewReader(body))
@@ -51,7 +59,7 @@ func Test_Integration(t *testing.T) {
 	pvzResp := Post(t, "/pvz", moderatorToken, map[string]string{"city": "Санкт-Петербург"})
 	pvzID := pvzResp["id"].(string)
 
-	employeeToken := RequestToken(t, "employee")
+	employeeToken := RequestEmployeeToken(t, pvzID)
 	fmt.Println(employeeToken)
 
 	recResp := Post(t, "/receptions", employeeToken, map[string]string{"pvzId": pvzID})
@@ -1,10 +1,15 @@
 package db
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"time"
 
+	"github.com/XSAM/otelsql"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
 type DatabaseConfig struct {
@@ -13,20 +18,70 @@ type DatabaseConfig struct {
 	User     string
 	Password string
 	Name     string
+
+	// ConnectTimeout - сколько ждать доступности базы при старте, по умолчанию defaultConnectTimeout
+	ConnectTimeout time.Duration
 }
 
-func NewDatabase(cfg *DatabaseConfig) (*sqlx.DB, error) {
+const (
+	defaultConnectTimeout = 30 * time.Second
+	connectRetryInterval  = time.Second
+)
+
+// NewDatabase открывает пул соединений и ждет, пока база начнет отвечать,
+// чтобы сервис не принимал запросы раньше, чем поднимется Postgres
+func NewDatabase(ctx context.Context, cfg *DatabaseConfig) (*sqlx.DB, error) {
 
-	db, err := sqlx.Open("postgres", fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+	dsn := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
 		cfg.Host,
 		cfg.Port,
 		cfg.User,
 		cfg.Password,
 		cfg.Name,
-	))
+	)
+
+	// Каждый SQL запрос через sqlx попадает в trace отдельным span'ом
+	sqlDB, err := otelsql.Open("postgres", dsn,
+		otelsql.WithAttributes(semconv.DBSystemPostgreSQL),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{
+			OmitConnResetSession: true,
+			OmitRows:             true,
+		}),
+	)
 	if err != nil {
 		return nil, fmt.Errorf("error connecting to the database: %w", err)
 	}
 
+	db := sqlx.NewDb(sqlDB, "postgres")
+	if err := waitForDatabase(ctx, db, cfg.ConnectTimeout); err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	return db, nil
 }
+
+func waitForDatabase(ctx context.Context, db *sqlx.DB, timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = defaultConnectTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(connectRetryInterval)
+	defer ticker.Stop()
+
+	for {
+		err := db.PingContext(ctx)
+		if err == nil {
+			return nil
+		}
+		slog.WarnContext(ctx, "Database is not available yet", "error", err)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("error waiting for the database: %w", err)
+		case <-ticker.C:
+		}
+	}
+}
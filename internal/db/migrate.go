@@ -0,0 +1,153 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/DarRo9/pvz_service/migrations"
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jmoiron/sqlx"
+)
+
+// MigrationStatus - встроенная миграция и признак того, что она применена
+type MigrationStatus struct {
+	Version uint
+	Name    string
+	Applied bool
+}
+
+// Migrator применяет миграции, встроенные в бинарник. Postgres драйвер golang-migrate держит
+// advisory lock на время применения, поэтому реплики, стартующие одновременно, не гоняются друг с другом
+type Migrator struct {
+	migrate *migrate.Migrate
+}
+
+// NewMigrator занимает отдельное соединение из пула, которое освобождается в Close
+func NewMigrator(ctx context.Context, db *sqlx.DB) (*Migrator, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("error opening migrations: %w", err)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		src.Close()
+		return nil, fmt.Errorf("error getting connection for migrations: %w", err)
+	}
+
+	driver, err := postgres.WithConnection(ctx, conn, &postgres.Config{})
+	if err != nil {
+		src.Close()
+		conn.Close()
+		return nil, fmt.Errorf("error creating migration driver: %w", err)
+	}
+
+	m, err := migrate.NewWithInstance("iofs", src, "postgres", driver)
+	if err != nil {
+		src.Close()
+		driver.Close()
+		return nil, fmt.Errorf("error creating migrator: %w", err)
+	}
+
+	return &Migrator{migrate: m}, nil
+}
+
+func (m *Migrator) Up() error {
+	if err := m.migrate.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("error applying migrations: %w", err)
+	}
+	return nil
+}
+
+// Down откатывает последние steps миграций
+func (m *Migrator) Down(steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("invalid number of steps: %d", steps)
+	}
+	if err := m.migrate.Steps(-steps); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("error rolling back migrations: %w", err)
+	}
+	return nil
+}
+
+// Version возвращает 0, если ни одна миграция еще не применена
+func (m *Migrator) Version() (uint, bool, error) {
+	version, dirty, err := m.migrate.Version()
+	if errors.Is(err, migrate.ErrNilVersion) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("error getting migration version: %w", err)
+	}
+	return version, dirty, nil
+}
+
+func (m *Migrator) Status() ([]*MigrationStatus, error) {
+	version, _, err := m.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := listMigrations()
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range statuses {
+		status.Applied = status.Version <= version
+	}
+	return statuses, nil
+}
+
+func (m *Migrator) Close() error {
+	srcErr, dbErr := m.migrate.Close()
+	return errors.Join(srcErr, dbErr)
+}
+
+// LatestMigrationVersion возвращает версию последней встроенной миграции
+func LatestMigrationVersion() (uint, error) {
+	statuses, err := listMigrations()
+	if err != nil {
+		return 0, err
+	}
+	if len(statuses) == 0 {
+		return 0, nil
+	}
+	return statuses[len(statuses)-1].Version, nil
+}
+
+func listMigrations() ([]*MigrationStatus, error) {
+	src, err := iofs.New(migrations.FS, ".")
+	if err != nil {
+		return nil, fmt.Errorf("error opening migrations: %w", err)
+	}
+	defer src.Close()
+
+	var statuses []*MigrationStatus
+	version, err := src.First()
+	for err == nil {
+		name, nameErr := migrationName(src, version)
+		if nameErr != nil {
+			return nil, nameErr
+		}
+		statuses = append(statuses, &MigrationStatus{Version: version, Name: name})
+		version, err = src.Next(version)
+	}
+	if !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("error listing migrations: %w", err)
+	}
+	return statuses, nil
+}
+
+func migrationName(src source.Driver, version uint) (string, error) {
+	r, name, err := src.ReadUp(version)
+	if err != nil {
+		return "", fmt.Errorf("error reading migration %d: %w", version, err)
+	}
+	r.Close()
+	return name, nil
+}
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"io/fs"
+	"testing"
+
+	"github.com/DarRo9/pvz_service/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListMigrations(t *testing.T) {
+	upFiles, err := fs.Glob(migrations.FS, "*.up.sql")
+	require.NoError(t, err)
+	downFiles, err := fs.Glob(migrations.FS, "*.down.sql")
+	require.NoError(t, err)
+	require.Len(t, downFiles, len(upFiles))
+
+	statuses, err := listMigrations()
+	require.NoError(t, err)
+	require.Len(t, statuses, len(upFiles))
+
+	for i, status := range statuses {
+		assert.Equal(t, uint(i+1), status.Version)
+		assert.NotEmpty(t, status.Name)
+		assert.False(t, status.Applied)
+	}
+
+	latest, err := LatestMigrationVersion()
+	require.NoError(t, err)
+	assert.Equal(t, statuses[len(statuses)-1].Version, latest)
+}
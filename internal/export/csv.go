@@ -0,0 +1,47 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// utf8BOM нужен, чтобы Excel открыл файл в UTF-8, а не в кодировке Windows-1251
+const utf8BOM = "\uFEFF"
+
+// CSVWriter пишет строки в CSV с разделителем точка с запятой, как ожидает Excel в русской локали
+type CSVWriter struct {
+	out     io.Writer
+	csv     *csv.Writer
+	started bool
+}
+
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	cw := csv.NewWriter(w)
+	cw.Comma = ';'
+	cw.UseCRLF = true
+	return &CSVWriter{out: w, csv: cw}
+}
+
+func (w *CSVWriter) WriteRow(values []string) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	return w.csv.Write(values)
+}
+
+func (w *CSVWriter) Close() error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *CSVWriter) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	_, err := io.WriteString(w.out, utf8BOM)
+	return err
+}
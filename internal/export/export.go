@@ -0,0 +1,13 @@
+package export
+
+// Writer записывает строки таблицы в файл выгрузки по мере поступления, не накапливая их в памяти.
+// Close дописывает окончание файла и должен быть вызван после последней строки
+type Writer interface {
+	WriteRow(values []string) error
+	Close() error
+}
+
+var (
+	_ Writer = (*CSVWriter)(nil)
+	_ Writer = (*XLSXWriter)(nil)
+)
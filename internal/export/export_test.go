@@ -0,0 +1,62 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSVWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf)
+
+	require.NoError(t, w.WriteRow([]string{"Город", "Адрес"}))
+	require.NoError(t, w.WriteRow([]string{"Москва", "Тверская; 1"}))
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "\uFEFFГород;Адрес\r\nМосква;\"Тверская; 1\"\r\n", buf.String())
+}
+
+func TestCSVWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewCSVWriter(&buf)
+
+	require.NoError(t, w.Close())
+
+	assert.Equal(t, "\uFEFF", buf.String())
+}
+
+func TestXLSXWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewXLSXWriter(&buf, "Приемки")
+
+	require.NoError(t, w.WriteRow([]string{"Город", "Тип"}))
+	require.NoError(t, w.WriteRow([]string{"Москва", "<обувь & одежда>"}))
+	require.NoError(t, w.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+
+	files := make(map[string]string)
+	for _, f := range archive.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		data, err := io.ReadAll(rc)
+		require.NoError(t, err)
+		rc.Close()
+		files[f.Name] = string(data)
+	}
+
+	require.Contains(t, files, "[Content_Types].xml")
+	require.Contains(t, files, "_rels/.rels")
+	require.Contains(t, files, "xl/_rels/workbook.xml.rels")
+	assert.Contains(t, files["xl/workbook.xml"], `<sheet name="Приемки"`)
+	assert.Contains(t, files["xl/worksheets/sheet1.xml"],
+		`<row><c t="inlineStr"><is><t xml:space="preserve">Москва</t></is></c>`+
+			`<c t="inlineStr"><is><t xml:space="preserve">&lt;обувь &amp; одежда&gt;</t></is></c></row>`)
+	assert.Contains(t, files["xl/worksheets/sheet1.xml"], `</sheetData></worksheet>`)
+}
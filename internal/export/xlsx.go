@@ -0,0 +1,126 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io"
+)
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`
+	xlsxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`
+	xlsxWorkbookStart = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="`
+	xlsxWorkbookEnd = `" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxSheetStart  = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd = `</sheetData></worksheet>`
+)
+
+// XLSXWriter пишет книгу XLSX с одним листом прямо в поток. Лист записывается последним
+// файлом архива, поэтому строки попадают в ответ сразу, без временных файлов.
+// Значения записываются строками (inline strings), без общей таблицы строк
+type XLSXWriter struct {
+	zip       *zip.Writer
+	sheet     *bufio.Writer
+	sheetName string
+	started   bool
+}
+
+func NewXLSXWriter(w io.Writer, sheetName string) *XLSXWriter {
+	return &XLSXWriter{zip: zip.NewWriter(w), sheetName: sheetName}
+}
+
+func (w *XLSXWriter) WriteRow(values []string) error {
+	if err := w.start(); err != nil {
+		return err
+	}
+
+	if _, err := w.sheet.WriteString("<row>"); err != nil {
+		return err
+	}
+	for _, value := range values {
+		if _, err := w.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`); err != nil {
+			return err
+		}
+		if err := xml.EscapeText(w.sheet, []byte(value)); err != nil {
+			return err
+		}
+		if _, err := w.sheet.WriteString("</t></is></c>"); err != nil {
+			return err
+		}
+	}
+	_, err := w.sheet.WriteString("</row>")
+	return err
+}
+
+func (w *XLSXWriter) Close() error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	if _, err := w.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zip.Close()
+}
+
+// start записывает служебные файлы книги и открывает лист
+func (w *XLSXWriter) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+
+	var workbook bytes.Buffer
+	workbook.WriteString(xlsxWorkbookStart)
+	if err := xml.EscapeText(&workbook, []byte(w.sheetName)); err != nil {
+		return err
+	}
+	workbook.WriteString(xlsxWorkbookEnd)
+
+	parts := []struct {
+		name string
+		data []byte
+	}{
+		{"[Content_Types].xml", []byte(xlsxContentTypes)},
+		{"_rels/.rels", []byte(xlsxRels)},
+		{"xl/workbook.xml", workbook.Bytes()},
+		{"xl/_rels/workbook.xml.rels", []byte(xlsxWorkbookRels)},
+	}
+	for _, part := range parts {
+		f, err := w.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := f.Write(part.data); err != nil {
+			return err
+		}
+	}
+
+	sheet, err := w.zip.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(sheet)
+	_, err = w.sheet.WriteString(xlsxSheetStart)
+	return err
+}
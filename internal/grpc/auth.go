@@ -0,0 +1,157 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1"
+	"github.com/DarRo9/pvz_service/internal/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	roleEmployee  = "employee"
+	roleModerator = "moderator"
+)
+
+// publicMethods не требуют токена
+var publicMethods = map[string]bool{
+	pvz_v1.PVZService_DummyLogin_FullMethodName:   true,
+	pvz_v1.PVZService_Register_FullMethodName:     true,
+	pvz_v1.PVZService_Login_FullMethodName:        true,
+	pvz_v1.PVZService_RefreshToken_FullMethodName: true,
+	grpc_health_v1.Health_Check_FullMethodName:    true,
+	grpc_health_v1.Health_Watch_FullMethodName:    true,
+	grpc_health_v1.Health_List_FullMethodName:     true,
+}
+
+// methodRoles описывает роли, которым разрешен вызов метода, по аналогии с validateRole в HTTP хендлерах
+var methodRoles = map[string][]string{
+	pvz_v1.PVZService_GetPVZList_FullMethodName:                {roleEmployee, roleModerator},
+	pvz_v1.PVZService_ListPVZ_FullMethodName:                   {roleEmployee, roleModerator},
+	pvz_v1.PVZService_CreatePVZ_FullMethodName:                 {roleModerator},
+	pvz_v1.PVZService_GetPVZ_FullMethodName:                    {roleEmployee, roleModerator},
+	pvz_v1.PVZService_UpdatePVZ_FullMethodName:                 {roleModerator},
+	pvz_v1.PVZService_DeactivatePVZ_FullMethodName:             {roleModerator},
+	pvz_v1.PVZService_ListNearbyPVZ_FullMethodName:             {roleEmployee, roleModerator},
+	pvz_v1.PVZService_ListPVZEmployees_FullMethodName:          {roleModerator},
+	pvz_v1.PVZService_AssignEmployee_FullMethodName:            {roleModerator},
+	pvz_v1.PVZService_UnassignEmployee_FullMethodName:          {roleModerator},
+	pvz_v1.PVZService_CreateReception_FullMethodName:           {roleEmployee},
+	pvz_v1.PVZService_CloseLastReception_FullMethodName:        {roleEmployee},
+	pvz_v1.PVZService_ListReceptions_FullMethodName:            {roleEmployee, roleModerator},
+	pvz_v1.PVZService_GetReception_FullMethodName:              {roleEmployee, roleModerator},
+	pvz_v1.PVZService_SetReceptionManifest_FullMethodName:      {roleEmployee},
+	pvz_v1.PVZService_GetReceptionManifest_FullMethodName:      {roleEmployee, roleModerator},
+	pvz_v1.PVZService_GetReconciliation_FullMethodName:         {roleEmployee, roleModerator},
+	pvz_v1.PVZService_AcknowledgeReconciliation_FullMethodName: {roleEmployee},
+	pvz_v1.PVZService_ReopenReception_FullMethodName:           {roleModerator},
+	pvz_v1.PVZService_AmendReception_FullMethodName:            {roleModerator},
+	pvz_v1.PVZService_ListReceptionCorrections_FullMethodName:  {roleEmployee, roleModerator},
+	pvz_v1.PVZService_AddProduct_FullMethodName:                {roleEmployee},
+	pvz_v1.PVZService_AddProducts_FullMethodName:               {roleEmployee},
+	pvz_v1.PVZService_DeleteLastProduct_FullMethodName:         {roleEmployee},
+	pvz_v1.PVZService_IssueProduct_FullMethodName:              {roleEmployee},
+	pvz_v1.PVZService_ReturnProduct_FullMethodName:             {roleEmployee},
+	pvz_v1.PVZService_GetProductHistory_FullMethodName:         {roleEmployee, roleModerator},
+	pvz_v1.PVZService_FindProductsByBarcode_FullMethodName:     {roleEmployee, roleModerator},
+	pvz_v1.PVZService_Logout_FullMethodName:                    {roleEmployee, roleModerator},
+	pvz_v1.PVZService_ListCities_FullMethodName:                {roleEmployee, roleModerator},
+	pvz_v1.PVZService_CreateCity_FullMethodName:                {roleModerator},
+	pvz_v1.PVZService_DeleteCity_FullMethodName:                {roleModerator},
+	pvz_v1.PVZService_ListProductTypes_FullMethodName:          {roleEmployee, roleModerator},
+	pvz_v1.PVZService_CreateProductType_FullMethodName:         {roleModerator},
+	pvz_v1.PVZService_DeleteProductType_FullMethodName:         {roleModerator},
+	pvz_v1.PVZService_CreateWebhook_FullMethodName:             {roleModerator},
+	pvz_v1.PVZService_ListWebhooks_FullMethodName:              {roleModerator},
+	pvz_v1.PVZService_DeleteWebhook_FullMethodName:             {roleModerator},
+	pvz_v1.PVZService_ListAuditRecords_FullMethodName:          {roleModerator},
+	pvz_v1.PVZService_GetReceptionsReport_FullMethodName:       {roleModerator},
+	pvz_v1.PVZService_GetProductsReport_FullMethodName:         {roleModerator},
+}
+
+type TokenRevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+func AuthUnaryInterceptor(checker TokenRevocationChecker) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, checker, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func AuthStreamInterceptor(checker TokenRevocationChecker) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), checker, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextServerStream подменяет контекст потока, например после авторизации
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func authorize(ctx context.Context, checker TokenRevocationChecker, method string) (context.Context, error) {
+	if publicMethods[method] {
+		return ctx, nil
+	}
+
+	claims, err := claimsFromMetadata(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	jti, ok := claims["jti"].(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	revoked, err := checker.IsTokenRevoked(ctx, jti)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check token")
+	}
+	if revoked {
+		return nil, status.Error(codes.Unauthenticated, "token revoked")
+	}
+
+	for _, role := range methodRoles[method] {
+		if claims["role"] == role {
+			return context.WithValue(ctx, "user", claims), nil
+		}
+	}
+
+	return nil, status.Error(codes.PermissionDenied, "forbidden")
+}
+
+func claimsFromMetadata(ctx context.Context) (jwt.MapClaims, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	tokenString := strings.TrimPrefix(values[0], "Bearer ")
+	return utils.ParseJWT(tokenString)
+}
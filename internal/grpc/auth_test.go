@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1"
+	"github.com/DarRo9/pvz_service/internal/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	employeeToken, err := utils.GenerateJWT("user123", "test@example.com", roleEmployee)
+	assert.NoError(t, err)
+	moderatorToken, err := utils.GenerateJWT("user456", "test@example.com", roleModerator)
+	assert.NoError(t, err)
+	revokedToken, err := utils.GenerateJWT("user789", "test@example.com", roleEmployee)
+	assert.NoError(t, err)
+	revokedClaims, err := utils.ParseJWT(revokedToken)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		expectedCode  codes.Code
+		expectClaims  bool
+	}{
+		{
+			name:         "public method without token",
+			method:       pvz_v1.PVZService_Login_FullMethodName,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "health list without token",
+			method:       grpc_health_v1.Health_List_FullMethodName,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "protected method without token",
+			method:       pvz_v1.PVZService_ListPVZ_FullMethodName,
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:          "invalid token",
+			method:        pvz_v1.PVZService_ListPVZ_FullMethodName,
+			authorization: "Bearer invalid",
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:          "allowed role",
+			method:        pvz_v1.PVZService_AddProduct_FullMethodName,
+			authorization: "Bearer " + employeeToken,
+			expectedCode:  codes.OK,
+			expectClaims:  true,
+		},
+		{
+			name:          "forbidden role",
+			method:        pvz_v1.PVZService_CreatePVZ_FullMethodName,
+			authorization: "Bearer " + employeeToken,
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "moderator creates pvz",
+			method:        pvz_v1.PVZService_CreatePVZ_FullMethodName,
+			authorization: "Bearer " + moderatorToken,
+			expectedCode:  codes.OK,
+			expectClaims:  true,
+		},
+		{
+			name:          "revoked token",
+			method:        pvz_v1.PVZService_AddProduct_FullMethodName,
+			authorization: "Bearer " + revokedToken,
+			expectedCode:  codes.Unauthenticated,
+		},
+		{
+			name:          "unknown method",
+			method:        "/pvz.v1.PVZService/Unknown",
+			authorization: "Bearer " + moderatorToken,
+			expectedCode:  codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				_, ok := ctx.Value("user").(jwt.MapClaims)
+				assert.Equal(t, tt.expectClaims, ok)
+				return nil, nil
+			}
+
+			checker := new(MockService)
+			checker.On("IsTokenRevoked", mock.Anything, revokedClaims["jti"]).Return(true, nil)
+			checker.On("IsTokenRevoked", mock.Anything, mock.Anything).Return(false, nil)
+			interceptor := AuthUnaryInterceptor(checker)
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedCode == codes.OK, called)
+		})
+	}
+}
@@ -2,39 +2,1005 @@ package grpc
 
 import (
 	"context"
-	"log"
+	"errors"
+	"io"
+	"log/slog"
 
 	"github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1"
+	"github.com/DarRo9/pvz_service/internal/metrics"
+	"github.com/DarRo9/pvz_service/internal/repository"
 	"github.com/DarRo9/pvz_service/internal/service"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"github.com/DarRo9/pvz_service/internal/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultPage  = 1
+	defaultLimit = 10
+	maxLimit     = 30
+	maxPVZLimit  = 100
+
+	defaultAuditLimit = 20
+	maxAuditLimit     = 100
+
+	defaultReceptionLimit = 20
+	maxReceptionLimit     = 100
 )
 
 type GRPCHandler struct {
-	service *service.Service
+	service service.ServiceInterface
 	pvz_v1.UnimplementedPVZServiceServer
 }
 
-func NewGRPCHandler(service *service.Service) *GRPCHandler {
+func NewGRPCHandler(service service.ServiceInterface) *GRPCHandler {
 	return &GRPCHandler{
 		service: service,
 	}
 }
 
 func (h *GRPCHandler) GetPVZList(ctx context.Context, _ *pvz_v1.GetPVZListRequest) (*pvz_v1.GetPVZListResponse, error) {
-	log.Println("Got request in GetPVZList")
+	slog.DebugContext(ctx, "Got request in GetPVZList")
 	pvzs, err := h.service.ListAllPVZ(ctx)
 	if err != nil {
-		log.Printf("Error getting PVZ list: %v", err)
+		slog.ErrorContext(ctx, "Error getting PVZ list", "error", err)
 		return nil, err
 	}
 
 	response := &pvz_v1.GetPVZListResponse{}
 	for _, p := range pvzs {
-		response.Pvzs = append(response.Pvzs, &pvz_v1.PVZ{
-			Id:               p.ID,
-			RegistrationDate: timestamppb.New(p.RegistrationDate),
-			City:             p.City,
-		})
+		response.Pvzs = append(response.Pvzs, pvzRepositoryToGRPC(p))
+	}
+	return response, nil
+}
+
+func (h *GRPCHandler) DummyLogin(ctx context.Context, req *pvz_v1.DummyLoginRequest) (*pvz_v1.TokenResponse, error) {
+	slog.DebugContext(ctx, "Got request in DummyLogin")
+
+	if !h.service.IsValidRole(service.UserRole(req.GetRole())) {
+		slog.WarnContext(ctx, "Invalid role", "role", req.GetRole())
+		return nil, status.Error(codes.InvalidArgument, "invalid role")
+	}
+
+	if req.GetPvzId() != "" {
+		if service.UserRole(req.GetRole()) != service.UserRoleEmployee {
+			slog.WarnContext(ctx, "PVZ id passed for non-employee role", "role", req.GetRole())
+			return nil, status.Error(codes.InvalidArgument, "pvz_id is allowed only for employee role")
+		}
+		if _, err := uuid.Parse(req.GetPvzId()); err != nil {
+			slog.WarnContext(ctx, "Invalid PVZ id", "pvzId", req.GetPvzId())
+			return nil, status.Error(codes.InvalidArgument, "invalid pvz_id")
+		}
+	}
+
+	token, err := utils.GenerateDummyJWT(req.GetRole(), req.GetPvzId())
+	if err != nil {
+		slog.ErrorContext(ctx, "Error generating token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+
+	slog.InfoContext(ctx, "Token generated")
+	return &pvz_v1.TokenResponse{Token: token}, nil
+}
+
+func (h *GRPCHandler) Register(ctx context.Context, req *pvz_v1.RegisterRequest) (*pvz_v1.RegisterResponse, error) {
+	slog.DebugContext(ctx, "Got request in Register")
+
+	user, err := h.service.RegisterUser(ctx, req.GetEmail(), req.GetPassword(), req.GetRole())
+	if err != nil {
+		slog.WarnContext(ctx, "Error registering user", "error", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	slog.InfoContext(ctx, "User registered")
+	return &pvz_v1.RegisterResponse{User: userRepositoryToGRPC(user)}, nil
+}
+
+func (h *GRPCHandler) Login(ctx context.Context, req *pvz_v1.LoginRequest) (*pvz_v1.TokenResponse, error) {
+	slog.DebugContext(ctx, "Got request in Login")
+
+	user, err := h.service.GetUserByEmail(ctx, req.GetEmail())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting user by email", "error", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.service.CheckPassword(ctx, user, req.GetPassword()); err != nil {
+		slog.WarnContext(ctx, "Invalid password", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "invalid password")
+	}
+
+	tokens, err := h.service.IssueTokens(ctx, user)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error generating token", "error", err)
+		return nil, status.Error(codes.Internal, "failed to generate token")
+	}
+
+	slog.InfoContext(ctx, "Token generated")
+	return tokenPairServiceToGRPC(tokens), nil
+}
+
+func (h *GRPCHandler) RefreshToken(ctx context.Context, req *pvz_v1.RefreshTokenRequest) (*pvz_v1.TokenResponse, error) {
+	slog.DebugContext(ctx, "Got request in RefreshToken")
+
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh token is required")
+	}
+
+	tokens, err := h.service.RefreshTokens(ctx, req.GetRefreshToken())
+	if errors.Is(err, service.ErrInvalidRefreshToken) {
+		slog.WarnContext(ctx, "Invalid refresh token")
+		return nil, status.Error(codes.Unauthenticated, "invalid refresh token")
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error refreshing tokens", "error", err)
+		return nil, status.Error(codes.Internal, "failed to refresh token")
+	}
+
+	slog.InfoContext(ctx, "Tokens refreshed")
+	return tokenPairServiceToGRPC(tokens), nil
+}
+
+func (h *GRPCHandler) Logout(ctx context.Context, req *pvz_v1.LogoutRequest) (*pvz_v1.LogoutResponse, error) {
+	slog.DebugContext(ctx, "Got request in Logout")
+
+	claims, ok := ctx.Value("user").(jwt.MapClaims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	jti, _ := claims["jti"].(string)
+	expiresAt, err := claims.GetExpirationTime()
+	if jti == "" || err != nil || expiresAt == nil {
+		slog.WarnContext(ctx, "Invalid token claims", "error", err)
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+
+	if err := h.service.Logout(ctx, userIDFromContext(ctx), jti, expiresAt.Time, req.GetRefreshToken()); err != nil {
+		slog.ErrorContext(ctx, "Error revoking tokens", "error", err)
+		return nil, status.Error(codes.Internal, "failed to logout")
+	}
+
+	slog.InfoContext(ctx, "User logged out")
+	return &pvz_v1.LogoutResponse{}, nil
+}
+
+func (h *GRPCHandler) CreatePVZ(ctx context.Context, req *pvz_v1.CreatePVZRequest) (*pvz_v1.CreatePVZResponse, error) {
+	slog.DebugContext(ctx, "Got request in CreatePVZ")
+
+	pvz, err := h.service.CreatePVZ(ctx, req.GetCity(), pvzLocationGRPCToRepository(req.GetLocation()))
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating PVZ", "error", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	metrics.PVZCreatedTotal.Inc()
+
+	slog.InfoContext(ctx, "PVZ created")
+	return &pvz_v1.CreatePVZResponse{Pvz: pvzRepositoryToGRPC(pvz)}, nil
+}
+
+func (h *GRPCHandler) ListPVZ(ctx context.Context, req *pvz_v1.ListPVZRequest) (*pvz_v1.ListPVZResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListPVZ")
+
+	page := int(req.GetPage())
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultLimit
+	}
+
+	if page < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page must be greater than 0")
+	}
+	if page > 0 && req.GetCursor() != "" {
+		return nil, status.Error(codes.InvalidArgument, "page and cursor can not be used together")
+	}
+
+	if limit < 0 || limit > maxPVZLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxPVZLimit)
+	}
+
+	filter := pvzFilterGRPCToRepository(req)
+
+	var (
+		pvzs       []*repository.PVZWithReceptions
+		nextCursor string
+		err        error
+	)
+	if page > 0 {
+		pvzs, err = h.service.ListPVZ(ctx, filter, page, limit)
+	} else {
+		pvzs, nextCursor, err = h.service.ListPVZByCursor(ctx, filter, req.GetCursor(), limit)
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting PVZ list", "error", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	response := &pvz_v1.ListPVZResponse{
+		Pvzs:       make([]*pvz_v1.PVZWithReceptions, len(pvzs)),
+		NextCursor: nextCursor,
+	}
+	for i := range pvzs {
+		response.Pvzs[i] = pvzWithReceptionsRepositoryToGRPC(pvzs[i])
+	}
+
+	slog.InfoContext(ctx, "PVZ list retrieved")
+	return response, nil
+}
+
+func (h *GRPCHandler) GetPVZ(ctx context.Context, req *pvz_v1.GetPVZRequest) (*pvz_v1.GetPVZResponse, error) {
+	slog.DebugContext(ctx, "Got request in GetPVZ")
+
+	pvz, err := h.service.GetPVZ(ctx, req.GetPvzId())
+	if errors.Is(err, service.ErrPVZNotFound) {
+		return nil, status.Error(codes.NotFound, "pvz not found")
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting PVZ", "error", err)
+		return nil, status.Error(codes.Internal, "failed to get pvz")
+	}
+
+	slog.InfoContext(ctx, "PVZ retrieved")
+	return &pvz_v1.GetPVZResponse{Pvz: pvzWithReceptionsRepositoryToGRPC(pvz)}, nil
+}
+
+func (h *GRPCHandler) UpdatePVZ(ctx context.Context, req *pvz_v1.UpdatePVZRequest) (*pvz_v1.UpdatePVZResponse, error) {
+	slog.DebugContext(ctx, "Got request in UpdatePVZ")
+
+	pvz, err := h.service.UpdatePVZ(ctx, req.GetPvzId(), pvzUpdateGRPCToRepository(req))
+	if err != nil {
+		slog.WarnContext(ctx, "Error updating PVZ", "error", err)
+		return nil, pvzError(err)
+	}
+
+	slog.InfoContext(ctx, "PVZ updated")
+	return &pvz_v1.UpdatePVZResponse{Pvz: pvzRepositoryToGRPC(pvz)}, nil
+}
+
+func (h *GRPCHandler) DeactivatePVZ(ctx context.Context, req *pvz_v1.DeactivatePVZRequest) (*pvz_v1.DeactivatePVZResponse, error) {
+	slog.DebugContext(ctx, "Got request in DeactivatePVZ")
+
+	pvz, err := h.service.DeactivatePVZ(ctx, req.GetPvzId())
+	if err != nil {
+		slog.WarnContext(ctx, "Error deactivating PVZ", "error", err)
+		return nil, pvzError(err)
+	}
+
+	slog.InfoContext(ctx, "PVZ deactivated")
+	return &pvz_v1.DeactivatePVZResponse{Pvz: pvzRepositoryToGRPC(pvz)}, nil
+}
+
+func (h *GRPCHandler) ListNearbyPVZ(ctx context.Context, req *pvz_v1.ListNearbyPVZRequest) (*pvz_v1.ListNearbyPVZResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListNearbyPVZ")
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit < 0 || limit > maxLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxLimit)
+	}
+
+	nearby, err := h.service.ListNearbyPVZ(ctx, req.GetLat(), req.GetLon(), req.GetRadius(), limit)
+	if errors.Is(err, service.ErrInvalidLocation) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error searching nearby PVZ", "error", err)
+		return nil, status.Error(codes.Internal, "failed to search nearby pvz")
+	}
+
+	response := &pvz_v1.ListNearbyPVZResponse{}
+	for _, p := range nearby {
+		response.Pvzs = append(response.Pvzs, nearbyPVZRepositoryToGRPC(p))
+	}
+	slog.InfoContext(ctx, "Nearby PVZ retrieved")
+	return response, nil
+}
+
+func (h *GRPCHandler) CreateReception(ctx context.Context, req *pvz_v1.CreateReceptionRequest) (*pvz_v1.CreateReceptionResponse, error) {
+	slog.DebugContext(ctx, "Got request in CreateReception")
+
+	rc, err := h.service.CreateReception(ctx, req.GetPvzId(), userIDFromContext(ctx))
+	if errors.Is(err, service.ErrReceptionInProgress) {
+		slog.WarnContext(ctx, "PVZ already has a reception in progress", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating reception", "error", err)
+		return nil, employeeError(err)
+	}
+
+	slog.InfoContext(ctx, "Reception created")
+	metrics.ReceptionsCreatedTotal.Inc()
+	return &pvz_v1.CreateReceptionResponse{Reception: receptionRepositoryToGRPC(rc)}, nil
+}
+
+func (h *GRPCHandler) CloseLastReception(ctx context.Context, req *pvz_v1.CloseLastReceptionRequest) (*pvz_v1.CloseLastReceptionResponse, error) {
+	slog.DebugContext(ctx, "Got request in CloseLastReception")
+
+	rc, err := h.service.CloseReception(ctx, req.GetPvzId(), userIDFromContext(ctx))
+	if errors.Is(err, service.ErrUnacknowledgedDiscrepancies) {
+		slog.WarnContext(ctx, "Reception has unacknowledged discrepancies", "error", err)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Error closing reception", "error", err)
+		return nil, employeeError(err)
 	}
+
+	slog.InfoContext(ctx, "Reception closed")
+	return &pvz_v1.CloseLastReceptionResponse{Reception: receptionRepositoryToGRPC(rc)}, nil
+}
+
+func (h *GRPCHandler) ListReceptions(ctx context.Context, req *pvz_v1.ListReceptionsRequest) (*pvz_v1.ListReceptionsResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListReceptions")
+
+	page := int(req.GetPage())
+	limit := int(req.GetLimit())
+	if page == 0 {
+		page = defaultPage
+	}
+	if limit == 0 {
+		limit = defaultReceptionLimit
+	}
+
+	if page < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page must be greater than 0")
+	}
+	if limit < 0 || limit > maxReceptionLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxReceptionLimit)
+	}
+
+	receptions, err := h.service.ListReceptions(ctx, req.GetPvzId(), receptionFilterGRPCToRepository(req, page, limit))
+	if errors.Is(err, service.ErrInvalidReceptionFilter) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing receptions", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list receptions")
+	}
+
+	response := &pvz_v1.ListReceptionsResponse{
+		Receptions: make([]*pvz_v1.Reception, len(receptions)),
+	}
+	for i := range receptions {
+		response.Receptions[i] = receptionRepositoryToGRPC(receptions[i])
+	}
+
+	slog.InfoContext(ctx, "Receptions retrieved")
 	return response, nil
 }
+
+func (h *GRPCHandler) GetReception(ctx context.Context, req *pvz_v1.GetReceptionRequest) (*pvz_v1.GetReceptionResponse, error) {
+	slog.DebugContext(ctx, "Got request in GetReception")
+
+	reception, err := h.service.GetReception(ctx, req.GetReceptionId())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting reception", "error", err)
+		return nil, receptionError(err)
+	}
+
+	slog.InfoContext(ctx, "Reception retrieved")
+	return &pvz_v1.GetReceptionResponse{Reception: receptionWithProductsRepositoryToGRPC(reception)}, nil
+}
+
+func (h *GRPCHandler) SetReceptionManifest(ctx context.Context, req *pvz_v1.SetReceptionManifestRequest) (*pvz_v1.SetReceptionManifestResponse, error) {
+	slog.DebugContext(ctx, "Got request in SetReceptionManifest")
+
+	manifest, err := h.service.SetReceptionManifest(
+		ctx,
+		req.GetReceptionId(),
+		manifestItemsGRPCToRepository(req.GetItems()),
+		req.GetBlockOnDiscrepancies(),
+		userIDFromContext(ctx),
+	)
+	if err != nil {
+		slog.WarnContext(ctx, "Error setting reception manifest", "error", err)
+		return nil, receptionError(err)
+	}
+
+	slog.InfoContext(ctx, "Reception manifest uploaded", "items", len(manifest.Items))
+	return &pvz_v1.SetReceptionManifestResponse{Manifest: receptionManifestRepositoryToGRPC(manifest)}, nil
+}
+
+func (h *GRPCHandler) GetReceptionManifest(ctx context.Context, req *pvz_v1.GetReceptionManifestRequest) (*pvz_v1.GetReceptionManifestResponse, error) {
+	slog.DebugContext(ctx, "Got request in GetReceptionManifest")
+
+	manifest, err := h.service.GetReceptionManifest(ctx, req.GetReceptionId())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting reception manifest", "error", err)
+		return nil, receptionError(err)
+	}
+
+	slog.InfoContext(ctx, "Reception manifest retrieved")
+	return &pvz_v1.GetReceptionManifestResponse{Manifest: receptionManifestRepositoryToGRPC(manifest)}, nil
+}
+
+func (h *GRPCHandler) GetReconciliation(ctx context.Context, req *pvz_v1.GetReconciliationRequest) (*pvz_v1.GetReconciliationResponse, error) {
+	slog.DebugContext(ctx, "Got request in GetReconciliation")
+
+	reconciliation, err := h.service.GetReconciliation(ctx, req.GetReceptionId())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting reconciliation", "error", err)
+		return nil, receptionError(err)
+	}
+
+	slog.InfoContext(ctx, "Reconciliation retrieved")
+	return &pvz_v1.GetReconciliationResponse{Reconciliation: reconciliationRepositoryToGRPC(reconciliation)}, nil
+}
+
+func (h *GRPCHandler) AcknowledgeReconciliation(ctx context.Context, req *pvz_v1.AcknowledgeReconciliationRequest) (*pvz_v1.AcknowledgeReconciliationResponse, error) {
+	slog.DebugContext(ctx, "Got request in AcknowledgeReconciliation")
+
+	reconciliation, err := h.service.AcknowledgeReconciliation(ctx, req.GetReceptionId(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error acknowledging reconciliation", "error", err)
+		return nil, receptionError(err)
+	}
+
+	slog.InfoContext(ctx, "Reconciliation acknowledged")
+	return &pvz_v1.AcknowledgeReconciliationResponse{Reconciliation: reconciliationRepositoryToGRPC(reconciliation)}, nil
+}
+
+func (h *GRPCHandler) ReopenReception(ctx context.Context, req *pvz_v1.ReopenReceptionRequest) (*pvz_v1.ReopenReceptionResponse, error) {
+	slog.DebugContext(ctx, "Got request in ReopenReception")
+
+	reception, err := h.service.ReopenReception(ctx, req.GetReceptionId(), req.GetReason(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error reopening reception", "error", err)
+		return nil, correctionError(err)
+	}
+
+	slog.InfoContext(ctx, "Reception reopened")
+	return &pvz_v1.ReopenReceptionResponse{Reception: receptionRepositoryToGRPC(reception)}, nil
+}
+
+func (h *GRPCHandler) AmendReception(ctx context.Context, req *pvz_v1.AmendReceptionRequest) (*pvz_v1.AmendReceptionResponse, error) {
+	slog.DebugContext(ctx, "Got request in AmendReception")
+
+	correction, err := h.service.AmendReception(ctx, req.GetReceptionId(), amendmentGRPCToRepository(req), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error amending reception", "error", err)
+		return nil, correctionError(err)
+	}
+
+	slog.InfoContext(ctx, "Reception amended",
+		"added", len(correction.Changes.Added), "removed", len(correction.Changes.Removed))
+	return &pvz_v1.AmendReceptionResponse{Correction: receptionCorrectionRepositoryToGRPC(correction)}, nil
+}
+
+func (h *GRPCHandler) ListReceptionCorrections(ctx context.Context, req *pvz_v1.ListReceptionCorrectionsRequest) (*pvz_v1.ListReceptionCorrectionsResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListReceptionCorrections")
+
+	corrections, err := h.service.ListReceptionCorrections(ctx, req.GetReceptionId())
+	if err != nil {
+		slog.WarnContext(ctx, "Error listing reception corrections", "error", err)
+		return nil, receptionError(err)
+	}
+
+	response := make([]*pvz_v1.ReceptionCorrection, len(corrections))
+	for i, correction := range corrections {
+		response[i] = receptionCorrectionRepositoryToGRPC(correction)
+	}
+	slog.InfoContext(ctx, "Reception corrections retrieved")
+	return &pvz_v1.ListReceptionCorrectionsResponse{Corrections: response}, nil
+}
+
+func (h *GRPCHandler) AddProduct(ctx context.Context, req *pvz_v1.AddProductRequest) (*pvz_v1.AddProductResponse, error) {
+	slog.DebugContext(ctx, "Got request in AddProduct")
+
+	product, err := h.service.CreateProduct(ctx, req.GetPvzId(), newProductGRPCToRepository(req.GetType(), req.GetDetails()), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating product", "error", err)
+		return nil, productError(err)
+	}
+
+	slog.InfoContext(ctx, "Product created")
+	metrics.ProductsAddedTotal.Inc()
+	return &pvz_v1.AddProductResponse{Product: productRepositoryToGRPC(product)}, nil
+}
+
+// AddProducts принимает поток товаров одного ПВЗ и добавляет их одной транзакцией после закрытия потока клиентом
+func (h *GRPCHandler) AddProducts(stream pvz_v1.PVZService_AddProductsServer) error {
+	ctx := stream.Context()
+	slog.DebugContext(ctx, "Got request in AddProducts")
+
+	var pvzID string
+	var newProducts []repository.NewProduct
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if len(newProducts) == 0 {
+			pvzID = req.GetPvzId()
+		} else if req.GetPvzId() != pvzID {
+			return status.Error(codes.InvalidArgument, "all products must belong to the same pvz")
+		}
+		if len(newProducts) == service.MaxProductBatchSize {
+			return status.Error(codes.InvalidArgument, service.ErrProductBatchTooLarge.Error())
+		}
+		newProducts = append(newProducts, newProductGRPCToRepository(req.GetType(), req.GetDetails()))
+	}
+
+	results, err := h.service.CreateProducts(ctx, pvzID, newProducts, userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating products", "error", err)
+		return employeeError(err)
+	}
+
+	response := &pvz_v1.AddProductsResponse{Results: make([]*pvz_v1.AddProductResult, len(results))}
+	added := 0
+	for i, result := range results {
+		response.Results[i] = &pvz_v1.AddProductResult{Index: int32(i)}
+		if result.Err != nil {
+			response.Results[i].Error = result.Err.Error()
+			continue
+		}
+		response.Results[i].Product = productRepositoryToGRPC(result.Product)
+		added++
+	}
+
+	slog.InfoContext(ctx, "Products created", "added", added, "total", len(results))
+	metrics.ProductsAddedTotal.Add(float64(added))
+	return stream.SendAndClose(response)
+}
+
+func (h *GRPCHandler) DeleteLastProduct(ctx context.Context, req *pvz_v1.DeleteLastProductRequest) (*pvz_v1.DeleteLastProductResponse, error) {
+	slog.DebugContext(ctx, "Got request in DeleteLastProduct")
+
+	product, err := h.service.DeleteProduct(ctx, req.GetPvzId(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error deleting product", "error", err)
+		return nil, employeeError(err)
+	}
+
+	slog.InfoContext(ctx, "Product deleted")
+	return &pvz_v1.DeleteLastProductResponse{Product: productRepositoryToGRPC(product)}, nil
+}
+
+func (h *GRPCHandler) IssueProduct(ctx context.Context, req *pvz_v1.IssueProductRequest) (*pvz_v1.IssueProductResponse, error) {
+	slog.DebugContext(ctx, "Got request in IssueProduct")
+
+	product, err := h.service.IssueProduct(ctx, req.GetProductId(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error issuing product", "error", err)
+		return nil, productStatusError(err)
+	}
+
+	slog.InfoContext(ctx, "Product issued")
+	metrics.ProductsIssuedTotal.Inc()
+	return &pvz_v1.IssueProductResponse{Product: productRepositoryToGRPC(product)}, nil
+}
+
+func (h *GRPCHandler) ReturnProduct(ctx context.Context, req *pvz_v1.ReturnProductRequest) (*pvz_v1.ReturnProductResponse, error) {
+	slog.DebugContext(ctx, "Got request in ReturnProduct")
+
+	product, err := h.service.ReturnProduct(ctx, req.GetProductId(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error returning product", "error", err)
+		return nil, productStatusError(err)
+	}
+
+	slog.InfoContext(ctx, "Product returned")
+	metrics.ProductsReturnedTotal.Inc()
+	return &pvz_v1.ReturnProductResponse{Product: productRepositoryToGRPC(product)}, nil
+}
+
+func (h *GRPCHandler) GetProductHistory(ctx context.Context, req *pvz_v1.GetProductHistoryRequest) (*pvz_v1.GetProductHistoryResponse, error) {
+	slog.DebugContext(ctx, "Got request in GetProductHistory")
+
+	history, err := h.service.GetProductHistory(ctx, req.GetProductId())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting product history", "error", err)
+		return nil, productStatusError(err)
+	}
+
+	response := &pvz_v1.GetProductHistoryResponse{
+		History: make([]*pvz_v1.ProductStatusChange, len(history)),
+	}
+	for i := range history {
+		response.History[i] = productStatusChangeRepositoryToGRPC(history[i])
+	}
+
+	slog.InfoContext(ctx, "Product history retrieved")
+	return response, nil
+}
+
+func (h *GRPCHandler) FindProductsByBarcode(ctx context.Context, req *pvz_v1.FindProductsByBarcodeRequest) (*pvz_v1.FindProductsByBarcodeResponse, error) {
+	slog.DebugContext(ctx, "Got request in FindProductsByBarcode")
+
+	products, err := h.service.FindProductsByBarcode(ctx, req.GetBarcode())
+	if errors.Is(err, service.ErrInvalidProductDetails) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error finding products by barcode", "error", err)
+		return nil, status.Error(codes.Internal, "failed to find products")
+	}
+
+	response := &pvz_v1.FindProductsByBarcodeResponse{
+		Products: make([]*pvz_v1.Product, len(products)),
+	}
+	for i := range products {
+		response.Products[i] = productRepositoryToGRPC(products[i])
+	}
+
+	slog.InfoContext(ctx, "Products found by barcode", "count", len(products))
+	return response, nil
+}
+
+func (h *GRPCHandler) ListCities(ctx context.Context, _ *pvz_v1.ListCitiesRequest) (*pvz_v1.ListCitiesResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListCities")
+
+	entries, err := h.service.ListCities(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing cities", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list cities")
+	}
+
+	slog.InfoContext(ctx, "Cities retrieved")
+	return &pvz_v1.ListCitiesResponse{Cities: dictionaryEntriesRepositoryToGRPC(entries)}, nil
+}
+
+func (h *GRPCHandler) CreateCity(ctx context.Context, req *pvz_v1.CreateCityRequest) (*pvz_v1.CreateCityResponse, error) {
+	slog.DebugContext(ctx, "Got request in CreateCity")
+
+	entry, err := h.service.CreateCity(ctx, req.GetName())
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating city", "error", err)
+		return nil, dictionaryError(err, "failed to create city")
+	}
+
+	slog.InfoContext(ctx, "City created")
+	return &pvz_v1.CreateCityResponse{City: dictionaryEntryRepositoryToGRPC(entry)}, nil
+}
+
+func (h *GRPCHandler) DeleteCity(ctx context.Context, req *pvz_v1.DeleteCityRequest) (*pvz_v1.DeleteCityResponse, error) {
+	slog.DebugContext(ctx, "Got request in DeleteCity")
+
+	if err := h.service.DeleteCity(ctx, req.GetName()); err != nil {
+		slog.WarnContext(ctx, "Error deleting city", "error", err)
+		return nil, dictionaryError(err, "failed to delete city")
+	}
+
+	slog.InfoContext(ctx, "City deleted")
+	return &pvz_v1.DeleteCityResponse{}, nil
+}
+
+func (h *GRPCHandler) ListProductTypes(ctx context.Context, _ *pvz_v1.ListProductTypesRequest) (*pvz_v1.ListProductTypesResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListProductTypes")
+
+	entries, err := h.service.ListProductTypes(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing product types", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list product types")
+	}
+
+	slog.InfoContext(ctx, "Product types retrieved")
+	return &pvz_v1.ListProductTypesResponse{ProductTypes: dictionaryEntriesRepositoryToGRPC(entries)}, nil
+}
+
+func (h *GRPCHandler) CreateProductType(ctx context.Context, req *pvz_v1.CreateProductTypeRequest) (*pvz_v1.CreateProductTypeResponse, error) {
+	slog.DebugContext(ctx, "Got request in CreateProductType")
+
+	entry, err := h.service.CreateProductType(ctx, req.GetName())
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating product type", "error", err)
+		return nil, dictionaryError(err, "failed to create product type")
+	}
+
+	slog.InfoContext(ctx, "Product type created")
+	return &pvz_v1.CreateProductTypeResponse{ProductType: dictionaryEntryRepositoryToGRPC(entry)}, nil
+}
+
+func (h *GRPCHandler) DeleteProductType(ctx context.Context, req *pvz_v1.DeleteProductTypeRequest) (*pvz_v1.DeleteProductTypeResponse, error) {
+	slog.DebugContext(ctx, "Got request in DeleteProductType")
+
+	if err := h.service.DeleteProductType(ctx, req.GetName()); err != nil {
+		slog.WarnContext(ctx, "Error deleting product type", "error", err)
+		return nil, dictionaryError(err, "failed to delete product type")
+	}
+
+	slog.InfoContext(ctx, "Product type deleted")
+	return &pvz_v1.DeleteProductTypeResponse{}, nil
+}
+
+func (h *GRPCHandler) CreateWebhook(ctx context.Context, req *pvz_v1.CreateWebhookRequest) (*pvz_v1.CreateWebhookResponse, error) {
+	slog.DebugContext(ctx, "Got request in CreateWebhook")
+
+	subscription, err := h.service.CreateWebhookSubscription(ctx, req.GetUrl(), req.GetEventTypes())
+	if errors.Is(err, service.ErrInvalidWebhookSubscription) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error creating webhook subscription", "error", err)
+		return nil, status.Error(codes.Internal, "failed to create webhook subscription")
+	}
+
+	slog.InfoContext(ctx, "Webhook subscription created")
+	response := webhookSubscriptionRepositoryToGRPC(subscription)
+	response.Secret = subscription.Secret
+	return &pvz_v1.CreateWebhookResponse{Subscription: response}, nil
+}
+
+func (h *GRPCHandler) ListWebhooks(ctx context.Context, _ *pvz_v1.ListWebhooksRequest) (*pvz_v1.ListWebhooksResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListWebhooks")
+
+	subscriptions, err := h.service.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing webhook subscriptions", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list webhook subscriptions")
+	}
+
+	response := &pvz_v1.ListWebhooksResponse{
+		Subscriptions: make([]*pvz_v1.WebhookSubscription, len(subscriptions)),
+	}
+	for i := range subscriptions {
+		response.Subscriptions[i] = webhookSubscriptionRepositoryToGRPC(subscriptions[i])
+	}
+
+	slog.InfoContext(ctx, "Webhook subscriptions retrieved")
+	return response, nil
+}
+
+func (h *GRPCHandler) DeleteWebhook(ctx context.Context, req *pvz_v1.DeleteWebhookRequest) (*pvz_v1.DeleteWebhookResponse, error) {
+	slog.DebugContext(ctx, "Got request in DeleteWebhook")
+
+	err := h.service.DeleteWebhookSubscription(ctx, req.GetWebhookId())
+	if errors.Is(err, service.ErrWebhookSubscriptionNotFound) {
+		return nil, status.Error(codes.NotFound, "webhook subscription not found")
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error deleting webhook subscription", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete webhook subscription")
+	}
+
+	slog.InfoContext(ctx, "Webhook subscription deleted")
+	return &pvz_v1.DeleteWebhookResponse{}, nil
+}
+
+func (h *GRPCHandler) ListPVZEmployees(ctx context.Context, req *pvz_v1.ListPVZEmployeesRequest) (*pvz_v1.ListPVZEmployeesResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListPVZEmployees")
+
+	employees, err := h.service.ListPVZEmployees(ctx, req.GetPvzId())
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing PVZ employees", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list pvz employees")
+	}
+
+	response := &pvz_v1.ListPVZEmployeesResponse{}
+	for _, employee := range employees {
+		response.Employees = append(response.Employees, pvzEmployeeRepositoryToGRPC(employee))
+	}
+	return response, nil
+}
+
+func (h *GRPCHandler) AssignEmployee(ctx context.Context, req *pvz_v1.AssignEmployeeRequest) (*pvz_v1.AssignEmployeeResponse, error) {
+	slog.DebugContext(ctx, "Got request in AssignEmployee")
+
+	employee, err := h.service.AssignEmployee(ctx, req.GetPvzId(), req.GetUserId())
+	switch {
+	case errors.Is(err, service.ErrPVZNotFound):
+		return nil, status.Error(codes.NotFound, "pvz not found")
+	case errors.Is(err, service.ErrUserNotFound):
+		return nil, status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, service.ErrAssignmentExists):
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrUserNotEmployee):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPVZInactive):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		slog.ErrorContext(ctx, "Error assigning employee", "error", err)
+		return nil, status.Error(codes.Internal, "failed to assign employee")
+	}
+
+	slog.InfoContext(ctx, "Employee assigned")
+	return &pvz_v1.AssignEmployeeResponse{Employee: pvzEmployeeRepositoryToGRPC(employee)}, nil
+}
+
+func (h *GRPCHandler) UnassignEmployee(ctx context.Context, req *pvz_v1.UnassignEmployeeRequest) (*pvz_v1.UnassignEmployeeResponse, error) {
+	slog.DebugContext(ctx, "Got request in UnassignEmployee")
+
+	err := h.service.UnassignEmployee(ctx, req.GetPvzId(), req.GetUserId())
+	if errors.Is(err, service.ErrAssignmentNotFound) {
+		return nil, status.Error(codes.NotFound, "employee is not assigned to pvz")
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error unassigning employee", "error", err)
+		return nil, status.Error(codes.Internal, "failed to unassign employee")
+	}
+
+	slog.InfoContext(ctx, "Employee unassigned")
+	return &pvz_v1.UnassignEmployeeResponse{}, nil
+}
+
+func userIDFromContext(ctx context.Context) string {
+	claims, _ := ctx.Value("user").(jwt.MapClaims)
+	userID, _ := claims["user_id"].(string)
+	return userID
+}
+
+func pvzError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrPVZNotFound):
+		return status.Error(codes.NotFound, "pvz not found")
+	case errors.Is(err, service.ErrPVZInactive), errors.Is(err, service.ErrReceptionInProgress):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+}
+
+// productError дополнительно отвечает AlreadyExists на повторное сканирование штрихкода
+func productError(err error) error {
+	if errors.Is(err, service.ErrDuplicateBarcode) {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return employeeError(err)
+}
+
+func receptionError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrReceptionNotFound),
+		errors.Is(err, service.ErrManifestNotFound),
+		errors.Is(err, service.ErrReconciliationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrReceptionClosed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrInvalidManifest),
+		errors.Is(err, service.ErrEmployeeNotAssigned):
+		return employeeError(err)
+	default:
+		return status.Error(codes.Internal, "failed to process reception")
+	}
+}
+
+// correctionError отвечает FailedPrecondition, если приемку нельзя открыть заново или исправить в текущем состоянии
+func correctionError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrReceptionNotClosed),
+		errors.Is(err, service.ErrNewerReceptionExists),
+		errors.Is(err, service.ErrProductNotAmendable),
+		errors.Is(err, service.ErrPVZInactive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrDuplicateBarcode):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrInvalidCorrection),
+		errors.Is(err, service.ErrInvalidProductType),
+		errors.Is(err, service.ErrInvalidProductDetails):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return receptionError(err)
+	}
+}
+
+func employeeError(err error) error {
+	if errors.Is(err, service.ErrEmployeeNotAssigned) {
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+func productStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, service.ErrInvalidProductStatusTransition):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "failed to process product")
+	}
+}
+
+func dictionaryError(err error, message string) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidDictionaryEntry):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrDictionaryEntryExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, service.ErrDictionaryEntryNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, message)
+	}
+}
+
+func (h *GRPCHandler) ListAuditRecords(ctx context.Context, req *pvz_v1.ListAuditRecordsRequest) (*pvz_v1.ListAuditRecordsResponse, error) {
+	slog.DebugContext(ctx, "Got request in ListAuditRecords")
+
+	page := int(req.GetPage())
+	limit := int(req.GetLimit())
+	if page == 0 {
+		page = defaultPage
+	}
+	if limit == 0 {
+		limit = defaultAuditLimit
+	}
+
+	if page < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page must be greater than 0")
+	}
+	if limit < 0 || limit > maxAuditLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxAuditLimit)
+	}
+
+	filter := auditFilterGRPCToRepository(req, page, limit)
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return nil, status.Error(codes.InvalidArgument, "from must be before to")
+	}
+
+	records, err := h.service.ListAuditRecords(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing audit records", "error", err)
+		return nil, status.Error(codes.Internal, "failed to list audit records")
+	}
+
+	response := &pvz_v1.ListAuditRecordsResponse{
+		Records: make([]*pvz_v1.AuditRecord, len(records)),
+	}
+	for i := range records {
+		response.Records[i] = auditRecordRepositoryToGRPC(records[i])
+	}
+
+	slog.InfoContext(ctx, "Audit records retrieved")
+	return response, nil
+}
+
+func (h *GRPCHandler) GetReceptionsReport(ctx context.Context, req *pvz_v1.GetReceptionsReportRequest) (*pvz_v1.GetReceptionsReportResponse, error) {
+	slog.DebugContext(ctx, "Got request in GetReceptionsReport")
+
+	filter := reportFilterGRPCToRepository(req.GetFrom(), req.GetTo(), req.GetPeriod(), req.GetGroupBy(), req.PvzId, req.City)
+	rows, err := h.service.ReceptionsReport(ctx, filter)
+	if err != nil {
+		slog.WarnContext(ctx, "Error building receptions report", "error", err)
+		return nil, reportError(err)
+	}
+
+	response := &pvz_v1.GetReceptionsReportResponse{
+		Rows: make([]*pvz_v1.ReceptionReportRow, len(rows)),
+	}
+	for i := range rows {
+		response.Rows[i] = receptionReportRowRepositoryToGRPC(rows[i])
+	}
+
+	slog.InfoContext(ctx, "Receptions report built")
+	return response, nil
+}
+
+func (h *GRPCHandler) GetProductsReport(ctx context.Context, req *pvz_v1.GetProductsReportRequest) (*pvz_v1.GetProductsReportResponse, error) {
+	slog.DebugContext(ctx, "Got request in GetProductsReport")
+
+	filter := reportFilterGRPCToRepository(req.GetFrom(), req.GetTo(), req.GetPeriod(), req.GetGroupBy(), req.PvzId, req.City)
+	filter.Type = req.Type
+	rows, err := h.service.ProductsReport(ctx, filter)
+	if err != nil {
+		slog.WarnContext(ctx, "Error building products report", "error", err)
+		return nil, reportError(err)
+	}
+
+	response := &pvz_v1.GetProductsReportResponse{
+		Rows: make([]*pvz_v1.ProductReportRow, len(rows)),
+	}
+	for i := range rows {
+		response.Rows[i] = productReportRowRepositoryToGRPC(rows[i])
+	}
+
+	slog.InfoContext(ctx, "Products report built")
+	return response, nil
+}
+
+func reportError(err error) error {
+	if errors.Is(err, service.ErrInvalidReportFilter) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return status.Error(codes.Internal, "failed to build report")
+}
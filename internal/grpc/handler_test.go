@@ -0,0 +1,1585 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1"
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/service"
+	"github.com/DarRo9/pvz_service/internal/utils"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type MockService struct {
+	mock.Mock
+}
+
+func (m *MockService) IsValidRole(role service.UserRole) bool {
+	args := m.Called(role)
+	return args.Bool(0)
+}
+
+func (m *MockService) IsValidCity(ctx context.Context, city string) (bool, error) {
+	return true, nil
+}
+
+func (m *MockService) IsValidProductType(ctx context.Context, productType string) (bool, error) {
+	return true, nil
+}
+
+func (m *MockService) GetUserByEmail(ctx context.Context, email string) (*repository.User, error) {
+	args := m.Called(ctx, email)
+	return args.Get(0).(*repository.User), args.Error(1)
+}
+
+func (m *MockService) CheckPassword(ctx context.Context, user *repository.User, password string) error {
+	args := m.Called(ctx, user, password)
+	return args.Error(0)
+}
+
+func (m *MockService) CreateProduct(ctx context.Context, pvzID string, product repository.NewProduct, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, pvzID, product, userID)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockService) CreateProducts(ctx context.Context, pvzID string, products []repository.NewProduct, userID string) ([]*service.ProductBatchResult, error) {
+	args := m.Called(ctx, pvzID, products, userID)
+	return args.Get(0).([]*service.ProductBatchResult), args.Error(1)
+}
+
+func (m *MockService) FindProductsByBarcode(ctx context.Context, barcode string) ([]*repository.Product, error) {
+	args := m.Called(ctx, barcode)
+	return args.Get(0).([]*repository.Product), args.Error(1)
+}
+
+func (m *MockService) ListPVZ(ctx context.Context, filter repository.PVZFilter, page, limit int) ([]*repository.PVZWithReceptions, error) {
+	args := m.Called(ctx, filter, page, limit)
+	return args.Get(0).([]*repository.PVZWithReceptions), args.Error(1)
+}
+
+func (m *MockService) ListPVZByCursor(ctx context.Context, filter repository.PVZFilter, cursor string, limit int) ([]*repository.PVZWithReceptions, string, error) {
+	args := m.Called(ctx, filter, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]*repository.PVZWithReceptions), args.String(1), args.Error(2)
+}
+
+func (m *MockService) CreatePVZ(ctx context.Context, city string, location repository.PVZLocation) (*repository.PVZ, error) {
+	args := m.Called(ctx, city, location)
+	return args.Get(0).(*repository.PVZ), args.Error(1)
+}
+
+func (m *MockService) GetPVZ(ctx context.Context, pvzId string) (*repository.PVZWithReceptions, error) {
+	args := m.Called(ctx, pvzId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PVZWithReceptions), args.Error(1)
+}
+
+func (m *MockService) UpdatePVZ(ctx context.Context, pvzId string, update repository.PVZUpdate) (*repository.PVZ, error) {
+	args := m.Called(ctx, pvzId, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PVZ), args.Error(1)
+}
+
+func (m *MockService) ListNearbyPVZ(ctx context.Context, lat, lon, radius float64, limit int) ([]*repository.NearbyPVZ, error) {
+	args := m.Called(ctx, lat, lon, radius, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.NearbyPVZ), args.Error(1)
+}
+
+func (m *MockService) DeactivatePVZ(ctx context.Context, pvzId string) (*repository.PVZ, error) {
+	args := m.Called(ctx, pvzId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PVZ), args.Error(1)
+}
+
+func (m *MockService) CloseReception(ctx context.Context, pvzID, userID string) (*repository.Reception, error) {
+	args := m.Called(ctx, pvzID, userID)
+	return args.Get(0).(*repository.Reception), args.Error(1)
+}
+
+func (m *MockService) ReceptionsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ReceptionReportRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ReceptionReportRow), args.Error(1)
+}
+
+func (m *MockService) ProductsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ProductReportRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ProductReportRow), args.Error(1)
+}
+
+// ExportReceptions передает в fn строки, заданные в Return, и возвращает заданную ошибку
+func (m *MockService) ExportReceptions(ctx context.Context, startDate, endDate *time.Time, fn func(*repository.ReceptionExportRow) error) error {
+	args := m.Called(ctx, startDate, endDate)
+	if rows, ok := args.Get(0).([]*repository.ReceptionExportRow); ok {
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockService) SetReceptionManifest(ctx context.Context, receptionID string, items []*repository.ManifestItem, blockOnDiscrepancies bool, userID string) (*repository.ReceptionManifest, error) {
+	args := m.Called(ctx, receptionID, items, blockOnDiscrepancies, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionManifest), args.Error(1)
+}
+
+func (m *MockService) ListReceptions(ctx context.Context, pvzID string, filter repository.ReceptionFilter) ([]*repository.Reception, error) {
+	args := m.Called(ctx, pvzID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.Reception), args.Error(1)
+}
+
+func (m *MockService) GetReception(ctx context.Context, receptionID string) (*repository.ReceptionWithProducts, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionWithProducts), args.Error(1)
+}
+
+func (m *MockService) ReopenReception(ctx context.Context, receptionID, reason, userID string) (*repository.Reception, error) {
+	args := m.Called(ctx, receptionID, reason, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reception), args.Error(1)
+}
+
+func (m *MockService) AmendReception(ctx context.Context, receptionID string, amendment repository.ReceptionAmendment, userID string) (*repository.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionID, amendment, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionCorrection), args.Error(1)
+}
+
+func (m *MockService) ListReceptionCorrections(ctx context.Context, receptionID string) ([]*repository.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ReceptionCorrection), args.Error(1)
+}
+
+func (m *MockService) GetReceptionManifest(ctx context.Context, receptionID string) (*repository.ReceptionManifest, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionManifest), args.Error(1)
+}
+
+func (m *MockService) GetReconciliation(ctx context.Context, receptionID string) (*repository.Reconciliation, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reconciliation), args.Error(1)
+}
+
+func (m *MockService) AcknowledgeReconciliation(ctx context.Context, receptionID, userID string) (*repository.Reconciliation, error) {
+	args := m.Called(ctx, receptionID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reconciliation), args.Error(1)
+}
+
+func (m *MockService) DeleteProduct(ctx context.Context, pvzID, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, pvzID, userID)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockService) CreateReception(ctx context.Context, pvzID, userID string) (*repository.Reception, error) {
+	args := m.Called(ctx, pvzID, userID)
+	return args.Get(0).(*repository.Reception), args.Error(1)
+}
+
+func (m *MockService) RegisterUser(ctx context.Context, email, password, role string) (*repository.User, error) {
+	args := m.Called(ctx, email, password, role)
+	return args.Get(0).(*repository.User), args.Error(1)
+}
+
+func (m *MockService) ListAllPVZ(ctx context.Context) ([]*repository.PVZ, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.PVZ), args.Error(1)
+}
+
+func (m *MockService) IssueTokens(ctx context.Context, user *repository.User) (*service.TokenPair, error) {
+	args := m.Called(ctx, user)
+	return args.Get(0).(*service.TokenPair), args.Error(1)
+}
+
+func (m *MockService) RefreshTokens(ctx context.Context, refreshToken string) (*service.TokenPair, error) {
+	args := m.Called(ctx, refreshToken)
+	return args.Get(0).(*service.TokenPair), args.Error(1)
+}
+
+func (m *MockService) Logout(ctx context.Context, userID, jti string, expiresAt time.Time, refreshToken string) error {
+	args := m.Called(ctx, userID, jti, expiresAt, refreshToken)
+	return args.Error(0)
+}
+
+func (m *MockService) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockService) IssueProduct(ctx context.Context, productID, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, productID, userID)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockService) ReturnProduct(ctx context.Context, productID, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, productID, userID)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockService) GetProductHistory(ctx context.Context, productID string) ([]*repository.ProductStatusChange, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).([]*repository.ProductStatusChange), args.Error(1)
+}
+
+func (m *MockService) CreateWebhookSubscription(ctx context.Context, webhookURL string, eventTypes []string) (*repository.WebhookSubscription, error) {
+	args := m.Called(ctx, webhookURL, eventTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockService) ListWebhookSubscriptions(ctx context.Context) ([]*repository.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockService) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockService) ListCities(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) CreateCity(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) DeleteCity(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockService) ListProductTypes(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) CreateProductType(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) DeleteProductType(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockService) ListPVZEmployees(ctx context.Context, pvzID string) ([]*repository.PVZEmployee, error) {
+	args := m.Called(ctx, pvzID)
+	return args.Get(0).([]*repository.PVZEmployee), args.Error(1)
+}
+
+func (m *MockService) AssignEmployee(ctx context.Context, pvzID, userID string) (*repository.PVZEmployee, error) {
+	args := m.Called(ctx, pvzID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PVZEmployee), args.Error(1)
+}
+
+func (m *MockService) UnassignEmployee(ctx context.Context, pvzID, userID string) error {
+	args := m.Called(ctx, pvzID, userID)
+	return args.Error(0)
+}
+
+func (m *MockService) ListAuditRecords(ctx context.Context, filter repository.AuditFilter) ([]*repository.AuditRecord, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*repository.AuditRecord), args.Error(1)
+}
+
+func (m *MockService) BeginIdempotentRequest(ctx context.Context, userID, key, requestHash string) (*repository.IdempotencyRecord, error) {
+	args := m.Called(ctx, userID, key, requestHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.IdempotencyRecord), args.Error(1)
+}
+
+func (m *MockService) CompleteIdempotentRequest(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error {
+	args := m.Called(ctx, userID, key, statusCode, contentType, body)
+	return args.Error(0)
+}
+
+func (m *MockService) ReleaseIdempotentRequest(ctx context.Context, userID, key string) error {
+	args := m.Called(ctx, userID, key)
+	return args.Error(0)
+}
+
+func TestGRPCHandler_Login(t *testing.T) {
+	user := &repository.User{
+		ID:    "user123",
+		Email: "test@example.com",
+		Role:  "employee",
+	}
+
+	tests := []struct {
+		name         string
+		password     string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name:     "successful login",
+			password: "password123",
+			mockSetup: func(ms *MockService) {
+				ms.On("GetUserByEmail", mock.Anything, "test@example.com").Return(user, nil)
+				ms.On("CheckPassword", mock.Anything, user, "password123").Return(nil)
+				ms.On("IssueTokens", mock.Anything, user).
+					Return(&service.TokenPair{AccessToken: "access", RefreshToken: "refresh"}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:     "invalid password",
+			password: "wrong",
+			mockSetup: func(ms *MockService) {
+				ms.On("GetUserByEmail", mock.Anything, "test@example.com").Return(user, nil)
+				ms.On("CheckPassword", mock.Anything, user, "wrong").Return(errors.New("invalid password"))
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			resp, err := handler.Login(context.Background(), &pvz_v1.LoginRequest{
+				Email:    "test@example.com",
+				Password: tt.password,
+			})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, "access", resp.GetToken())
+				assert.Equal(t, "refresh", resp.GetRefreshToken())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_RefreshToken(t *testing.T) {
+	tests := []struct {
+		name         string
+		refreshToken string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name:         "successful refresh",
+			refreshToken: "refresh",
+			mockSetup: func(ms *MockService) {
+				ms.On("RefreshTokens", mock.Anything, "refresh").
+					Return(&service.TokenPair{AccessToken: "access", RefreshToken: "refresh2"}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "invalid refresh token",
+			refreshToken: "refresh",
+			mockSetup: func(ms *MockService) {
+				ms.On("RefreshTokens", mock.Anything, "refresh").
+					Return((*service.TokenPair)(nil), service.ErrInvalidRefreshToken)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "missing refresh token",
+			mockSetup:    func(ms *MockService) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			_, err := handler.RefreshToken(context.Background(), &pvz_v1.RefreshTokenRequest{RefreshToken: tt.refreshToken})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_DummyLogin(t *testing.T) {
+	pvzID := "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+	tests := []struct {
+		name         string
+		req          *pvz_v1.DummyLoginRequest
+		validRole    bool
+		expectedCode codes.Code
+	}{
+		{
+			name:         "invalid role",
+			req:          &pvz_v1.DummyLoginRequest{Role: "invalid"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "employee assigned to pvz",
+			req:          &pvz_v1.DummyLoginRequest{Role: "employee", PvzId: pvzID},
+			validRole:    true,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "pvz for moderator",
+			req:          &pvz_v1.DummyLoginRequest{Role: "moderator", PvzId: pvzID},
+			validRole:    true,
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid pvz id",
+			req:          &pvz_v1.DummyLoginRequest{Role: "employee", PvzId: "pvz1"},
+			validRole:    true,
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			mockService.On("IsValidRole", service.UserRole(tt.req.GetRole())).Return(tt.validRole)
+			handler := NewGRPCHandler(mockService)
+
+			resp, err := handler.DummyLogin(context.Background(), tt.req)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				claims, err := utils.ParseJWT(resp.GetToken())
+				assert.NoError(t, err)
+				assert.Equal(t, tt.req.GetPvzId(), claims["pvz_id"])
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_CreatePVZ(t *testing.T) {
+	lat, lon := 55.7558, 37.6173
+	location := repository.PVZLocation{Latitude: &lat, Longitude: &lon}
+	mockService := new(MockService)
+	mockService.On("CreatePVZ", mock.Anything, "Москва", location).
+		Return(&repository.PVZ{ID: "pvz123", City: "Москва", PVZLocation: location}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.CreatePVZ(context.Background(), &pvz_v1.CreatePVZRequest{
+		City:     "Москва",
+		Location: &pvz_v1.PVZLocation{Latitude: &lat, Longitude: &lon},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "pvz123", resp.GetPvz().GetId())
+	assert.Equal(t, "Москва", resp.GetPvz().GetCity())
+	assert.Equal(t, lat, resp.GetPvz().GetLocation().GetLatitude())
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_ListPVZ(t *testing.T) {
+	startDate := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)
+	city, receptionStatus := "Moscow", "close"
+
+	tests := []struct {
+		name         string
+		request      *pvz_v1.ListPVZRequest
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+		expectedLen  int
+		expectedNext string
+	}{
+		{
+			name:    "defaults applied",
+			request: &pvz_v1.ListPVZRequest{},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZByCursor", mock.Anything, repository.PVZFilter{}, "", 10).
+					Return([]*repository.PVZWithReceptions{
+						{
+							PVZ: &repository.PVZ{ID: "pvz123"},
+							Receptions: []*repository.ReceptionWithProducts{
+								{
+									Reception: &repository.Reception{ID: "rc123", Status: "close"},
+									Products:  []*repository.Product{{ID: "p123"}},
+								},
+							},
+						},
+					}, "next1", nil)
+			},
+			expectedCode: codes.OK,
+			expectedLen:  1,
+			expectedNext: "next1",
+		},
+		{
+			name: "date filter passed through",
+			request: &pvz_v1.ListPVZRequest{
+				StartDate: timestamppb.New(startDate),
+				Page:      2,
+				Limit:     5,
+			},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZ", mock.Anything, repository.PVZFilter{StartDate: &startDate}, 2, 5).
+					Return([]*repository.PVZWithReceptions{}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:    "cursor passed through",
+			request: &pvz_v1.ListPVZRequest{Cursor: "next1", Limit: 100},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZByCursor", mock.Anything, repository.PVZFilter{}, "next1", 100).
+					Return([]*repository.PVZWithReceptions{}, "", nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:    "filters passed through",
+			request: &pvz_v1.ListPVZRequest{City: &city, ReceptionStatus: &receptionStatus, IncludeEmpty: true},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZByCursor", mock.Anything, repository.PVZFilter{
+					City:            &city,
+					ReceptionStatus: &receptionStatus,
+					IncludeEmpty:    true,
+				}, "", 10).Return([]*repository.PVZWithReceptions{}, "", nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "page with cursor",
+			request:      &pvz_v1.ListPVZRequest{Page: 1, Cursor: "next1"},
+			mockSetup:    func(ms *MockService) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "limit too large",
+			request:      &pvz_v1.ListPVZRequest{Limit: 101},
+			mockSetup:    func(ms *MockService) {},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			resp, err := handler.ListPVZ(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Len(t, resp.GetPvzs(), tt.expectedLen)
+			assert.Equal(t, tt.expectedNext, resp.GetNextCursor())
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_CloseLastReception(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("CloseReception", mock.Anything, "pvz123", "user123").
+		Return(&repository.Reception{ID: "rc123", PVZID: "pvz123", Status: "close"}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+	resp, err := handler.CloseLastReception(ctx, &pvz_v1.CloseLastReceptionRequest{PvzId: "pvz123"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, resp.GetReception().GetStatus())
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_CloseLastReception_UnacknowledgedDiscrepancies(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("CloseReception", mock.Anything, "pvz123", "user123").
+		Return((*repository.Reception)(nil), service.ErrUnacknowledgedDiscrepancies)
+	handler := NewGRPCHandler(mockService)
+
+	ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+	_, err := handler.CloseLastReception(ctx, &pvz_v1.CloseLastReceptionRequest{PvzId: "pvz123"})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_ListReceptions(t *testing.T) {
+	receptionStatus := "close"
+	mockService := new(MockService)
+	mockService.On("ListReceptions", mock.Anything, "pvz123", repository.ReceptionFilter{Status: &receptionStatus, Page: 1, Limit: 20}).
+		Return([]*repository.Reception{{ID: "rc123", PVZID: "pvz123", Status: "close"}}, nil)
+	mockService.On("ListReceptions", mock.Anything, "pvz123", repository.ReceptionFilter{Page: 1, Limit: 20}).
+		Return(nil, service.ErrInvalidReceptionFilter)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.ListReceptions(context.Background(), &pvz_v1.ListReceptionsRequest{PvzId: "pvz123", Status: &receptionStatus})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetReceptions(), 1)
+	assert.Equal(t, "rc123", resp.GetReceptions()[0].GetId())
+
+	_, err = handler.ListReceptions(context.Background(), &pvz_v1.ListReceptionsRequest{PvzId: "pvz123"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = handler.ListReceptions(context.Background(), &pvz_v1.ListReceptionsRequest{PvzId: "pvz123", Limit: 101})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_CreateReception(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockErr  error
+		wantCode codes.Code
+	}{
+		{name: "success", wantCode: codes.OK},
+		{name: "reception in progress", mockErr: fmt.Errorf("%w: pvz123", service.ErrReceptionInProgress), wantCode: codes.FailedPrecondition},
+		{name: "not assigned", mockErr: service.ErrEmployeeNotAssigned, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.mockErr != nil {
+				mockService.On("CreateReception", mock.Anything, "pvz123", "user123").Return((*repository.Reception)(nil), tt.mockErr)
+			} else {
+				mockService.On("CreateReception", mock.Anything, "pvz123", "user123").
+					Return(&repository.Reception{ID: "rc123", PVZID: "pvz123", Status: "in_progress"}, nil)
+			}
+			handler := NewGRPCHandler(mockService)
+
+			ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+			resp, err := handler.CreateReception(ctx, &pvz_v1.CreateReceptionRequest{PvzId: "pvz123"})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "rc123", resp.GetReception().GetId())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_GetReception(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("GetReception", mock.Anything, "rc123").Return(&repository.ReceptionWithProducts{
+		Reception: &repository.Reception{ID: "rc123", PVZID: "pvz123", Status: "close"},
+		Products:  []*repository.Product{{ID: "p123", ReceptionId: "rc123", Type: "обувь"}},
+	}, nil)
+	mockService.On("GetReception", mock.Anything, "missing").Return(nil, service.ErrReceptionNotFound)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.GetReception(context.Background(), &pvz_v1.GetReceptionRequest{ReceptionId: "rc123"})
+	assert.NoError(t, err)
+	assert.Equal(t, "rc123", resp.GetReception().GetReception().GetId())
+	assert.Len(t, resp.GetReception().GetProducts(), 1)
+
+	_, err = handler.GetReception(context.Background(), &pvz_v1.GetReceptionRequest{ReceptionId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_SetReceptionManifest(t *testing.T) {
+	productType := "обувь"
+	tests := []struct {
+		name     string
+		mockErr  error
+		wantCode codes.Code
+	}{
+		{name: "success", wantCode: codes.OK},
+		{name: "invalid manifest", mockErr: service.ErrInvalidManifest, wantCode: codes.InvalidArgument},
+		{name: "reception not found", mockErr: service.ErrReceptionNotFound, wantCode: codes.NotFound},
+		{name: "reception closed", mockErr: service.ErrReceptionClosed, wantCode: codes.FailedPrecondition},
+		{name: "not assigned", mockErr: service.ErrEmployeeNotAssigned, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			items := []*repository.ManifestItem{{Type: &productType, Quantity: 2}}
+			if tt.mockErr != nil {
+				mockService.On("SetReceptionManifest", mock.Anything, "rc123", items, true, "user123").Return(nil, tt.mockErr)
+			} else {
+				mockService.On("SetReceptionManifest", mock.Anything, "rc123", items, true, "user123").
+					Return(&repository.ReceptionManifest{ReceptionID: "rc123", BlockOnDiscrepancies: true, Items: items}, nil)
+			}
+			handler := NewGRPCHandler(mockService)
+
+			ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+			resp, err := handler.SetReceptionManifest(ctx, &pvz_v1.SetReceptionManifestRequest{
+				ReceptionId:          "rc123",
+				Items:                []*pvz_v1.ManifestItem{{Type: &productType, Quantity: 2}},
+				BlockOnDiscrepancies: true,
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "rc123", resp.GetManifest().GetReceptionId())
+				assert.Len(t, resp.GetManifest().GetItems(), 1)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_GetReconciliation(t *testing.T) {
+	productType := "обувь"
+	acknowledgedBy := "user123"
+	mockService := new(MockService)
+	mockService.On("GetReconciliation", mock.Anything, "rc123").Return(&repository.Reconciliation{
+		ReceptionID: "rc123",
+		Report: repository.ReconciliationReport{
+			Matched: []*repository.ManifestItem{},
+			Missing: []*repository.ManifestItem{{Type: &productType, Quantity: 1}},
+			Extra:   []*repository.ManifestItem{},
+		},
+		HasDiscrepancies: true,
+		AcknowledgedBy:   &acknowledgedBy,
+	}, nil)
+	mockService.On("GetReconciliation", mock.Anything, "missing").Return(nil, service.ErrManifestNotFound)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.GetReconciliation(context.Background(), &pvz_v1.GetReconciliationRequest{ReceptionId: "rc123"})
+	assert.NoError(t, err)
+	assert.True(t, resp.GetReconciliation().GetHasDiscrepancies())
+	assert.Equal(t, "user123", resp.GetReconciliation().GetAcknowledgedBy())
+	assert.Equal(t, int32(1), resp.GetReconciliation().GetMissing()[0].GetQuantity())
+
+	_, err = handler.GetReconciliation(context.Background(), &pvz_v1.GetReconciliationRequest{ReceptionId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_ReopenReception(t *testing.T) {
+	tests := []struct {
+		name     string
+		mockErr  error
+		wantCode codes.Code
+	}{
+		{name: "success", wantCode: codes.OK},
+		{name: "empty reason", mockErr: service.ErrInvalidCorrection, wantCode: codes.InvalidArgument},
+		{name: "reception not found", mockErr: service.ErrReceptionNotFound, wantCode: codes.NotFound},
+		{name: "reception not closed", mockErr: service.ErrReceptionNotClosed, wantCode: codes.FailedPrecondition},
+		{name: "newer reception exists", mockErr: service.ErrNewerReceptionExists, wantCode: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.mockErr != nil {
+				mockService.On("ReopenReception", mock.Anything, "rc123", "ошибка", "user123").Return(nil, tt.mockErr)
+			} else {
+				mockService.On("ReopenReception", mock.Anything, "rc123", "ошибка", "user123").
+					Return(&repository.Reception{ID: "rc123", PVZID: "pvz123", Status: "reopened"}, nil)
+			}
+			handler := NewGRPCHandler(mockService)
+
+			ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+			resp, err := handler.ReopenReception(ctx, &pvz_v1.ReopenReceptionRequest{ReceptionId: "rc123", Reason: "ошибка"})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_REOPENED, resp.GetReception().GetStatus())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_AmendReception(t *testing.T) {
+	barcode := "4601234567890"
+	amendment := repository.ReceptionAmendment{
+		AddProducts:      []repository.NewProduct{{Type: "обувь", ProductDetails: repository.ProductDetails{Barcode: &barcode}}},
+		RemoveProductIDs: []string{"p1"},
+		Reason:           "пересорт",
+	}
+	tests := []struct {
+		name     string
+		mockErr  error
+		wantCode codes.Code
+	}{
+		{name: "success", wantCode: codes.OK},
+		{name: "invalid product type", mockErr: service.ErrInvalidProductType, wantCode: codes.InvalidArgument},
+		{name: "product not amendable", mockErr: service.ErrProductNotAmendable, wantCode: codes.FailedPrecondition},
+		{name: "duplicate barcode", mockErr: service.ErrDuplicateBarcode, wantCode: codes.AlreadyExists},
+		{name: "internal error", mockErr: errors.New("db error"), wantCode: codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.mockErr != nil {
+				mockService.On("AmendReception", mock.Anything, "rc123", amendment, "user123").Return(nil, tt.mockErr)
+			} else {
+				mockService.On("AmendReception", mock.Anything, "rc123", amendment, "user123").Return(&repository.ReceptionCorrection{
+					ID:          "c1",
+					ReceptionID: "rc123",
+					Action:      repository.CorrectionActionAmend,
+					Reason:      "пересорт",
+					Changes: repository.ReceptionChanges{
+						Added:   []*repository.Product{{ID: "p2", ReceptionId: "rc123", Type: "обувь", Status: "stored"}},
+						Removed: []*repository.Product{{ID: "p1", ReceptionId: "rc123", Type: "одежда", Status: "stored"}},
+					},
+				}, nil)
+			}
+			handler := NewGRPCHandler(mockService)
+
+			ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+			resp, err := handler.AmendReception(ctx, &pvz_v1.AmendReceptionRequest{
+				ReceptionId:      "rc123",
+				Reason:           "пересорт",
+				AddProducts:      []*pvz_v1.NewProduct{{Type: "обувь", Details: &pvz_v1.ProductDetails{Barcode: &barcode}}},
+				RemoveProductIds: []string{"p1"},
+			})
+
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			if tt.wantCode == codes.OK {
+				assert.Equal(t, "amend", resp.GetCorrection().GetAction())
+				assert.Equal(t, "p2", resp.GetCorrection().GetAdded()[0].GetId())
+				assert.Equal(t, "p1", resp.GetCorrection().GetRemoved()[0].GetId())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_ListReceptionCorrections(t *testing.T) {
+	changedBy := "user123"
+	mockService := new(MockService)
+	mockService.On("ListReceptionCorrections", mock.Anything, "rc123").Return([]*repository.ReceptionCorrection{
+		{ID: "c1", ReceptionID: "rc123", Action: repository.CorrectionActionReopen, Reason: "ошибка", ChangedBy: &changedBy},
+	}, nil)
+	mockService.On("ListReceptionCorrections", mock.Anything, "missing").Return(nil, service.ErrReceptionNotFound)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.ListReceptionCorrections(context.Background(), &pvz_v1.ListReceptionCorrectionsRequest{ReceptionId: "rc123"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetCorrections(), 1)
+	assert.Equal(t, "user123", resp.GetCorrections()[0].GetChangedBy())
+
+	_, err = handler.ListReceptionCorrections(context.Background(), &pvz_v1.ListReceptionCorrectionsRequest{ReceptionId: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_AddProduct(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name: "successful product creation",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProduct", mock.Anything, "pvz123", repository.NewProduct{Type: "обувь"}, "user123").
+					Return(&repository.Product{ID: "p123", ReceptionId: "rc123", Type: "обувь"}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "no reception in progress",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProduct", mock.Anything, "pvz123", repository.NewProduct{Type: "обувь"}, "user123").
+					Return((*repository.Product)(nil), errors.New("last reception is closed"))
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "employee not assigned",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProduct", mock.Anything, "pvz123", repository.NewProduct{Type: "обувь"}, "user123").
+					Return((*repository.Product)(nil), service.ErrEmployeeNotAssigned)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name: "barcode already scanned",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProduct", mock.Anything, "pvz123", repository.NewProduct{Type: "обувь"}, "user123").
+					Return((*repository.Product)(nil), service.ErrDuplicateBarcode)
+			},
+			expectedCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+			_, err := handler.AddProduct(ctx, &pvz_v1.AddProductRequest{
+				PvzId: "pvz123",
+				Type:  "обувь",
+			})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_AddProductWithDetails(t *testing.T) {
+	barcode := "4601234567890"
+	weight := 1200
+	product := repository.NewProduct{
+		Type: "обувь",
+		ProductDetails: repository.ProductDetails{
+			Barcode:     &barcode,
+			WeightGrams: &weight,
+			Attributes:  repository.ProductAttributes{"order": "A-15"},
+		},
+	}
+	mockService := new(MockService)
+	mockService.On("CreateProduct", mock.Anything, "pvz123", product, "user123").
+		Return(&repository.Product{ID: "p123", ReceptionId: "rc123", Type: "обувь", ProductDetails: product.ProductDetails}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+	grpcWeight := int32(weight)
+	resp, err := handler.AddProduct(ctx, &pvz_v1.AddProductRequest{
+		PvzId: "pvz123",
+		Type:  "обувь",
+		Details: &pvz_v1.ProductDetails{
+			Barcode:     &barcode,
+			WeightGrams: &grpcWeight,
+			Attributes:  map[string]string{"order": "A-15"},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, barcode, resp.GetProduct().GetDetails().GetBarcode())
+	assert.Equal(t, grpcWeight, resp.GetProduct().GetDetails().GetWeightGrams())
+	assert.Equal(t, "A-15", resp.GetProduct().GetDetails().GetAttributes()["order"])
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_FindProductsByBarcode(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("FindProductsByBarcode", mock.Anything, "4601234567890").
+		Return([]*repository.Product{{ID: "p123", ReceptionId: "rc123"}}, nil)
+	mockService.On("FindProductsByBarcode", mock.Anything, "").
+		Return([]*repository.Product(nil), service.ErrInvalidProductDetails)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.FindProductsByBarcode(context.Background(), &pvz_v1.FindProductsByBarcodeRequest{Barcode: "4601234567890"})
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetProducts(), 1)
+
+	_, err = handler.FindProductsByBarcode(context.Background(), &pvz_v1.FindProductsByBarcodeRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+// addProductsStream отдает заранее заданные сообщения клиентского потока и запоминает ответ
+type addProductsStream struct {
+	pvz_v1.PVZService_AddProductsServer
+	ctx      context.Context
+	requests []*pvz_v1.AddProductRequest
+	response *pvz_v1.AddProductsResponse
+}
+
+func (s *addProductsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *addProductsStream) Recv() (*pvz_v1.AddProductRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *addProductsStream) SendAndClose(resp *pvz_v1.AddProductsResponse) error {
+	s.response = resp
+	return nil
+}
+
+func TestGRPCHandler_AddProducts(t *testing.T) {
+	tests := []struct {
+		name         string
+		requests     []*pvz_v1.AddProductRequest
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name: "successful batch with invalid type",
+			requests: []*pvz_v1.AddProductRequest{
+				{PvzId: "pvz123", Type: "обувь"},
+				{PvzId: "pvz123", Type: "еда"},
+			},
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProducts", mock.Anything, "pvz123", []repository.NewProduct{{Type: "обувь"}, {Type: "еда"}}, "user123").
+					Return([]*service.ProductBatchResult{
+						{Product: &repository.Product{ID: "p123", ReceptionId: "rc123", Type: "обувь"}},
+						{Err: errors.New("invalid product type: еда")},
+					}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "products of different pvz",
+			requests: []*pvz_v1.AddProductRequest{
+				{PvzId: "pvz123", Type: "обувь"},
+				{PvzId: "pvz456", Type: "обувь"},
+			},
+			mockSetup:    func(ms *MockService) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:     "employee not assigned",
+			requests: []*pvz_v1.AddProductRequest{{PvzId: "pvz123", Type: "обувь"}},
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProducts", mock.Anything, "pvz123", []repository.NewProduct{{Type: "обувь"}}, "user123").
+					Return([]*service.ProductBatchResult(nil), service.ErrEmployeeNotAssigned)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			stream := &addProductsStream{
+				ctx:      context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"}),
+				requests: tt.requests,
+			}
+			err := handler.AddProducts(stream)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				results := stream.response.GetResults()
+				assert.Len(t, results, 2)
+				assert.Equal(t, "p123", results[0].GetProduct().GetId())
+				assert.Equal(t, int32(1), results[1].GetIndex())
+				assert.Equal(t, "invalid product type: еда", results[1].GetError())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_DeleteLastProduct(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("DeleteProduct", mock.Anything, "pvz123", "user123").
+		Return(&repository.Product{ID: "p123", ReceptionId: "rc123"}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+	resp, err := handler.DeleteLastProduct(ctx, &pvz_v1.DeleteLastProductRequest{PvzId: "pvz123"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "p123", resp.GetProduct().GetId())
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_IssueProduct(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name: "successful issue",
+			mockSetup: func(ms *MockService) {
+				ms.On("IssueProduct", mock.Anything, "p123", "user123").
+					Return(&repository.Product{ID: "p123", Status: "issued"}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "illegal transition",
+			mockSetup: func(ms *MockService) {
+				ms.On("IssueProduct", mock.Anything, "p123", "user123").
+					Return((*repository.Product)(nil), service.ErrInvalidProductStatusTransition)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "product not found",
+			mockSetup: func(ms *MockService) {
+				ms.On("IssueProduct", mock.Anything, "p123", "user123").
+					Return((*repository.Product)(nil), service.ErrProductNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "user123"})
+			resp, err := handler.IssueProduct(ctx, &pvz_v1.IssueProductRequest{ProductId: "p123"})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, pvz_v1.ProductStatus_PRODUCT_STATUS_ISSUED, resp.GetProduct().GetStatus())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_GetProductHistory(t *testing.T) {
+	fromStatus := "accepted"
+	mockService := new(MockService)
+	mockService.On("GetProductHistory", mock.Anything, "p123").
+		Return([]*repository.ProductStatusChange{{ID: "h1", ProductID: "p123", FromStatus: &fromStatus, ToStatus: "stored"}}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.GetProductHistory(context.Background(), &pvz_v1.GetProductHistoryRequest{ProductId: "p123"})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetHistory(), 1)
+	assert.Equal(t, pvz_v1.ProductStatus_PRODUCT_STATUS_ACCEPTED, resp.GetHistory()[0].GetFromStatus())
+	assert.Equal(t, pvz_v1.ProductStatus_PRODUCT_STATUS_STORED, resp.GetHistory()[0].GetToStatus())
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_CreateWebhook(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name: "successful create",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateWebhookSubscription", mock.Anything, "http://example.com/hook", []string{"PVZCreated"}).
+					Return(&repository.WebhookSubscription{ID: "w1", URL: "http://example.com/hook", Secret: "secret", EventTypes: []string{"PVZCreated"}}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "invalid subscription",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateWebhookSubscription", mock.Anything, "http://example.com/hook", []string{"PVZCreated"}).
+					Return(nil, service.ErrInvalidWebhookSubscription)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			resp, err := handler.CreateWebhook(context.Background(), &pvz_v1.CreateWebhookRequest{
+				Url:        "http://example.com/hook",
+				EventTypes: []string{"PVZCreated"},
+			})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, "secret", resp.GetSubscription().GetSecret())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_DeleteWebhook(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("DeleteWebhookSubscription", mock.Anything, "w1").Return(service.ErrWebhookSubscriptionNotFound)
+	handler := NewGRPCHandler(mockService)
+
+	_, err := handler.DeleteWebhook(context.Background(), &pvz_v1.DeleteWebhookRequest{WebhookId: "w1"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_CreateCity(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name: "successful create",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateCity", mock.Anything, "Новосибирск").
+					Return(&repository.DictionaryEntry{Name: "Новосибирск"}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "already exists",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateCity", mock.Anything, "Новосибирск").
+					Return(nil, service.ErrDictionaryEntryExists)
+			},
+			expectedCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			resp, err := handler.CreateCity(context.Background(), &pvz_v1.CreateCityRequest{Name: "Новосибирск"})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, "Новосибирск", resp.GetCity().GetName())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_DeleteProductType(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("DeleteProductType", mock.Anything, "книги").Return(service.ErrDictionaryEntryNotFound)
+	handler := NewGRPCHandler(mockService)
+
+	_, err := handler.DeleteProductType(context.Background(), &pvz_v1.DeleteProductTypeRequest{Name: "книги"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_GetPVZ(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("GetPVZ", mock.Anything, "pvz123").Return(&repository.PVZWithReceptions{
+		PVZ: &repository.PVZ{ID: "pvz123", City: "Москва", Status: "active"},
+	}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.GetPVZ(context.Background(), &pvz_v1.GetPVZRequest{PvzId: "pvz123"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, pvz_v1.PVZStatus_PVZ_STATUS_ACTIVE, resp.GetPvz().GetPvz().GetStatus())
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_UpdatePVZ(t *testing.T) {
+	street := "Баумана"
+	mockService := new(MockService)
+	mockService.On("UpdatePVZ", mock.Anything, "pvz123", repository.PVZUpdate{
+		PVZLocation: repository.PVZLocation{Street: &street},
+	}).Return(&repository.PVZ{ID: "pvz123", City: "Казань", PVZLocation: repository.PVZLocation{Street: &street}}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.UpdatePVZ(context.Background(), &pvz_v1.UpdatePVZRequest{
+		PvzId:    "pvz123",
+		Location: &pvz_v1.PVZLocation{Street: &street},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Казань", resp.GetPvz().GetCity())
+	assert.Equal(t, street, resp.GetPvz().GetLocation().GetStreet())
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_ListNearbyPVZ(t *testing.T) {
+	tests := []struct {
+		name         string
+		request      *pvz_v1.ListNearbyPVZRequest
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+		expectedLen  int
+	}{
+		{
+			name:    "default limit applied",
+			request: &pvz_v1.ListNearbyPVZRequest{Lat: 55.75, Lon: 37.61, Radius: 1000},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListNearbyPVZ", mock.Anything, 55.75, 37.61, 1000.0, 10).
+					Return([]*repository.NearbyPVZ{{PVZ: repository.PVZ{ID: "pvz123"}, Distance: 120}}, nil)
+			},
+			expectedCode: codes.OK,
+			expectedLen:  1,
+		},
+		{
+			name:    "invalid location",
+			request: &pvz_v1.ListNearbyPVZRequest{Lat: 95, Lon: 37.61, Radius: 1000},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListNearbyPVZ", mock.Anything, 95.0, 37.61, 1000.0, 10).Return(nil, service.ErrInvalidLocation)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "limit too large",
+			request:      &pvz_v1.ListNearbyPVZRequest{Lat: 55.75, Lon: 37.61, Radius: 1000, Limit: 31},
+			mockSetup:    func(ms *MockService) {},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:    "internal error",
+			request: &pvz_v1.ListNearbyPVZRequest{Lat: 55.75, Lon: 37.61, Radius: 1000},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListNearbyPVZ", mock.Anything, 55.75, 37.61, 1000.0, 10).Return(nil, errors.New("db error"))
+			},
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			resp, err := handler.ListNearbyPVZ(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Len(t, resp.GetPvzs(), tt.expectedLen)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_DeactivatePVZ(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name: "successful deactivation",
+			mockSetup: func(ms *MockService) {
+				deactivatedAt := time.Now()
+				ms.On("DeactivatePVZ", mock.Anything, "pvz123").
+					Return(&repository.PVZ{ID: "pvz123", Status: "inactive", DeactivatedAt: &deactivatedAt}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "reception in progress",
+			mockSetup: func(ms *MockService) {
+				ms.On("DeactivatePVZ", mock.Anything, "pvz123").Return(nil, service.ErrReceptionInProgress)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "pvz not found",
+			mockSetup: func(ms *MockService) {
+				ms.On("DeactivatePVZ", mock.Anything, "pvz123").Return(nil, service.ErrPVZNotFound)
+			},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			resp, err := handler.DeactivatePVZ(context.Background(), &pvz_v1.DeactivatePVZRequest{PvzId: "pvz123"})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, pvz_v1.PVZStatus_PVZ_STATUS_INACTIVE, resp.GetPvz().GetStatus())
+				assert.NotNil(t, resp.GetPvz().GetDeactivatedAt())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_AssignEmployee(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(*MockService)
+		expectedCode codes.Code
+	}{
+		{
+			name: "successful assignment",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, "pvz123", "user123").Return(&repository.PVZEmployee{
+					PVZID: "pvz123", UserID: "user123", Email: "employee@example.com", AssignedAt: time.Now(),
+				}, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "user not employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, "pvz123", "user123").Return(nil, service.ErrUserNotEmployee)
+			},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "already assigned",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, "pvz123", "user123").Return(nil, service.ErrAssignmentExists)
+			},
+			expectedCode: codes.AlreadyExists,
+		},
+		{
+			name: "pvz inactive",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, "pvz123", "user123").Return(nil, service.ErrPVZInactive)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewGRPCHandler(mockService)
+
+			_, err := handler.AssignEmployee(context.Background(), &pvz_v1.AssignEmployeeRequest{PvzId: "pvz123", UserId: "user123"})
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGRPCHandler_UnassignEmployee(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("UnassignEmployee", mock.Anything, "pvz123", "user123").Return(service.ErrAssignmentNotFound)
+	handler := NewGRPCHandler(mockService)
+
+	_, err := handler.UnassignEmployee(context.Background(), &pvz_v1.UnassignEmployeeRequest{PvzId: "pvz123", UserId: "user123"})
+
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_ListAuditRecords(t *testing.T) {
+	entityType := "pvz"
+	actorID := "moderator1"
+	mockService := new(MockService)
+	mockService.On("ListAuditRecords", mock.Anything, repository.AuditFilter{EntityType: &entityType, Page: 1, Limit: 20}).
+		Return([]*repository.AuditRecord{{
+			ID:         "audit1",
+			ActorID:    &actorID,
+			Action:     "update",
+			EntityType: "pvz",
+			EntityID:   "pvz1",
+			After:      []byte(`{"city":"Казань"}`),
+			CreatedAt:  time.Now(),
+		}}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.ListAuditRecords(context.Background(), &pvz_v1.ListAuditRecordsRequest{EntityType: &entityType})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetRecords(), 1)
+	assert.Equal(t, actorID, resp.GetRecords()[0].GetActorId())
+	assert.Empty(t, resp.GetRecords()[0].GetBefore())
+	mockService.AssertExpectations(t)
+
+	_, err = handler.ListAuditRecords(context.Background(), &pvz_v1.ListAuditRecordsRequest{Limit: 101})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestGRPCHandler_GetReceptionsReport(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	city := "Москва"
+	mockService := new(MockService)
+	mockService.On("ReceptionsReport", mock.Anything, repository.ReportFilter{
+		From:    from,
+		To:      to,
+		Period:  "month",
+		GroupBy: []string{"city"},
+	}).Return([]*repository.ReceptionReportRow{{Period: from, City: &city, Receptions: 3, Closed: 2, Products: 10}}, nil)
+	handler := NewGRPCHandler(mockService)
+
+	resp, err := handler.GetReceptionsReport(context.Background(), &pvz_v1.GetReceptionsReportRequest{
+		From:    timestamppb.New(from),
+		To:      timestamppb.New(to),
+		Period:  "month",
+		GroupBy: []string{"city"},
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetRows(), 1)
+	assert.Equal(t, city, resp.GetRows()[0].GetCity())
+	assert.Nil(t, resp.GetRows()[0].PvzId)
+	assert.Equal(t, int32(10), resp.GetRows()[0].GetProducts())
+	mockService.AssertExpectations(t)
+}
+
+func TestGRPCHandler_GetProductsReport_InvalidFilter(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("ProductsReport", mock.Anything, repository.ReportFilter{}).
+		Return(nil, service.ErrInvalidReportFilter)
+	handler := NewGRPCHandler(mockService)
+
+	_, err := handler.GetProductsReport(context.Background(), &pvz_v1.GetProductsReportRequest{})
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	mockService.AssertExpectations(t)
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/logger"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxRequestIDLength ограничивает длину идентификатора, присланного клиентом
+const maxRequestIDLength = 128
+
+// LoggingUnaryInterceptor назначает вызову идентификатор запроса из метаданных x-request-id
+// или новый, возвращает его в заголовке ответа и пишет вызов в лог
+func LoggingUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRequestID(ctx)
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		logCall(ctx, info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+func LoggingStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withRequestID(ss.Context())
+		start := time.Now()
+
+		err := handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+
+		logCall(ctx, info.FullMethod, start, err)
+		return err
+	}
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(strings.ToLower(logger.RequestIDHeader)); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.New().String()
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(strings.ToLower(logger.RequestIDHeader), requestID)); err != nil {
+		slog.DebugContext(ctx, "Error setting request id header", "error", err)
+	}
+	return logger.WithRequestID(ctx, requestID)
+}
+
+func logCall(ctx context.Context, method string, start time.Time, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.Unimplemented, codes.DeadlineExceeded:
+		level = slog.LevelError
+	}
+	slog.Log(ctx, level, "gRPC request",
+		"method", method,
+		"code", code.String(),
+		"duration", time.Since(start),
+	)
+}
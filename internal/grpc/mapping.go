@@ -0,0 +1,435 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1"
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/service"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func pvzStatusToGRPC(status string) pvz_v1.PVZStatus {
+	switch status {
+	case "active":
+		return pvz_v1.PVZStatus_PVZ_STATUS_ACTIVE
+	case "inactive":
+		return pvz_v1.PVZStatus_PVZ_STATUS_INACTIVE
+	default:
+		return pvz_v1.PVZStatus_PVZ_STATUS_UNSPECIFIED
+	}
+}
+
+func pvzRepositoryToGRPC(pvz *repository.PVZ) *pvz_v1.PVZ {
+	response := &pvz_v1.PVZ{
+		Id:               pvz.ID,
+		RegistrationDate: timestamppb.New(pvz.RegistrationDate),
+		City:             pvz.City,
+		Status:           pvzStatusToGRPC(pvz.Status),
+		Location: &pvz_v1.PVZLocation{
+			Street:       pvz.Street,
+			House:        pvz.House,
+			PostalCode:   pvz.PostalCode,
+			Latitude:     pvz.Latitude,
+			Longitude:    pvz.Longitude,
+			OpeningHours: pvz.OpeningHours,
+		},
+	}
+	if pvz.DeactivatedAt != nil {
+		response.DeactivatedAt = timestamppb.New(*pvz.DeactivatedAt)
+	}
+	return response
+}
+
+func pvzLocationGRPCToRepository(location *pvz_v1.PVZLocation) repository.PVZLocation {
+	if location == nil {
+		return repository.PVZLocation{}
+	}
+	return repository.PVZLocation{
+		Street:       location.Street,
+		House:        location.House,
+		PostalCode:   location.PostalCode,
+		Latitude:     location.Latitude,
+		Longitude:    location.Longitude,
+		OpeningHours: location.OpeningHours,
+	}
+}
+
+func pvzUpdateGRPCToRepository(req *pvz_v1.UpdatePVZRequest) repository.PVZUpdate {
+	return repository.PVZUpdate{
+		City:        req.City,
+		PVZLocation: pvzLocationGRPCToRepository(req.GetLocation()),
+	}
+}
+
+func nearbyPVZRepositoryToGRPC(nearby *repository.NearbyPVZ) *pvz_v1.NearbyPVZ {
+	return &pvz_v1.NearbyPVZ{
+		Pvz:      pvzRepositoryToGRPC(&nearby.PVZ),
+		Distance: nearby.Distance,
+	}
+}
+
+func receptionStatusToGRPC(status string) pvz_v1.ReceptionStatus {
+	switch status {
+	case "close":
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED
+	case "reopened":
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_REOPENED
+	case "amended":
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_AMENDED
+	default:
+		return pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+	}
+}
+
+func receptionRepositoryToGRPC(reception *repository.Reception) *pvz_v1.Reception {
+	return &pvz_v1.Reception{
+		Id:       reception.ID,
+		DateTime: timestamppb.New(reception.ExecutionDate),
+		PvzId:    reception.PVZID,
+		Status:   receptionStatusToGRPC(reception.Status),
+	}
+}
+
+func manifestItemsRepositoryToGRPC(items []*repository.ManifestItem) []*pvz_v1.ManifestItem {
+	response := make([]*pvz_v1.ManifestItem, len(items))
+	for i, item := range items {
+		response[i] = &pvz_v1.ManifestItem{
+			Barcode:  item.Barcode,
+			Type:     item.Type,
+			Quantity: int32(item.Quantity),
+		}
+	}
+	return response
+}
+
+func manifestItemsGRPCToRepository(items []*pvz_v1.ManifestItem) []*repository.ManifestItem {
+	result := make([]*repository.ManifestItem, len(items))
+	for i, item := range items {
+		result[i] = &repository.ManifestItem{
+			Barcode:  item.Barcode,
+			Type:     item.Type,
+			Quantity: int(item.GetQuantity()),
+		}
+	}
+	return result
+}
+
+func receptionManifestRepositoryToGRPC(manifest *repository.ReceptionManifest) *pvz_v1.ReceptionManifest {
+	response := &pvz_v1.ReceptionManifest{
+		ReceptionId:          manifest.ReceptionID,
+		BlockOnDiscrepancies: manifest.BlockOnDiscrepancies,
+		UploadedAt:           timestamppb.New(manifest.UploadedAt),
+		Items:                manifestItemsRepositoryToGRPC(manifest.Items),
+	}
+	if manifest.UploadedBy != nil {
+		response.UploadedBy = *manifest.UploadedBy
+	}
+	return response
+}
+
+func reconciliationRepositoryToGRPC(reconciliation *repository.Reconciliation) *pvz_v1.Reconciliation {
+	response := &pvz_v1.Reconciliation{
+		ReceptionId:      reconciliation.ReceptionID,
+		Matched:          manifestItemsRepositoryToGRPC(reconciliation.Report.Matched),
+		Missing:          manifestItemsRepositoryToGRPC(reconciliation.Report.Missing),
+		Extra:            manifestItemsRepositoryToGRPC(reconciliation.Report.Extra),
+		HasDiscrepancies: reconciliation.HasDiscrepancies,
+		CreatedAt:        timestamppb.New(reconciliation.CreatedAt),
+	}
+	if reconciliation.AcknowledgedBy != nil {
+		response.AcknowledgedBy = *reconciliation.AcknowledgedBy
+	}
+	if reconciliation.AcknowledgedAt != nil {
+		response.AcknowledgedAt = timestamppb.New(*reconciliation.AcknowledgedAt)
+	}
+	return response
+}
+
+func productStatusToGRPC(status string) pvz_v1.ProductStatus {
+	switch status {
+	case "accepted":
+		return pvz_v1.ProductStatus_PRODUCT_STATUS_ACCEPTED
+	case "stored":
+		return pvz_v1.ProductStatus_PRODUCT_STATUS_STORED
+	case "issued":
+		return pvz_v1.ProductStatus_PRODUCT_STATUS_ISSUED
+	case "returned":
+		return pvz_v1.ProductStatus_PRODUCT_STATUS_RETURNED
+	default:
+		return pvz_v1.ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+	}
+}
+
+func productRepositoryToGRPC(product *repository.Product) *pvz_v1.Product {
+	return &pvz_v1.Product{
+		Id:          product.ID,
+		DateTime:    timestamppb.New(product.ReceptionDate),
+		Type:        product.Type,
+		ReceptionId: product.ReceptionId,
+		Status:      productStatusToGRPC(product.Status),
+		Details: &pvz_v1.ProductDetails{
+			Barcode:     product.Barcode,
+			WeightGrams: intToInt32(product.WeightGrams),
+			LengthMm:    intToInt32(product.LengthMm),
+			WidthMm:     intToInt32(product.WidthMm),
+			HeightMm:    intToInt32(product.HeightMm),
+			Attributes:  product.Attributes,
+		},
+	}
+}
+
+func newProductGRPCToRepository(productType string, details *pvz_v1.ProductDetails) repository.NewProduct {
+	if details == nil {
+		return repository.NewProduct{Type: productType}
+	}
+	return repository.NewProduct{
+		Type: productType,
+		ProductDetails: repository.ProductDetails{
+			Barcode:     details.Barcode,
+			WeightGrams: int32ToInt(details.WeightGrams),
+			LengthMm:    int32ToInt(details.LengthMm),
+			WidthMm:     int32ToInt(details.WidthMm),
+			HeightMm:    int32ToInt(details.HeightMm),
+			Attributes:  details.Attributes,
+		},
+	}
+}
+
+func amendmentGRPCToRepository(req *pvz_v1.AmendReceptionRequest) repository.ReceptionAmendment {
+	amendment := repository.ReceptionAmendment{
+		Reason:           req.GetReason(),
+		RemoveProductIDs: req.GetRemoveProductIds(),
+	}
+	if len(req.GetAddProducts()) > 0 {
+		amendment.AddProducts = make([]repository.NewProduct, len(req.GetAddProducts()))
+		for i, product := range req.GetAddProducts() {
+			amendment.AddProducts[i] = newProductGRPCToRepository(product.GetType(), product.GetDetails())
+		}
+	}
+	return amendment
+}
+
+func receptionCorrectionRepositoryToGRPC(correction *repository.ReceptionCorrection) *pvz_v1.ReceptionCorrection {
+	response := &pvz_v1.ReceptionCorrection{
+		Id:          correction.ID,
+		ReceptionId: correction.ReceptionID,
+		Action:      correction.Action,
+		Reason:      correction.Reason,
+		Added:       make([]*pvz_v1.Product, len(correction.Changes.Added)),
+		Removed:     make([]*pvz_v1.Product, len(correction.Changes.Removed)),
+		CreatedAt:   timestamppb.New(correction.CreatedAt),
+	}
+	if correction.ChangedBy != nil {
+		response.ChangedBy = *correction.ChangedBy
+	}
+	for i, product := range correction.Changes.Added {
+		response.Added[i] = productRepositoryToGRPC(product)
+	}
+	for i, product := range correction.Changes.Removed {
+		response.Removed[i] = productRepositoryToGRPC(product)
+	}
+	return response
+}
+
+func intToInt32(value *int) *int32 {
+	if value == nil {
+		return nil
+	}
+	converted := int32(*value)
+	return &converted
+}
+
+func int32ToInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+	converted := int(*value)
+	return &converted
+}
+
+func productStatusChangeRepositoryToGRPC(change *repository.ProductStatusChange) *pvz_v1.ProductStatusChange {
+	response := &pvz_v1.ProductStatusChange{
+		Id:        change.ID,
+		ProductId: change.ProductID,
+		ToStatus:  productStatusToGRPC(change.ToStatus),
+		DateTime:  timestamppb.New(change.ChangedAt),
+	}
+	if change.FromStatus != nil {
+		response.FromStatus = productStatusToGRPC(*change.FromStatus)
+	}
+	if change.ChangedBy != nil {
+		response.ChangedBy = *change.ChangedBy
+	}
+	return response
+}
+
+func userRepositoryToGRPC(user *repository.User) *pvz_v1.User {
+	return &pvz_v1.User{
+		Id:    user.ID,
+		Email: user.Email,
+		Role:  user.Role,
+	}
+}
+
+func pvzWithReceptionsRepositoryToGRPC(pvz *repository.PVZWithReceptions) *pvz_v1.PVZWithReceptions {
+	receptions := make([]*pvz_v1.ReceptionWithProducts, len(pvz.Receptions))
+	for i, reception := range pvz.Receptions {
+		receptions[i] = receptionWithProductsRepositoryToGRPC(reception)
+	}
+	return &pvz_v1.PVZWithReceptions{
+		Pvz:        pvzRepositoryToGRPC(pvz.PVZ),
+		Receptions: receptions,
+	}
+}
+
+func receptionWithProductsRepositoryToGRPC(reception *repository.ReceptionWithProducts) *pvz_v1.ReceptionWithProducts {
+	products := make([]*pvz_v1.Product, len(reception.Products))
+	for i, product := range reception.Products {
+		products[i] = productRepositoryToGRPC(product)
+	}
+	return &pvz_v1.ReceptionWithProducts{
+		Reception: receptionRepositoryToGRPC(reception.Reception),
+		Products:  products,
+	}
+}
+
+func dictionaryEntriesRepositoryToGRPC(entries []*repository.DictionaryEntry) []*pvz_v1.DictionaryEntry {
+	response := make([]*pvz_v1.DictionaryEntry, len(entries))
+	for i, entry := range entries {
+		response[i] = dictionaryEntryRepositoryToGRPC(entry)
+	}
+	return response
+}
+
+func dictionaryEntryRepositoryToGRPC(entry *repository.DictionaryEntry) *pvz_v1.DictionaryEntry {
+	return &pvz_v1.DictionaryEntry{
+		Name:      entry.Name,
+		CreatedAt: timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func webhookSubscriptionRepositoryToGRPC(subscription *repository.WebhookSubscription) *pvz_v1.WebhookSubscription {
+	return &pvz_v1.WebhookSubscription{
+		Id:         subscription.ID,
+		Url:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		CreatedAt:  timestamppb.New(subscription.CreatedAt),
+	}
+}
+
+func tokenPairServiceToGRPC(tokens *service.TokenPair) *pvz_v1.TokenResponse {
+	return &pvz_v1.TokenResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func timestampToTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+func pvzEmployeeRepositoryToGRPC(employee *repository.PVZEmployee) *pvz_v1.PVZEmployee {
+	return &pvz_v1.PVZEmployee{
+		PvzId:      employee.PVZID,
+		UserId:     employee.UserID,
+		Email:      employee.Email,
+		AssignedAt: timestamppb.New(employee.AssignedAt),
+	}
+}
+
+func pvzFilterGRPCToRepository(req *pvz_v1.ListPVZRequest) repository.PVZFilter {
+	return repository.PVZFilter{
+		StartDate:       timestampToTime(req.GetStartDate()),
+		EndDate:         timestampToTime(req.GetEndDate()),
+		City:            req.City,
+		ReceptionStatus: req.ReceptionStatus,
+		IncludeEmpty:    req.GetIncludeEmpty(),
+	}
+}
+
+func receptionFilterGRPCToRepository(req *pvz_v1.ListReceptionsRequest, page, limit int) repository.ReceptionFilter {
+	return repository.ReceptionFilter{
+		Status: req.Status,
+		From:   timestampToTime(req.GetStartDate()),
+		To:     timestampToTime(req.GetEndDate()),
+		Page:   page,
+		Limit:  limit,
+	}
+}
+
+func auditFilterGRPCToRepository(req *pvz_v1.ListAuditRecordsRequest, page, limit int) repository.AuditFilter {
+	return repository.AuditFilter{
+		EntityType: req.EntityType,
+		EntityID:   req.EntityId,
+		ActorID:    req.ActorId,
+		From:       timestampToTime(req.GetFrom()),
+		To:         timestampToTime(req.GetTo()),
+		Page:       page,
+		Limit:      limit,
+	}
+}
+
+func auditRecordRepositoryToGRPC(record *repository.AuditRecord) *pvz_v1.AuditRecord {
+	response := &pvz_v1.AuditRecord{
+		Id:         record.ID,
+		Action:     record.Action,
+		EntityType: record.EntityType,
+		EntityId:   record.EntityID,
+		Before:     string(record.Before),
+		After:      string(record.After),
+		CreatedAt:  timestamppb.New(record.CreatedAt),
+	}
+	if record.ActorID != nil {
+		response.ActorId = *record.ActorID
+	}
+	if record.ActorRole != nil {
+		response.ActorRole = *record.ActorRole
+	}
+	if record.RequestID != nil {
+		response.RequestId = *record.RequestID
+	}
+	return response
+}
+
+// reportFilterGRPCToRepository оставляет незаданные границы периода нулевыми, чтобы их отклонил сервис
+func reportFilterGRPCToRepository(from, to *timestamppb.Timestamp, period string, groupBy []string, pvzId, city *string) repository.ReportFilter {
+	filter := repository.ReportFilter{
+		Period:  period,
+		GroupBy: groupBy,
+		PVZID:   pvzId,
+		City:    city,
+	}
+	if from != nil {
+		filter.From = from.AsTime()
+	}
+	if to != nil {
+		filter.To = to.AsTime()
+	}
+	return filter
+}
+
+func receptionReportRowRepositoryToGRPC(row *repository.ReceptionReportRow) *pvz_v1.ReceptionReportRow {
+	return &pvz_v1.ReceptionReportRow{
+		Period:     timestamppb.New(row.Period),
+		PvzId:      row.PVZID,
+		City:       row.City,
+		Receptions: int32(row.Receptions),
+		Closed:     int32(row.Closed),
+		Products:   int32(row.Products),
+	}
+}
+
+func productReportRowRepositoryToGRPC(row *repository.ProductReportRow) *pvz_v1.ProductReportRow {
+	return &pvz_v1.ProductReportRow{
+		Period:   timestamppb.New(row.Period),
+		PvzId:    row.PVZID,
+		City:     row.City,
+		Type:     row.Type,
+		Products: int32(row.Products),
+	}
+}
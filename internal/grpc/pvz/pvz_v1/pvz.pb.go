@@ -22,11 +22,62 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PVZStatus int32
+
+const (
+	PVZStatus_PVZ_STATUS_UNSPECIFIED PVZStatus = 0
+	PVZStatus_PVZ_STATUS_ACTIVE      PVZStatus = 1
+	PVZStatus_PVZ_STATUS_INACTIVE    PVZStatus = 2
+)
+
+// Enum value maps for PVZStatus.
+var (
+	PVZStatus_name = map[int32]string{
+		0: "PVZ_STATUS_UNSPECIFIED",
+		1: "PVZ_STATUS_ACTIVE",
+		2: "PVZ_STATUS_INACTIVE",
+	}
+	PVZStatus_value = map[string]int32{
+		"PVZ_STATUS_UNSPECIFIED": 0,
+		"PVZ_STATUS_ACTIVE":      1,
+		"PVZ_STATUS_INACTIVE":    2,
+	}
+)
+
+func (x PVZStatus) Enum() *PVZStatus {
+	p := new(PVZStatus)
+	*p = x
+	return p
+}
+
+func (x PVZStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_pvz_proto_enumTypes[0].Descriptor()
+}
+
+func (PVZStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_pvz_proto_enumTypes[0]
+}
+
+func (x PVZStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZStatus.Descriptor instead.
+func (PVZStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{0}
+}
+
 type ReceptionStatus int32
 
 const (
 	ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS ReceptionStatus = 0
 	ReceptionStatus_RECEPTION_STATUS_CLOSED      ReceptionStatus = 1
+	ReceptionStatus_RECEPTION_STATUS_REOPENED    ReceptionStatus = 2
+	ReceptionStatus_RECEPTION_STATUS_AMENDED     ReceptionStatus = 3
 )
 
 // Enum value maps for ReceptionStatus.
@@ -34,10 +85,14 @@ var (
 	ReceptionStatus_name = map[int32]string{
 		0: "RECEPTION_STATUS_IN_PROGRESS",
 		1: "RECEPTION_STATUS_CLOSED",
+		2: "RECEPTION_STATUS_REOPENED",
+		3: "RECEPTION_STATUS_AMENDED",
 	}
 	ReceptionStatus_value = map[string]int32{
 		"RECEPTION_STATUS_IN_PROGRESS": 0,
 		"RECEPTION_STATUS_CLOSED":      1,
+		"RECEPTION_STATUS_REOPENED":    2,
+		"RECEPTION_STATUS_AMENDED":     3,
 	}
 )
 
@@ -52,11 +107,11 @@ func (x ReceptionStatus) String() string {
 }
 
 func (ReceptionStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_api_proto_pvz_proto_enumTypes[0].Descriptor()
+	return file_api_proto_pvz_proto_enumTypes[1].Descriptor()
 }
 
 func (ReceptionStatus) Type() protoreflect.EnumType {
-	return &file_api_proto_pvz_proto_enumTypes[0]
+	return &file_api_proto_pvz_proto_enumTypes[1]
 }
 
 func (x ReceptionStatus) Number() protoreflect.EnumNumber {
@@ -65,7 +120,62 @@ func (x ReceptionStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReceptionStatus.Descriptor instead.
 func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
-	return file_api_proto_pvz_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{1}
+}
+
+type ProductStatus int32
+
+const (
+	ProductStatus_PRODUCT_STATUS_UNSPECIFIED ProductStatus = 0
+	ProductStatus_PRODUCT_STATUS_ACCEPTED    ProductStatus = 1
+	ProductStatus_PRODUCT_STATUS_STORED      ProductStatus = 2
+	ProductStatus_PRODUCT_STATUS_ISSUED      ProductStatus = 3
+	ProductStatus_PRODUCT_STATUS_RETURNED    ProductStatus = 4
+)
+
+// Enum value maps for ProductStatus.
+var (
+	ProductStatus_name = map[int32]string{
+		0: "PRODUCT_STATUS_UNSPECIFIED",
+		1: "PRODUCT_STATUS_ACCEPTED",
+		2: "PRODUCT_STATUS_STORED",
+		3: "PRODUCT_STATUS_ISSUED",
+		4: "PRODUCT_STATUS_RETURNED",
+	}
+	ProductStatus_value = map[string]int32{
+		"PRODUCT_STATUS_UNSPECIFIED": 0,
+		"PRODUCT_STATUS_ACCEPTED":    1,
+		"PRODUCT_STATUS_STORED":      2,
+		"PRODUCT_STATUS_ISSUED":      3,
+		"PRODUCT_STATUS_RETURNED":    4,
+	}
+)
+
+func (x ProductStatus) Enum() *ProductStatus {
+	p := new(ProductStatus)
+	*p = x
+	return p
+}
+
+func (x ProductStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_pvz_proto_enumTypes[2].Descriptor()
+}
+
+func (ProductStatus) Type() protoreflect.EnumType {
+	return &file_api_proto_pvz_proto_enumTypes[2]
+}
+
+func (x ProductStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductStatus.Descriptor instead.
+func (ProductStatus) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{2}
 }
 
 type PVZ struct {
@@ -73,6 +183,9 @@ type PVZ struct {
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
 	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
+	Status           PVZStatus              `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.PVZStatus" json:"status,omitempty"`
+	DeactivatedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=deactivated_at,json=deactivatedAt,proto3" json:"deactivated_at,omitempty"`
+	Location         *PVZLocation           `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
@@ -128,26 +241,53 @@ func (x *PVZ) GetCity() string {
 	return ""
 }
 
-type GetPVZListRequest struct {
+func (x *PVZ) GetStatus() PVZStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PVZStatus_PVZ_STATUS_UNSPECIFIED
+}
+
+func (x *PVZ) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
+func (x *PVZ) GetLocation() *PVZLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type PVZLocation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Street        *string                `protobuf:"bytes,1,opt,name=street,proto3,oneof" json:"street,omitempty"`
+	House         *string                `protobuf:"bytes,2,opt,name=house,proto3,oneof" json:"house,omitempty"`
+	PostalCode    *string                `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3,oneof" json:"postal_code,omitempty"`
+	Latitude      *float64               `protobuf:"fixed64,4,opt,name=latitude,proto3,oneof" json:"latitude,omitempty"`
+	Longitude     *float64               `protobuf:"fixed64,5,opt,name=longitude,proto3,oneof" json:"longitude,omitempty"`
+	OpeningHours  *string                `protobuf:"bytes,6,opt,name=opening_hours,json=openingHours,proto3,oneof" json:"opening_hours,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPVZListRequest) Reset() {
-	*x = GetPVZListRequest{}
+func (x *PVZLocation) Reset() {
+	*x = PVZLocation{}
 	mi := &file_api_proto_pvz_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPVZListRequest) String() string {
+func (x *PVZLocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPVZListRequest) ProtoMessage() {}
+func (*PVZLocation) ProtoMessage() {}
 
-func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+func (x *PVZLocation) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_pvz_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -159,32 +299,77 @@ func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
-func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PVZLocation.ProtoReflect.Descriptor instead.
+func (*PVZLocation) Descriptor() ([]byte, []int) {
 	return file_api_proto_pvz_proto_rawDescGZIP(), []int{1}
 }
 
-type GetPVZListResponse struct {
+func (x *PVZLocation) GetStreet() string {
+	if x != nil && x.Street != nil {
+		return *x.Street
+	}
+	return ""
+}
+
+func (x *PVZLocation) GetHouse() string {
+	if x != nil && x.House != nil {
+		return *x.House
+	}
+	return ""
+}
+
+func (x *PVZLocation) GetPostalCode() string {
+	if x != nil && x.PostalCode != nil {
+		return *x.PostalCode
+	}
+	return ""
+}
+
+func (x *PVZLocation) GetLatitude() float64 {
+	if x != nil && x.Latitude != nil {
+		return *x.Latitude
+	}
+	return 0
+}
+
+func (x *PVZLocation) GetLongitude() float64 {
+	if x != nil && x.Longitude != nil {
+		return *x.Longitude
+	}
+	return 0
+}
+
+func (x *PVZLocation) GetOpeningHours() string {
+	if x != nil && x.OpeningHours != nil {
+		return *x.OpeningHours
+	}
+	return ""
+}
+
+type Reception struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPVZListResponse) Reset() {
-	*x = GetPVZListResponse{}
+func (x *Reception) Reset() {
+	*x = Reception{}
 	mi := &file_api_proto_pvz_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPVZListResponse) String() string {
+func (x *Reception) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPVZListResponse) ProtoMessage() {}
+func (*Reception) ProtoMessage() {}
 
-func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+func (x *Reception) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_pvz_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -196,37 +381,6105 @@ func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
-func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
 	return file_api_proto_pvz_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+func (x *Reception) GetId() string {
 	if x != nil {
-		return x.Pvzs
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
 	}
 	return nil
 }
 
-var File_api_proto_pvz_proto protoreflect.FileDescriptor
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
 
-const file_api_proto_pvz_proto_rawDesc = "" +
-	"\n" +
-	"\x13api/proto/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"r\n" +
-	"\x03PVZ\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
-	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"5\n" +
-	"\x12GetPVZListResponse\x12\x1f\n" +
-	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs*P\n" +
-	"\x0fReceptionStatus\x12 \n" +
-	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
-	"\x17RECEPTION_STATUS_CLOSED\x10\x012Q\n" +
-	"\n" +
-	"PVZService\x12C\n" +
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+// Строка манифеста: конкретный товар по штрихкоду или количество товаров типа
+type ManifestItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barcode       *string                `protobuf:"bytes,1,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ManifestItem) Reset() {
+	*x = ManifestItem{}
+	mi := &file_api_proto_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ManifestItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestItem) ProtoMessage() {}
+
+func (x *ManifestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestItem.ProtoReflect.Descriptor instead.
+func (*ManifestItem) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ManifestItem) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *ManifestItem) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ManifestItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReceptionManifest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId          string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	BlockOnDiscrepancies bool                   `protobuf:"varint,2,opt,name=block_on_discrepancies,json=blockOnDiscrepancies,proto3" json:"block_on_discrepancies,omitempty"`
+	UploadedBy           string                 `protobuf:"bytes,3,opt,name=uploaded_by,json=uploadedBy,proto3" json:"uploaded_by,omitempty"`
+	UploadedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=uploaded_at,json=uploadedAt,proto3" json:"uploaded_at,omitempty"`
+	Items                []*ManifestItem        `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReceptionManifest) Reset() {
+	*x = ReceptionManifest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionManifest) ProtoMessage() {}
+
+func (x *ReceptionManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionManifest.ProtoReflect.Descriptor instead.
+func (*ReceptionManifest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReceptionManifest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReceptionManifest) GetBlockOnDiscrepancies() bool {
+	if x != nil {
+		return x.BlockOnDiscrepancies
+	}
+	return false
+}
+
+func (x *ReceptionManifest) GetUploadedBy() string {
+	if x != nil {
+		return x.UploadedBy
+	}
+	return ""
+}
+
+func (x *ReceptionManifest) GetUploadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UploadedAt
+	}
+	return nil
+}
+
+func (x *ReceptionManifest) GetItems() []*ManifestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Для открытой приемки сверка считается по текущим товарам
+type Reconciliation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId      string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Matched          []*ManifestItem        `protobuf:"bytes,2,rep,name=matched,proto3" json:"matched,omitempty"`
+	Missing          []*ManifestItem        `protobuf:"bytes,3,rep,name=missing,proto3" json:"missing,omitempty"`
+	Extra            []*ManifestItem        `protobuf:"bytes,4,rep,name=extra,proto3" json:"extra,omitempty"`
+	HasDiscrepancies bool                   `protobuf:"varint,5,opt,name=has_discrepancies,json=hasDiscrepancies,proto3" json:"has_discrepancies,omitempty"`
+	AcknowledgedBy   string                 `protobuf:"bytes,6,opt,name=acknowledged_by,json=acknowledgedBy,proto3" json:"acknowledged_by,omitempty"`
+	AcknowledgedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Reconciliation) Reset() {
+	*x = Reconciliation{}
+	mi := &file_api_proto_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reconciliation) ProtoMessage() {}
+
+func (x *Reconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reconciliation.ProtoReflect.Descriptor instead.
+func (*Reconciliation) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Reconciliation) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetMatched() []*ManifestItem {
+	if x != nil {
+		return x.Matched
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetMissing() []*ManifestItem {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetExtra() []*ManifestItem {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetHasDiscrepancies() bool {
+	if x != nil {
+		return x.HasDiscrepancies
+	}
+	return false
+}
+
+func (x *Reconciliation) GetAcknowledgedBy() string {
+	if x != nil {
+		return x.AcknowledgedBy
+	}
+	return ""
+}
+
+func (x *Reconciliation) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *Reconciliation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Открытие или исправление закрытой приемки модератором. Для открытия added и removed пустые
+type ReceptionCorrection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,2,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	Added         []*Product             `protobuf:"bytes,6,rep,name=added,proto3" json:"added,omitempty"`
+	Removed       []*Product             `protobuf:"bytes,7,rep,name=removed,proto3" json:"removed,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionCorrection) Reset() {
+	*x = ReceptionCorrection{}
+	mi := &file_api_proto_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionCorrection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionCorrection) ProtoMessage() {}
+
+func (x *ReceptionCorrection) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionCorrection.ProtoReflect.Descriptor instead.
+func (*ReceptionCorrection) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReceptionCorrection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *ReceptionCorrection) GetAdded() []*Product {
+	if x != nil {
+		return x.Added
+	}
+	return nil
+}
+
+func (x *ReceptionCorrection) GetRemoved() []*Product {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+func (x *ReceptionCorrection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Status        ProductStatus          `protobuf:"varint,5,opt,name=status,proto3,enum=pvz.v1.ProductStatus" json:"status,omitempty"`
+	Details       *ProductDetails        `protobuf:"bytes,6,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_api_proto_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetStatus() ProductStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *Product) GetDetails() *ProductDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+// Штрихкод уникален в пределах приемки, вес в граммах, габариты в миллиметрах
+type ProductDetails struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barcode       *string                `protobuf:"bytes,1,opt,name=barcode,proto3,oneof" json:"barcode,omitempty"`
+	WeightGrams   *int32                 `protobuf:"varint,2,opt,name=weight_grams,json=weightGrams,proto3,oneof" json:"weight_grams,omitempty"`
+	LengthMm      *int32                 `protobuf:"varint,3,opt,name=length_mm,json=lengthMm,proto3,oneof" json:"length_mm,omitempty"`
+	WidthMm       *int32                 `protobuf:"varint,4,opt,name=width_mm,json=widthMm,proto3,oneof" json:"width_mm,omitempty"`
+	HeightMm      *int32                 `protobuf:"varint,5,opt,name=height_mm,json=heightMm,proto3,oneof" json:"height_mm,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductDetails) Reset() {
+	*x = ProductDetails{}
+	mi := &file_api_proto_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductDetails) ProtoMessage() {}
+
+func (x *ProductDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductDetails.ProtoReflect.Descriptor instead.
+func (*ProductDetails) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProductDetails) GetBarcode() string {
+	if x != nil && x.Barcode != nil {
+		return *x.Barcode
+	}
+	return ""
+}
+
+func (x *ProductDetails) GetWeightGrams() int32 {
+	if x != nil && x.WeightGrams != nil {
+		return *x.WeightGrams
+	}
+	return 0
+}
+
+func (x *ProductDetails) GetLengthMm() int32 {
+	if x != nil && x.LengthMm != nil {
+		return *x.LengthMm
+	}
+	return 0
+}
+
+func (x *ProductDetails) GetWidthMm() int32 {
+	if x != nil && x.WidthMm != nil {
+		return *x.WidthMm
+	}
+	return 0
+}
+
+func (x *ProductDetails) GetHeightMm() int32 {
+	if x != nil && x.HeightMm != nil {
+		return *x.HeightMm
+	}
+	return 0
+}
+
+func (x *ProductDetails) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type NewProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Details       *ProductDetails        `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewProduct) Reset() {
+	*x = NewProduct{}
+	mi := &file_api_proto_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewProduct) ProtoMessage() {}
+
+func (x *NewProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewProduct.ProtoReflect.Descriptor instead.
+func (*NewProduct) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *NewProduct) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *NewProduct) GetDetails() *ProductDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type ProductStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FromStatus    ProductStatus          `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=pvz.v1.ProductStatus" json:"from_status,omitempty"`
+	ToStatus      ProductStatus          `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=pvz.v1.ProductStatus" json:"to_status,omitempty"`
+	ChangedBy     string                 `protobuf:"bytes,5,opt,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductStatusChange) Reset() {
+	*x = ProductStatusChange{}
+	mi := &file_api_proto_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductStatusChange) ProtoMessage() {}
+
+func (x *ProductStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductStatusChange.ProtoReflect.Descriptor instead.
+func (*ProductStatusChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ProductStatusChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductStatusChange) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductStatusChange) GetFromStatus() ProductStatus {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *ProductStatusChange) GetToStatus() ProductStatus {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ProductStatus_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *ProductStatusChange) GetChangedBy() string {
+	if x != nil {
+		return x.ChangedBy
+	}
+	return ""
+}
+
+func (x *ProductStatusChange) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+type DictionaryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictionaryEntry) Reset() {
+	*x = DictionaryEntry{}
+	mi := &file_api_proto_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictionaryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictionaryEntry) ProtoMessage() {}
+
+func (x *DictionaryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictionaryEntry.ProtoReflect.Descriptor instead.
+func (*DictionaryEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DictionaryEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DictionaryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type PVZEmployee struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	AssignedAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZEmployee) Reset() {
+	*x = PVZEmployee{}
+	mi := &file_api_proto_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZEmployee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZEmployee) ProtoMessage() {}
+
+func (x *PVZEmployee) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZEmployee.ProtoReflect.Descriptor instead.
+func (*PVZEmployee) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PVZEmployee) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZEmployee) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PVZEmployee) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PVZEmployee) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+type WebhookSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,3,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	Secret        string                 `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	mi := &file_api_proto_pvz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WebhookSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AuditRecord struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId    string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorRole  string                 `protobuf:"bytes,3,opt,name=actor_role,json=actorRole,proto3" json:"actor_role,omitempty"`
+	Action     string                 `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	EntityType string                 `protobuf:"bytes,5,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId   string                 `protobuf:"bytes,6,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	// Состояние сущности до и после изменения в JSON
+	Before        string                 `protobuf:"bytes,7,opt,name=before,proto3" json:"before,omitempty"`
+	After         string                 `protobuf:"bytes,8,opt,name=after,proto3" json:"after,omitempty"`
+	RequestId     string                 `protobuf:"bytes,9,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecord) Reset() {
+	*x = AuditRecord{}
+	mi := &file_api_proto_pvz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecord) ProtoMessage() {}
+
+func (x *AuditRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecord.ProtoReflect.Descriptor instead.
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AuditRecord) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetActorRole() string {
+	if x != nil {
+		return x.ActorRole
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *AuditRecord) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Разрезы, по которым не группировали, в строке отчета не заполнены
+type ReceptionReportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	PvzId         *string                `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3,oneof" json:"pvz_id,omitempty"`
+	City          *string                `protobuf:"bytes,3,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Receptions    int32                  `protobuf:"varint,4,opt,name=receptions,proto3" json:"receptions,omitempty"`
+	Closed        int32                  `protobuf:"varint,5,opt,name=closed,proto3" json:"closed,omitempty"`
+	Products      int32                  `protobuf:"varint,6,opt,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionReportRow) Reset() {
+	*x = ReceptionReportRow{}
+	mi := &file_api_proto_pvz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionReportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionReportRow) ProtoMessage() {}
+
+func (x *ReceptionReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionReportRow.ProtoReflect.Descriptor instead.
+func (*ReceptionReportRow) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReceptionReportRow) GetPeriod() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *ReceptionReportRow) GetPvzId() string {
+	if x != nil && x.PvzId != nil {
+		return *x.PvzId
+	}
+	return ""
+}
+
+func (x *ReceptionReportRow) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *ReceptionReportRow) GetReceptions() int32 {
+	if x != nil {
+		return x.Receptions
+	}
+	return 0
+}
+
+func (x *ReceptionReportRow) GetClosed() int32 {
+	if x != nil {
+		return x.Closed
+	}
+	return 0
+}
+
+func (x *ReceptionReportRow) GetProducts() int32 {
+	if x != nil {
+		return x.Products
+	}
+	return 0
+}
+
+type ProductReportRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period,proto3" json:"period,omitempty"`
+	PvzId         *string                `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3,oneof" json:"pvz_id,omitempty"`
+	City          *string                `protobuf:"bytes,3,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Type          *string                `protobuf:"bytes,4,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	Products      int32                  `protobuf:"varint,5,opt,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductReportRow) Reset() {
+	*x = ProductReportRow{}
+	mi := &file_api_proto_pvz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductReportRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductReportRow) ProtoMessage() {}
+
+func (x *ProductReportRow) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductReportRow.ProtoReflect.Descriptor instead.
+func (*ProductReportRow) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ProductReportRow) GetPeriod() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Period
+	}
+	return nil
+}
+
+func (x *ProductReportRow) GetPvzId() string {
+	if x != nil && x.PvzId != nil {
+		return *x.PvzId
+	}
+	return ""
+}
+
+func (x *ProductReportRow) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *ProductReportRow) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+func (x *ProductReportRow) GetProducts() int32 {
+	if x != nil {
+		return x.Products
+	}
+	return 0
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_proto_pvz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ReceptionWithProducts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionWithProducts) Reset() {
+	*x = ReceptionWithProducts{}
+	mi := &file_api_proto_pvz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionWithProducts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionWithProducts) ProtoMessage() {}
+
+func (x *ReceptionWithProducts) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionWithProducts.ProtoReflect.Descriptor instead.
+func (*ReceptionWithProducts) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReceptionWithProducts) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionWithProducts) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type PVZWithReceptions struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Pvz           *PVZ                     `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Receptions    []*ReceptionWithProducts `protobuf:"bytes,2,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZWithReceptions) Reset() {
+	*x = PVZWithReceptions{}
+	mi := &file_api_proto_pvz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZWithReceptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZWithReceptions) ProtoMessage() {}
+
+func (x *PVZWithReceptions) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZWithReceptions.ProtoReflect.Descriptor instead.
+func (*PVZWithReceptions) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PVZWithReceptions) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZWithReceptions) GetReceptions() []*ReceptionWithProducts {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+type GetPVZListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListRequest) Reset() {
+	*x = GetPVZListRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListRequest) ProtoMessage() {}
+
+func (x *GetPVZListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{20}
+}
+
+type GetPVZListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZListResponse) Reset() {
+	*x = GetPVZListResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZListResponse) ProtoMessage() {}
+
+func (x *GetPVZListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZListResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZListResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetPVZListResponse) GetPvzs() []*PVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+type DummyLoginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Role  string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// ПВЗ, за которым закрепляется тестовый сотрудник. Без него сотрудник не может работать с приемками и товарами
+	PvzId         string `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DummyLoginRequest) Reset() {
+	*x = DummyLoginRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DummyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DummyLoginRequest) ProtoMessage() {}
+
+func (x *DummyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DummyLoginRequest.ProtoReflect.Descriptor instead.
+func (*DummyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DummyLoginRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *DummyLoginRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RegisterResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type TokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenResponse) Reset() {
+	*x = TokenResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenResponse) ProtoMessage() {}
+
+func (x *TokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenResponse.ProtoReflect.Descriptor instead.
+func (*TokenResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TokenResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *TokenResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{29}
+}
+
+type CreatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	Location      *PVZLocation           `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZRequest) Reset() {
+	*x = CreatePVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZRequest) ProtoMessage() {}
+
+func (x *CreatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZRequest.ProtoReflect.Descriptor instead.
+func (*CreatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreatePVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreatePVZRequest) GetLocation() *PVZLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type CreatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZResponse) Reset() {
+	*x = CreatePVZResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZResponse) ProtoMessage() {}
+
+func (x *CreatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZResponse.ProtoReflect.Descriptor instead.
+func (*CreatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+// Без page список листается по курсору: cursor берется из next_cursor предыдущего ответа.
+// Даты и статус отбирают приемки, ПВЗ без подходящих приемок возвращаются только при include_empty
+type ListPVZRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	StartDate       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page            int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor          string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	City            *string                `protobuf:"bytes,6,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	ReceptionStatus *string                `protobuf:"bytes,7,opt,name=reception_status,json=receptionStatus,proto3,oneof" json:"reception_status,omitempty"`
+	IncludeEmpty    bool                   `protobuf:"varint,8,opt,name=include_empty,json=includeEmpty,proto3" json:"include_empty,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPVZRequest) Reset() {
+	*x = ListPVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZRequest) ProtoMessage() {}
+
+func (x *ListPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZRequest.ProtoReflect.Descriptor instead.
+func (*ListPVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListPVZRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListPVZRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPVZRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListPVZRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListPVZRequest) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *ListPVZRequest) GetReceptionStatus() string {
+	if x != nil && x.ReceptionStatus != nil {
+		return *x.ReceptionStatus
+	}
+	return ""
+}
+
+func (x *ListPVZRequest) GetIncludeEmpty() bool {
+	if x != nil {
+		return x.IncludeEmpty
+	}
+	return false
+}
+
+type ListPVZResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs  []*PVZWithReceptions   `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	// Пустой на последней странице и при использовании page
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZResponse) Reset() {
+	*x = ListPVZResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZResponse) ProtoMessage() {}
+
+func (x *ListPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZResponse.ProtoReflect.Descriptor instead.
+func (*ListPVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListPVZResponse) GetPvzs() []*PVZWithReceptions {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+func (x *ListPVZResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetPVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZRequest) Reset() {
+	*x = GetPVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZRequest) ProtoMessage() {}
+
+func (x *GetPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetPVZRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type GetPVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZWithReceptions     `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZResponse) Reset() {
+	*x = GetPVZResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZResponse) ProtoMessage() {}
+
+func (x *GetPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetPVZResponse) GetPvz() *PVZWithReceptions {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type UpdatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	City          *string                `protobuf:"bytes,2,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Location      *PVZLocation           `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePVZRequest) Reset() {
+	*x = UpdatePVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZRequest) ProtoMessage() {}
+
+func (x *UpdatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdatePVZRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *UpdatePVZRequest) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *UpdatePVZRequest) GetLocation() *PVZLocation {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type UpdatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePVZResponse) Reset() {
+	*x = UpdatePVZResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZResponse) ProtoMessage() {}
+
+func (x *UpdatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type DeactivatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePVZRequest) Reset() {
+	*x = DeactivatePVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePVZRequest) ProtoMessage() {}
+
+func (x *DeactivatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePVZRequest.ProtoReflect.Descriptor instead.
+func (*DeactivatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeactivatePVZRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeactivatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivatePVZResponse) Reset() {
+	*x = DeactivatePVZResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivatePVZResponse) ProtoMessage() {}
+
+func (x *DeactivatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivatePVZResponse.ProtoReflect.Descriptor instead.
+func (*DeactivatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeactivatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type ListNearbyPVZRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Lat   float64                `protobuf:"fixed64,1,opt,name=lat,proto3" json:"lat,omitempty"`
+	Lon   float64                `protobuf:"fixed64,2,opt,name=lon,proto3" json:"lon,omitempty"`
+	// Радиус поиска в метрах
+	Radius        float64 `protobuf:"fixed64,3,opt,name=radius,proto3" json:"radius,omitempty"`
+	Limit         int32   `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNearbyPVZRequest) Reset() {
+	*x = ListNearbyPVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyPVZRequest) ProtoMessage() {}
+
+func (x *ListNearbyPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyPVZRequest.ProtoReflect.Descriptor instead.
+func (*ListNearbyPVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListNearbyPVZRequest) GetLat() float64 {
+	if x != nil {
+		return x.Lat
+	}
+	return 0
+}
+
+func (x *ListNearbyPVZRequest) GetLon() float64 {
+	if x != nil {
+		return x.Lon
+	}
+	return 0
+}
+
+func (x *ListNearbyPVZRequest) GetRadius() float64 {
+	if x != nil {
+		return x.Radius
+	}
+	return 0
+}
+
+func (x *ListNearbyPVZRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NearbyPVZ struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Distance      float64                `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearbyPVZ) Reset() {
+	*x = NearbyPVZ{}
+	mi := &file_api_proto_pvz_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyPVZ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyPVZ) ProtoMessage() {}
+
+func (x *NearbyPVZ) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyPVZ.ProtoReflect.Descriptor instead.
+func (*NearbyPVZ) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *NearbyPVZ) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *NearbyPVZ) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type ListNearbyPVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvzs          []*NearbyPVZ           `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListNearbyPVZResponse) Reset() {
+	*x = ListNearbyPVZResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListNearbyPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNearbyPVZResponse) ProtoMessage() {}
+
+func (x *ListNearbyPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNearbyPVZResponse.ProtoReflect.Descriptor instead.
+func (*ListNearbyPVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListNearbyPVZResponse) GetPvzs() []*NearbyPVZ {
+	if x != nil {
+		return x.Pvzs
+	}
+	return nil
+}
+
+type CreateReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionRequest) Reset() {
+	*x = CreateReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionRequest) ProtoMessage() {}
+
+func (x *CreateReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CreateReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceptionResponse) Reset() {
+	*x = CreateReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceptionResponse) ProtoMessage() {}
+
+func (x *CreateReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type CloseLastReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionRequest) Reset() {
+	*x = CloseLastReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionRequest) ProtoMessage() {}
+
+func (x *CloseLastReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CloseLastReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CloseLastReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseLastReceptionResponse) Reset() {
+	*x = CloseLastReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseLastReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseLastReceptionResponse) ProtoMessage() {}
+
+func (x *CloseLastReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseLastReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CloseLastReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CloseLastReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type ListReceptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        *string                `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	StartDate     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page          int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionsRequest) Reset() {
+	*x = ListReceptionsRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionsRequest) ProtoMessage() {}
+
+func (x *ListReceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListReceptionsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ListReceptionsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListReceptionsRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ListReceptionsRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ListReceptionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReceptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReceptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Receptions    []*Reception           `protobuf:"bytes,1,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionsResponse) Reset() {
+	*x = ListReceptionsResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionsResponse) ProtoMessage() {}
+
+func (x *ListReceptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceptionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListReceptionsResponse) GetReceptions() []*Reception {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+type GetReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionRequest) Reset() {
+	*x = GetReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionRequest) ProtoMessage() {}
+
+func (x *GetReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type GetReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *ReceptionWithProducts `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionResponse) Reset() {
+	*x = GetReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionResponse) ProtoMessage() {}
+
+func (x *GetReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionResponse.ProtoReflect.Descriptor instead.
+func (*GetReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetReceptionResponse) GetReception() *ReceptionWithProducts {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type SetReceptionManifestRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId          string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Items                []*ManifestItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	BlockOnDiscrepancies bool                   `protobuf:"varint,3,opt,name=block_on_discrepancies,json=blockOnDiscrepancies,proto3" json:"block_on_discrepancies,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *SetReceptionManifestRequest) Reset() {
+	*x = SetReceptionManifestRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReceptionManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReceptionManifestRequest) ProtoMessage() {}
+
+func (x *SetReceptionManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReceptionManifestRequest.ProtoReflect.Descriptor instead.
+func (*SetReceptionManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetReceptionManifestRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *SetReceptionManifestRequest) GetItems() []*ManifestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SetReceptionManifestRequest) GetBlockOnDiscrepancies() bool {
+	if x != nil {
+		return x.BlockOnDiscrepancies
+	}
+	return false
+}
+
+type SetReceptionManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *ReceptionManifest     `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReceptionManifestResponse) Reset() {
+	*x = SetReceptionManifestResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReceptionManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReceptionManifestResponse) ProtoMessage() {}
+
+func (x *SetReceptionManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReceptionManifestResponse.ProtoReflect.Descriptor instead.
+func (*SetReceptionManifestResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *SetReceptionManifestResponse) GetManifest() *ReceptionManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type GetReceptionManifestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionManifestRequest) Reset() {
+	*x = GetReceptionManifestRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionManifestRequest) ProtoMessage() {}
+
+func (x *GetReceptionManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionManifestRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionManifestRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetReceptionManifestRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type GetReceptionManifestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifest      *ReceptionManifest     `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionManifestResponse) Reset() {
+	*x = GetReceptionManifestResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionManifestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionManifestResponse) ProtoMessage() {}
+
+func (x *GetReceptionManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionManifestResponse.ProtoReflect.Descriptor instead.
+func (*GetReceptionManifestResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetReceptionManifestResponse) GetManifest() *ReceptionManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type GetReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReconciliationRequest) Reset() {
+	*x = GetReconciliationRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationRequest) ProtoMessage() {}
+
+func (x *GetReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*GetReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetReconciliationRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type GetReconciliationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reconciliation *Reconciliation        `protobuf:"bytes,1,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetReconciliationResponse) Reset() {
+	*x = GetReconciliationResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReconciliationResponse) ProtoMessage() {}
+
+func (x *GetReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*GetReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetReconciliationResponse) GetReconciliation() *Reconciliation {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+type AcknowledgeReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeReconciliationRequest) Reset() {
+	*x = AcknowledgeReconciliationRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeReconciliationRequest) ProtoMessage() {}
+
+func (x *AcknowledgeReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AcknowledgeReconciliationRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type AcknowledgeReconciliationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reconciliation *Reconciliation        `protobuf:"bytes,1,opt,name=reconciliation,proto3" json:"reconciliation,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcknowledgeReconciliationResponse) Reset() {
+	*x = AcknowledgeReconciliationResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeReconciliationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeReconciliationResponse) ProtoMessage() {}
+
+func (x *AcknowledgeReconciliationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeReconciliationResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeReconciliationResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *AcknowledgeReconciliationResponse) GetReconciliation() *Reconciliation {
+	if x != nil {
+		return x.Reconciliation
+	}
+	return nil
+}
+
+type ReopenReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionRequest) Reset() {
+	*x = ReopenReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionRequest) ProtoMessage() {}
+
+func (x *ReopenReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionRequest.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReopenReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReopenReceptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReopenReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionResponse) Reset() {
+	*x = ReopenReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionResponse) ProtoMessage() {}
+
+func (x *ReopenReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionResponse.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ReopenReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+// Удалить можно только товары, которые еще хранятся в ПВЗ, добавленные товары сразу попадают на хранение
+type AmendReceptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId      string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Reason           string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	AddProducts      []*NewProduct          `protobuf:"bytes,3,rep,name=add_products,json=addProducts,proto3" json:"add_products,omitempty"`
+	RemoveProductIds []string               `protobuf:"bytes,4,rep,name=remove_product_ids,json=removeProductIds,proto3" json:"remove_product_ids,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AmendReceptionRequest) Reset() {
+	*x = AmendReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendReceptionRequest) ProtoMessage() {}
+
+func (x *AmendReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendReceptionRequest.ProtoReflect.Descriptor instead.
+func (*AmendReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *AmendReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *AmendReceptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AmendReceptionRequest) GetAddProducts() []*NewProduct {
+	if x != nil {
+		return x.AddProducts
+	}
+	return nil
+}
+
+func (x *AmendReceptionRequest) GetRemoveProductIds() []string {
+	if x != nil {
+		return x.RemoveProductIds
+	}
+	return nil
+}
+
+type AmendReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Correction    *ReceptionCorrection   `protobuf:"bytes,1,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AmendReceptionResponse) Reset() {
+	*x = AmendReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AmendReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AmendReceptionResponse) ProtoMessage() {}
+
+func (x *AmendReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AmendReceptionResponse.ProtoReflect.Descriptor instead.
+func (*AmendReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AmendReceptionResponse) GetCorrection() *ReceptionCorrection {
+	if x != nil {
+		return x.Correction
+	}
+	return nil
+}
+
+type ListReceptionCorrectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionCorrectionsRequest) Reset() {
+	*x = ListReceptionCorrectionsRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionCorrectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionCorrectionsRequest) ProtoMessage() {}
+
+func (x *ListReceptionCorrectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionCorrectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceptionCorrectionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListReceptionCorrectionsRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type ListReceptionCorrectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Corrections   []*ReceptionCorrection `protobuf:"bytes,1,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionCorrectionsResponse) Reset() {
+	*x = ListReceptionCorrectionsResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionCorrectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionCorrectionsResponse) ProtoMessage() {}
+
+func (x *ListReceptionCorrectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionCorrectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceptionCorrectionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListReceptionCorrectionsResponse) GetCorrections() []*ReceptionCorrection {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+type AddProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Details       *ProductDetails        `protobuf:"bytes,3,opt,name=details,proto3" json:"details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *AddProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetDetails() *ProductDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+type AddProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductResponse) Reset() {
+	*x = AddProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResponse) ProtoMessage() {}
+
+func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
+func (*AddProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type AddProductResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Product       *Product               `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductResult) Reset() {
+	*x = AddProductResult{}
+	mi := &file_api_proto_pvz_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResult) ProtoMessage() {}
+
+func (x *AddProductResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResult.ProtoReflect.Descriptor instead.
+func (*AddProductResult) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *AddProductResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *AddProductResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *AddProductResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*AddProductResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsResponse) Reset() {
+	*x = AddProductsResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsResponse) ProtoMessage() {}
+
+func (x *AddProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsResponse.ProtoReflect.Descriptor instead.
+func (*AddProductsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AddProductsResponse) GetResults() []*AddProductResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type DeleteLastProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductRequest) Reset() {
+	*x = DeleteLastProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductRequest) ProtoMessage() {}
+
+func (x *DeleteLastProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *DeleteLastProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeleteLastProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductResponse) Reset() {
+	*x = DeleteLastProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductResponse) ProtoMessage() {}
+
+func (x *DeleteLastProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *DeleteLastProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type IssueProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueProductRequest) Reset() {
+	*x = IssueProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueProductRequest) ProtoMessage() {}
+
+func (x *IssueProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueProductRequest.ProtoReflect.Descriptor instead.
+func (*IssueProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *IssueProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type IssueProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueProductResponse) Reset() {
+	*x = IssueProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueProductResponse) ProtoMessage() {}
+
+func (x *IssueProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueProductResponse.ProtoReflect.Descriptor instead.
+func (*IssueProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *IssueProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ReturnProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnProductRequest) Reset() {
+	*x = ReturnProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnProductRequest) ProtoMessage() {}
+
+func (x *ReturnProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnProductRequest.ProtoReflect.Descriptor instead.
+func (*ReturnProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReturnProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ReturnProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReturnProductResponse) Reset() {
+	*x = ReturnProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnProductResponse) ProtoMessage() {}
+
+func (x *ReturnProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnProductResponse.ProtoReflect.Descriptor instead.
+func (*ReturnProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReturnProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductHistoryRequest) Reset() {
+	*x = GetProductHistoryRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryRequest) ProtoMessage() {}
+
+func (x *GetProductHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetProductHistoryRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type GetProductHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	History       []*ProductStatusChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductHistoryResponse) Reset() {
+	*x = GetProductHistoryResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductHistoryResponse) ProtoMessage() {}
+
+func (x *GetProductHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProductHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetProductHistoryResponse) GetHistory() []*ProductStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type FindProductsByBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barcode       string                 `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProductsByBarcodeRequest) Reset() {
+	*x = FindProductsByBarcodeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProductsByBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProductsByBarcodeRequest) ProtoMessage() {}
+
+func (x *FindProductsByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProductsByBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*FindProductsByBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *FindProductsByBarcodeRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+type FindProductsByBarcodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProductsByBarcodeResponse) Reset() {
+	*x = FindProductsByBarcodeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProductsByBarcodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProductsByBarcodeResponse) ProtoMessage() {}
+
+func (x *FindProductsByBarcodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProductsByBarcodeResponse.ProtoReflect.Descriptor instead.
+func (*FindProductsByBarcodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *FindProductsByBarcodeResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ListCitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitiesRequest) Reset() {
+	*x = ListCitiesRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitiesRequest) ProtoMessage() {}
+
+func (x *ListCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitiesRequest.ProtoReflect.Descriptor instead.
+func (*ListCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{79}
+}
+
+type ListCitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cities        []*DictionaryEntry     `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCitiesResponse) Reset() {
+	*x = ListCitiesResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCitiesResponse) ProtoMessage() {}
+
+func (x *ListCitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCitiesResponse.ProtoReflect.Descriptor instead.
+func (*ListCitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListCitiesResponse) GetCities() []*DictionaryEntry {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+type CreateCityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCityRequest) Reset() {
+	*x = CreateCityRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCityRequest) ProtoMessage() {}
+
+func (x *CreateCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCityRequest.ProtoReflect.Descriptor instead.
+func (*CreateCityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateCityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateCityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          *DictionaryEntry       `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCityResponse) Reset() {
+	*x = CreateCityResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCityResponse) ProtoMessage() {}
+
+func (x *CreateCityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCityResponse.ProtoReflect.Descriptor instead.
+func (*CreateCityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateCityResponse) GetCity() *DictionaryEntry {
+	if x != nil {
+		return x.City
+	}
+	return nil
+}
+
+type DeleteCityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCityRequest) Reset() {
+	*x = DeleteCityRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCityRequest) ProtoMessage() {}
+
+func (x *DeleteCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCityRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *DeleteCityRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteCityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCityResponse) Reset() {
+	*x = DeleteCityResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCityResponse) ProtoMessage() {}
+
+func (x *DeleteCityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCityResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{84}
+}
+
+type ListProductTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductTypesRequest) Reset() {
+	*x = ListProductTypesRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductTypesRequest) ProtoMessage() {}
+
+func (x *ListProductTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductTypesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{85}
+}
+
+type ListProductTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductTypes  []*DictionaryEntry     `protobuf:"bytes,1,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductTypesResponse) Reset() {
+	*x = ListProductTypesResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductTypesResponse) ProtoMessage() {}
+
+func (x *ListProductTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductTypesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListProductTypesResponse) GetProductTypes() []*DictionaryEntry {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+type CreateProductTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductTypeRequest) Reset() {
+	*x = CreateProductTypeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductTypeRequest) ProtoMessage() {}
+
+func (x *CreateProductTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CreateProductTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateProductTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductType   *DictionaryEntry       `protobuf:"bytes,1,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductTypeResponse) Reset() {
+	*x = CreateProductTypeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductTypeResponse) ProtoMessage() {}
+
+func (x *CreateProductTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateProductTypeResponse) GetProductType() *DictionaryEntry {
+	if x != nil {
+		return x.ProductType
+	}
+	return nil
+}
+
+type DeleteProductTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductTypeRequest) Reset() {
+	*x = DeleteProductTypeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductTypeRequest) ProtoMessage() {}
+
+func (x *DeleteProductTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteProductTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteProductTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductTypeResponse) Reset() {
+	*x = DeleteProductTypeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductTypeResponse) ProtoMessage() {}
+
+func (x *DeleteProductTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{90}
+}
+
+type CreateWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	EventTypes    []string               `protobuf:"bytes,2,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookRequest) Reset() {
+	*x = CreateWebhookRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookRequest) ProtoMessage() {}
+
+func (x *CreateWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookRequest.ProtoReflect.Descriptor instead.
+func (*CreateWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *CreateWebhookRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateWebhookRequest) GetEventTypes() []string {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type CreateWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *WebhookSubscription   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWebhookResponse) Reset() {
+	*x = CreateWebhookResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWebhookResponse) ProtoMessage() {}
+
+func (x *CreateWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWebhookResponse.ProtoReflect.Descriptor instead.
+func (*CreateWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CreateWebhookResponse) GetSubscription() *WebhookSubscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{93}
+}
+
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListWebhooksResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type DeleteWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookRequest) Reset() {
+	*x = DeleteWebhookRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookRequest) ProtoMessage() {}
+
+func (x *DeleteWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *DeleteWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+type DeleteWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhookResponse) Reset() {
+	*x = DeleteWebhookResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhookResponse) ProtoMessage() {}
+
+func (x *DeleteWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{96}
+}
+
+type ListPVZEmployeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZEmployeesRequest) Reset() {
+	*x = ListPVZEmployeesRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZEmployeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZEmployeesRequest) ProtoMessage() {}
+
+func (x *ListPVZEmployeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZEmployeesRequest.ProtoReflect.Descriptor instead.
+func (*ListPVZEmployeesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListPVZEmployeesRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type ListPVZEmployeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*PVZEmployee         `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPVZEmployeesResponse) Reset() {
+	*x = ListPVZEmployeesResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPVZEmployeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPVZEmployeesResponse) ProtoMessage() {}
+
+func (x *ListPVZEmployeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPVZEmployeesResponse.ProtoReflect.Descriptor instead.
+func (*ListPVZEmployeesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListPVZEmployeesResponse) GetEmployees() []*PVZEmployee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
+}
+
+type AssignEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEmployeeRequest) Reset() {
+	*x = AssignEmployeeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEmployeeRequest) ProtoMessage() {}
+
+func (x *AssignEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*AssignEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *AssignEmployeeRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AssignEmployeeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AssignEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *PVZEmployee           `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEmployeeResponse) Reset() {
+	*x = AssignEmployeeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEmployeeResponse) ProtoMessage() {}
+
+func (x *AssignEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*AssignEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *AssignEmployeeResponse) GetEmployee() *PVZEmployee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type UnassignEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignEmployeeRequest) Reset() {
+	*x = UnassignEmployeeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignEmployeeRequest) ProtoMessage() {}
+
+func (x *UnassignEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UnassignEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *UnassignEmployeeRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *UnassignEmployeeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnassignEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignEmployeeResponse) Reset() {
+	*x = UnassignEmployeeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignEmployeeResponse) ProtoMessage() {}
+
+func (x *UnassignEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*UnassignEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{102}
+}
+
+type ListAuditRecordsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    *string                `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3,oneof" json:"entity_type,omitempty"`
+	EntityId      *string                `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3,oneof" json:"entity_id,omitempty"`
+	ActorId       *string                `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3,oneof" json:"actor_id,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to,proto3" json:"to,omitempty"`
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditRecordsRequest) Reset() {
+	*x = ListAuditRecordsRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditRecordsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditRecordsRequest) ProtoMessage() {}
+
+func (x *ListAuditRecordsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditRecordsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditRecordsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListAuditRecordsRequest) GetEntityType() string {
+	if x != nil && x.EntityType != nil {
+		return *x.EntityType
+	}
+	return ""
+}
+
+func (x *ListAuditRecordsRequest) GetEntityId() string {
+	if x != nil && x.EntityId != nil {
+		return *x.EntityId
+	}
+	return ""
+}
+
+func (x *ListAuditRecordsRequest) GetActorId() string {
+	if x != nil && x.ActorId != nil {
+		return *x.ActorId
+	}
+	return ""
+}
+
+func (x *ListAuditRecordsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListAuditRecordsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListAuditRecordsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListAuditRecordsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListAuditRecordsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Records       []*AuditRecord         `protobuf:"bytes,1,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAuditRecordsResponse) Reset() {
+	*x = ListAuditRecordsResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAuditRecordsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditRecordsResponse) ProtoMessage() {}
+
+func (x *ListAuditRecordsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditRecordsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditRecordsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *ListAuditRecordsResponse) GetRecords() []*AuditRecord {
+	if x != nil {
+		return x.Records
+	}
+	return nil
+}
+
+// period - day, week или month, group_by - pvz, city и для товаров type
+type GetReceptionsReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	GroupBy       []string               `protobuf:"bytes,4,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	PvzId         *string                `protobuf:"bytes,5,opt,name=pvz_id,json=pvzId,proto3,oneof" json:"pvz_id,omitempty"`
+	City          *string                `protobuf:"bytes,6,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionsReportRequest) Reset() {
+	*x = GetReceptionsReportRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionsReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionsReportRequest) ProtoMessage() {}
+
+func (x *GetReceptionsReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionsReportRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionsReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetReceptionsReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetReceptionsReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetReceptionsReportRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetReceptionsReportRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *GetReceptionsReportRequest) GetPvzId() string {
+	if x != nil && x.PvzId != nil {
+		return *x.PvzId
+	}
+	return ""
+}
+
+func (x *GetReceptionsReportRequest) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+type GetReceptionsReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ReceptionReportRow  `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionsReportResponse) Reset() {
+	*x = GetReceptionsReportResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionsReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionsReportResponse) ProtoMessage() {}
+
+func (x *GetReceptionsReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionsReportResponse.ProtoReflect.Descriptor instead.
+func (*GetReceptionsReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetReceptionsReportResponse) GetRows() []*ReceptionReportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type GetProductsReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	GroupBy       []string               `protobuf:"bytes,4,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	PvzId         *string                `protobuf:"bytes,5,opt,name=pvz_id,json=pvzId,proto3,oneof" json:"pvz_id,omitempty"`
+	City          *string                `protobuf:"bytes,6,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	Type          *string                `protobuf:"bytes,7,opt,name=type,proto3,oneof" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsReportRequest) Reset() {
+	*x = GetProductsReportRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsReportRequest) ProtoMessage() {}
+
+func (x *GetProductsReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsReportRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsReportRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetProductsReportRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetProductsReportRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetProductsReportRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetProductsReportRequest) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *GetProductsReportRequest) GetPvzId() string {
+	if x != nil && x.PvzId != nil {
+		return *x.PvzId
+	}
+	return ""
+}
+
+func (x *GetProductsReportRequest) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *GetProductsReportRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
+type GetProductsReportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ProductReportRow    `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsReportResponse) Reset() {
+	*x = GetProductsReportResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsReportResponse) ProtoMessage() {}
+
+func (x *GetProductsReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsReportResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsReportResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *GetProductsReportResponse) GetRows() []*ProductReportRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+var File_api_proto_pvz_proto protoreflect.FileDescriptor
+
+const file_api_proto_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\x13api/proto/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x91\x02\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12)\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x11.pvz.v1.PVZStatusR\x06status\x12A\n" +
+	"\x0edeactivated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rdeactivatedAt\x12/\n" +
+	"\blocation\x18\x06 \x01(\v2\x13.pvz.v1.PVZLocationR\blocation\"\xab\x02\n" +
+	"\vPVZLocation\x12\x1b\n" +
+	"\x06street\x18\x01 \x01(\tH\x00R\x06street\x88\x01\x01\x12\x19\n" +
+	"\x05house\x18\x02 \x01(\tH\x01R\x05house\x88\x01\x01\x12$\n" +
+	"\vpostal_code\x18\x03 \x01(\tH\x02R\n" +
+	"postalCode\x88\x01\x01\x12\x1f\n" +
+	"\blatitude\x18\x04 \x01(\x01H\x03R\blatitude\x88\x01\x01\x12!\n" +
+	"\tlongitude\x18\x05 \x01(\x01H\x04R\tlongitude\x88\x01\x01\x12(\n" +
+	"\ropening_hours\x18\x06 \x01(\tH\x05R\fopeningHours\x88\x01\x01B\t\n" +
+	"\a_streetB\b\n" +
+	"\x06_houseB\x0e\n" +
+	"\f_postal_codeB\v\n" +
+	"\t_latitudeB\f\n" +
+	"\n" +
+	"_longitudeB\x10\n" +
+	"\x0e_opening_hours\"\x9c\x01\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\"w\n" +
+	"\fManifestItem\x12\x1d\n" +
+	"\abarcode\x18\x01 \x01(\tH\x00R\abarcode\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x01R\x04type\x88\x01\x01\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantityB\n" +
+	"\n" +
+	"\b_barcodeB\a\n" +
+	"\x05_type\"\xf6\x01\n" +
+	"\x11ReceptionManifest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x124\n" +
+	"\x16block_on_discrepancies\x18\x02 \x01(\bR\x14blockOnDiscrepancies\x12\x1f\n" +
+	"\vuploaded_by\x18\x03 \x01(\tR\n" +
+	"uploadedBy\x12;\n" +
+	"\vuploaded_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"uploadedAt\x12*\n" +
+	"\x05items\x18\x05 \x03(\v2\x14.pvz.v1.ManifestItemR\x05items\"\x95\x03\n" +
+	"\x0eReconciliation\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12.\n" +
+	"\amatched\x18\x02 \x03(\v2\x14.pvz.v1.ManifestItemR\amatched\x12.\n" +
+	"\amissing\x18\x03 \x03(\v2\x14.pvz.v1.ManifestItemR\amissing\x12*\n" +
+	"\x05extra\x18\x04 \x03(\v2\x14.pvz.v1.ManifestItemR\x05extra\x12+\n" +
+	"\x11has_discrepancies\x18\x05 \x01(\bR\x10hasDiscrepancies\x12'\n" +
+	"\x0facknowledged_by\x18\x06 \x01(\tR\x0eacknowledgedBy\x12C\n" +
+	"\x0facknowledged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0eacknowledgedAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xa4\x02\n" +
+	"\x13ReceptionCorrection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\freception_id\x18\x02 \x01(\tR\vreceptionId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tR\tchangedBy\x12%\n" +
+	"\x05added\x18\x06 \x03(\v2\x0f.pvz.v1.ProductR\x05added\x12)\n" +
+	"\aremoved\x18\a \x03(\v2\x0f.pvz.v1.ProductR\aremoved\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xea\x01\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\x12-\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x15.pvz.v1.ProductStatusR\x06status\x120\n" +
+	"\adetails\x18\x06 \x01(\v2\x16.pvz.v1.ProductDetailsR\adetails\"\x88\x03\n" +
+	"\x0eProductDetails\x12\x1d\n" +
+	"\abarcode\x18\x01 \x01(\tH\x00R\abarcode\x88\x01\x01\x12&\n" +
+	"\fweight_grams\x18\x02 \x01(\x05H\x01R\vweightGrams\x88\x01\x01\x12 \n" +
+	"\tlength_mm\x18\x03 \x01(\x05H\x02R\blengthMm\x88\x01\x01\x12\x1e\n" +
+	"\bwidth_mm\x18\x04 \x01(\x05H\x03R\awidthMm\x88\x01\x01\x12 \n" +
+	"\theight_mm\x18\x05 \x01(\x05H\x04R\bheightMm\x88\x01\x01\x12F\n" +
+	"\n" +
+	"attributes\x18\x06 \x03(\v2&.pvz.v1.ProductDetails.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_barcodeB\x0f\n" +
+	"\r_weight_gramsB\f\n" +
+	"\n" +
+	"_length_mmB\v\n" +
+	"\t_width_mmB\f\n" +
+	"\n" +
+	"_height_mm\"R\n" +
+	"\n" +
+	"NewProduct\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x120\n" +
+	"\adetails\x18\x02 \x01(\v2\x16.pvz.v1.ProductDetailsR\adetails\"\x88\x02\n" +
+	"\x13ProductStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x126\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x15.pvz.v1.ProductStatusR\n" +
+	"fromStatus\x122\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x15.pvz.v1.ProductStatusR\btoStatus\x12\x1d\n" +
+	"\n" +
+	"changed_by\x18\x05 \x01(\tR\tchangedBy\x127\n" +
+	"\tdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\"`\n" +
+	"\x0fDictionaryEntry\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x90\x01\n" +
+	"\vPVZEmployee\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12;\n" +
+	"\vassigned_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\"\xab\x01\n" +
+	"\x13WebhookSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x03 \x03(\tR\n" +
+	"eventTypes\x12\x16\n" +
+	"\x06secret\x18\x04 \x01(\tR\x06secret\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb5\x02\n" +
+	"\vAuditRecord\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_role\x18\x03 \x01(\tR\tactorRole\x12\x16\n" +
+	"\x06action\x18\x04 \x01(\tR\x06action\x12\x1f\n" +
+	"\ventity_type\x18\x05 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x06 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06before\x18\a \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\b \x01(\tR\x05after\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\t \x01(\tR\trequestId\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xe5\x01\n" +
+	"\x12ReceptionReportRow\x122\n" +
+	"\x06period\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06period\x12\x1a\n" +
+	"\x06pvz_id\x18\x02 \x01(\tH\x00R\x05pvzId\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x03 \x01(\tH\x01R\x04city\x88\x01\x01\x12\x1e\n" +
+	"\n" +
+	"receptions\x18\x04 \x01(\x05R\n" +
+	"receptions\x12\x16\n" +
+	"\x06closed\x18\x05 \x01(\x05R\x06closed\x12\x1a\n" +
+	"\bproducts\x18\x06 \x01(\x05R\bproductsB\t\n" +
+	"\a_pvz_idB\a\n" +
+	"\x05_city\"\xcd\x01\n" +
+	"\x10ProductReportRow\x122\n" +
+	"\x06period\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x06period\x12\x1a\n" +
+	"\x06pvz_id\x18\x02 \x01(\tH\x00R\x05pvzId\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x03 \x01(\tH\x01R\x04city\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\x04 \x01(\tH\x02R\x04type\x88\x01\x01\x12\x1a\n" +
+	"\bproducts\x18\x05 \x01(\x05R\bproductsB\t\n" +
+	"\a_pvz_idB\a\n" +
+	"\x05_cityB\a\n" +
+	"\x05_type\"@\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"u\n" +
+	"\x15ReceptionWithProducts\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"q\n" +
+	"\x11PVZWithReceptions\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12=\n" +
+	"\n" +
+	"receptions\x18\x02 \x03(\v2\x1d.pvz.v1.ReceptionWithProductsR\n" +
+	"receptions\"\x13\n" +
+	"\x11GetPVZListRequest\"5\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\">\n" +
+	"\x11DummyLoginRequest\x12\x12\n" +
+	"\x04role\x18\x01 \x01(\tR\x04role\x12\x15\n" +
+	"\x06pvz_id\x18\x02 \x01(\tR\x05pvzId\"W\n" +
+	"\x0fRegisterRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"4\n" +
+	"\x10RegisterResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.pvz.v1.UserR\x04user\"@\n" +
+	"\fLoginRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"J\n" +
+	"\rTokenResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12#\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\":\n" +
+	"\x13RefreshTokenRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"4\n" +
+	"\rLogoutRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\"\x10\n" +
+	"\x0eLogoutResponse\"W\n" +
+	"\x10CreatePVZRequest\x12\x12\n" +
+	"\x04city\x18\x01 \x01(\tR\x04city\x12/\n" +
+	"\blocation\x18\x02 \x01(\v2\x13.pvz.v1.PVZLocationR\blocation\"2\n" +
+	"\x11CreatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"\xd0\x02\n" +
+	"\x0eListPVZRequest\x129\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x17\n" +
+	"\x04city\x18\x06 \x01(\tH\x00R\x04city\x88\x01\x01\x12.\n" +
+	"\x10reception_status\x18\a \x01(\tH\x01R\x0freceptionStatus\x88\x01\x01\x12#\n" +
+	"\rinclude_empty\x18\b \x01(\bR\fincludeEmptyB\a\n" +
+	"\x05_cityB\x13\n" +
+	"\x11_reception_status\"a\n" +
+	"\x0fListPVZResponse\x12-\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x19.pvz.v1.PVZWithReceptionsR\x04pvzs\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\"&\n" +
+	"\rGetPVZRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"=\n" +
+	"\x0eGetPVZResponse\x12+\n" +
+	"\x03pvz\x18\x01 \x01(\v2\x19.pvz.v1.PVZWithReceptionsR\x03pvz\"|\n" +
+	"\x10UpdatePVZRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x17\n" +
+	"\x04city\x18\x02 \x01(\tH\x00R\x04city\x88\x01\x01\x12/\n" +
+	"\blocation\x18\x03 \x01(\v2\x13.pvz.v1.PVZLocationR\blocationB\a\n" +
+	"\x05_city\"2\n" +
+	"\x11UpdatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"-\n" +
+	"\x14DeactivatePVZRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"6\n" +
+	"\x15DeactivatePVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\"h\n" +
+	"\x14ListNearbyPVZRequest\x12\x10\n" +
+	"\x03lat\x18\x01 \x01(\x01R\x03lat\x12\x10\n" +
+	"\x03lon\x18\x02 \x01(\x01R\x03lon\x12\x16\n" +
+	"\x06radius\x18\x03 \x01(\x01R\x06radius\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"F\n" +
+	"\tNearbyPVZ\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x01R\bdistance\">\n" +
+	"\x15ListNearbyPVZResponse\x12%\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\x11.pvz.v1.NearbyPVZR\x04pvzs\"/\n" +
+	"\x16CreateReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"J\n" +
+	"\x17CreateReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"2\n" +
+	"\x19CloseLastReceptionRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"M\n" +
+	"\x1aCloseLastReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"\xf2\x01\n" +
+	"\x15ListReceptionsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x00R\x06status\x88\x01\x01\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limitB\t\n" +
+	"\a_status\"K\n" +
+	"\x16ListReceptionsResponse\x121\n" +
+	"\n" +
+	"receptions\x18\x01 \x03(\v2\x11.pvz.v1.ReceptionR\n" +
+	"receptions\"8\n" +
+	"\x13GetReceptionRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\"S\n" +
+	"\x14GetReceptionResponse\x12;\n" +
+	"\treception\x18\x01 \x01(\v2\x1d.pvz.v1.ReceptionWithProductsR\treception\"\xa2\x01\n" +
+	"\x1bSetReceptionManifestRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.pvz.v1.ManifestItemR\x05items\x124\n" +
+	"\x16block_on_discrepancies\x18\x03 \x01(\bR\x14blockOnDiscrepancies\"U\n" +
+	"\x1cSetReceptionManifestResponse\x125\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x19.pvz.v1.ReceptionManifestR\bmanifest\"@\n" +
+	"\x1bGetReceptionManifestRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\"U\n" +
+	"\x1cGetReceptionManifestResponse\x125\n" +
+	"\bmanifest\x18\x01 \x01(\v2\x19.pvz.v1.ReceptionManifestR\bmanifest\"=\n" +
+	"\x18GetReconciliationRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\"[\n" +
+	"\x19GetReconciliationResponse\x12>\n" +
+	"\x0ereconciliation\x18\x01 \x01(\v2\x16.pvz.v1.ReconciliationR\x0ereconciliation\"E\n" +
+	" AcknowledgeReconciliationRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\"c\n" +
+	"!AcknowledgeReconciliationResponse\x12>\n" +
+	"\x0ereconciliation\x18\x01 \x01(\v2\x16.pvz.v1.ReconciliationR\x0ereconciliation\"S\n" +
+	"\x16ReopenReceptionRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"J\n" +
+	"\x17ReopenReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\"\xb7\x01\n" +
+	"\x15AmendReceptionRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x125\n" +
+	"\fadd_products\x18\x03 \x03(\v2\x12.pvz.v1.NewProductR\vaddProducts\x12,\n" +
+	"\x12remove_product_ids\x18\x04 \x03(\tR\x10removeProductIds\"U\n" +
+	"\x16AmendReceptionResponse\x12;\n" +
+	"\n" +
+	"correction\x18\x01 \x01(\v2\x1b.pvz.v1.ReceptionCorrectionR\n" +
+	"correction\"D\n" +
+	"\x1fListReceptionCorrectionsRequest\x12!\n" +
+	"\freception_id\x18\x01 \x01(\tR\vreceptionId\"a\n" +
+	" ListReceptionCorrectionsResponse\x12=\n" +
+	"\vcorrections\x18\x01 \x03(\v2\x1b.pvz.v1.ReceptionCorrectionR\vcorrections\"p\n" +
+	"\x11AddProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x120\n" +
+	"\adetails\x18\x03 \x01(\v2\x16.pvz.v1.ProductDetailsR\adetails\"?\n" +
+	"\x12AddProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"i\n" +
+	"\x10AddProductResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12)\n" +
+	"\aproduct\x18\x02 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"I\n" +
+	"\x13AddProductsResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.pvz.v1.AddProductResultR\aresults\"1\n" +
+	"\x18DeleteLastProductRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"F\n" +
+	"\x19DeleteLastProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"4\n" +
+	"\x13IssueProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"A\n" +
+	"\x14IssueProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"5\n" +
+	"\x14ReturnProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"B\n" +
+	"\x15ReturnProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"9\n" +
+	"\x18GetProductHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"R\n" +
+	"\x19GetProductHistoryResponse\x125\n" +
+	"\ahistory\x18\x01 \x03(\v2\x1b.pvz.v1.ProductStatusChangeR\ahistory\"8\n" +
+	"\x1cFindProductsByBarcodeRequest\x12\x18\n" +
+	"\abarcode\x18\x01 \x01(\tR\abarcode\"L\n" +
+	"\x1dFindProductsByBarcodeResponse\x12+\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"\x13\n" +
+	"\x11ListCitiesRequest\"E\n" +
+	"\x12ListCitiesResponse\x12/\n" +
+	"\x06cities\x18\x01 \x03(\v2\x17.pvz.v1.DictionaryEntryR\x06cities\"'\n" +
+	"\x11CreateCityRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"A\n" +
+	"\x12CreateCityResponse\x12+\n" +
+	"\x04city\x18\x01 \x01(\v2\x17.pvz.v1.DictionaryEntryR\x04city\"'\n" +
+	"\x11DeleteCityRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x14\n" +
+	"\x12DeleteCityResponse\"\x19\n" +
+	"\x17ListProductTypesRequest\"X\n" +
+	"\x18ListProductTypesResponse\x12<\n" +
+	"\rproduct_types\x18\x01 \x03(\v2\x17.pvz.v1.DictionaryEntryR\fproductTypes\".\n" +
+	"\x18CreateProductTypeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"W\n" +
+	"\x19CreateProductTypeResponse\x12:\n" +
+	"\fproduct_type\x18\x01 \x01(\v2\x17.pvz.v1.DictionaryEntryR\vproductType\".\n" +
+	"\x18DeleteProductTypeRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x1b\n" +
+	"\x19DeleteProductTypeResponse\"I\n" +
+	"\x14CreateWebhookRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x1f\n" +
+	"\vevent_types\x18\x02 \x03(\tR\n" +
+	"eventTypes\"X\n" +
+	"\x15CreateWebhookResponse\x12?\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x1b.pvz.v1.WebhookSubscriptionR\fsubscription\"\x15\n" +
+	"\x13ListWebhooksRequest\"Y\n" +
+	"\x14ListWebhooksResponse\x12A\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x1b.pvz.v1.WebhookSubscriptionR\rsubscriptions\"5\n" +
+	"\x14DeleteWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"\x17\n" +
+	"\x15DeleteWebhookResponse\"0\n" +
+	"\x17ListPVZEmployeesRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\"M\n" +
+	"\x18ListPVZEmployeesResponse\x121\n" +
+	"\temployees\x18\x01 \x03(\v2\x13.pvz.v1.PVZEmployeeR\temployees\"G\n" +
+	"\x15AssignEmployeeRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"I\n" +
+	"\x16AssignEmployeeResponse\x12/\n" +
+	"\bemployee\x18\x01 \x01(\v2\x13.pvz.v1.PVZEmployeeR\bemployee\"I\n" +
+	"\x17UnassignEmployeeRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x1a\n" +
+	"\x18UnassignEmployeeResponse\"\xb2\x02\n" +
+	"\x17ListAuditRecordsRequest\x12$\n" +
+	"\ventity_type\x18\x01 \x01(\tH\x00R\n" +
+	"entityType\x88\x01\x01\x12 \n" +
+	"\tentity_id\x18\x02 \x01(\tH\x01R\bentityId\x88\x01\x01\x12\x1e\n" +
+	"\bactor_id\x18\x03 \x01(\tH\x02R\aactorId\x88\x01\x01\x12.\n" +
+	"\x04from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\a \x01(\x05R\x05limitB\x0e\n" +
+	"\f_entity_typeB\f\n" +
+	"\n" +
+	"_entity_idB\v\n" +
+	"\t_actor_id\"I\n" +
+	"\x18ListAuditRecordsResponse\x12-\n" +
+	"\arecords\x18\x01 \x03(\v2\x13.pvz.v1.AuditRecordR\arecords\"\xf4\x01\n" +
+	"\x1aGetReceptionsReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x19\n" +
+	"\bgroup_by\x18\x04 \x03(\tR\agroupBy\x12\x1a\n" +
+	"\x06pvz_id\x18\x05 \x01(\tH\x00R\x05pvzId\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x06 \x01(\tH\x01R\x04city\x88\x01\x01B\t\n" +
+	"\a_pvz_idB\a\n" +
+	"\x05_city\"M\n" +
+	"\x1bGetReceptionsReportResponse\x12.\n" +
+	"\x04rows\x18\x01 \x03(\v2\x1a.pvz.v1.ReceptionReportRowR\x04rows\"\x94\x02\n" +
+	"\x18GetProductsReportRequest\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x19\n" +
+	"\bgroup_by\x18\x04 \x03(\tR\agroupBy\x12\x1a\n" +
+	"\x06pvz_id\x18\x05 \x01(\tH\x00R\x05pvzId\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x06 \x01(\tH\x01R\x04city\x88\x01\x01\x12\x17\n" +
+	"\x04type\x18\a \x01(\tH\x02R\x04type\x88\x01\x01B\t\n" +
+	"\a_pvz_idB\a\n" +
+	"\x05_cityB\a\n" +
+	"\x05_type\"I\n" +
+	"\x19GetProductsReportResponse\x12,\n" +
+	"\x04rows\x18\x01 \x03(\v2\x18.pvz.v1.ProductReportRowR\x04rows*W\n" +
+	"\tPVZStatus\x12\x1a\n" +
+	"\x16PVZ_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11PVZ_STATUS_ACTIVE\x10\x01\x12\x17\n" +
+	"\x13PVZ_STATUS_INACTIVE\x10\x02*\x8d\x01\n" +
+	"\x0fReceptionStatus\x12 \n" +
+	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01\x12\x1d\n" +
+	"\x19RECEPTION_STATUS_REOPENED\x10\x02\x12\x1c\n" +
+	"\x18RECEPTION_STATUS_AMENDED\x10\x03*\x9f\x01\n" +
+	"\rProductStatus\x12\x1e\n" +
+	"\x1aPRODUCT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PRODUCT_STATUS_ACCEPTED\x10\x01\x12\x19\n" +
+	"\x15PRODUCT_STATUS_STORED\x10\x02\x12\x19\n" +
+	"\x15PRODUCT_STATUS_ISSUED\x10\x03\x12\x1b\n" +
+	"\x17PRODUCT_STATUS_RETURNED\x10\x042\x9d\x1c\n" +
+	"\n" +
+	"PVZService\x12C\n" +
+	"\n" +
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x12>\n" +
+	"\n" +
+	"DummyLogin\x12\x19.pvz.v1.DummyLoginRequest\x1a\x15.pvz.v1.TokenResponse\x12=\n" +
+	"\bRegister\x12\x17.pvz.v1.RegisterRequest\x1a\x18.pvz.v1.RegisterResponse\x124\n" +
+	"\x05Login\x12\x14.pvz.v1.LoginRequest\x1a\x15.pvz.v1.TokenResponse\x12B\n" +
+	"\fRefreshToken\x12\x1b.pvz.v1.RefreshTokenRequest\x1a\x15.pvz.v1.TokenResponse\x127\n" +
+	"\x06Logout\x12\x15.pvz.v1.LogoutRequest\x1a\x16.pvz.v1.LogoutResponse\x12@\n" +
+	"\tCreatePVZ\x12\x18.pvz.v1.CreatePVZRequest\x1a\x19.pvz.v1.CreatePVZResponse\x12:\n" +
+	"\aListPVZ\x12\x16.pvz.v1.ListPVZRequest\x1a\x17.pvz.v1.ListPVZResponse\x127\n" +
+	"\x06GetPVZ\x12\x15.pvz.v1.GetPVZRequest\x1a\x16.pvz.v1.GetPVZResponse\x12@\n" +
+	"\tUpdatePVZ\x12\x18.pvz.v1.UpdatePVZRequest\x1a\x19.pvz.v1.UpdatePVZResponse\x12L\n" +
+	"\rDeactivatePVZ\x12\x1c.pvz.v1.DeactivatePVZRequest\x1a\x1d.pvz.v1.DeactivatePVZResponse\x12L\n" +
+	"\rListNearbyPVZ\x12\x1c.pvz.v1.ListNearbyPVZRequest\x1a\x1d.pvz.v1.ListNearbyPVZResponse\x12U\n" +
+	"\x10ListPVZEmployees\x12\x1f.pvz.v1.ListPVZEmployeesRequest\x1a .pvz.v1.ListPVZEmployeesResponse\x12O\n" +
+	"\x0eAssignEmployee\x12\x1d.pvz.v1.AssignEmployeeRequest\x1a\x1e.pvz.v1.AssignEmployeeResponse\x12U\n" +
+	"\x10UnassignEmployee\x12\x1f.pvz.v1.UnassignEmployeeRequest\x1a .pvz.v1.UnassignEmployeeResponse\x12R\n" +
+	"\x0fCreateReception\x12\x1e.pvz.v1.CreateReceptionRequest\x1a\x1f.pvz.v1.CreateReceptionResponse\x12[\n" +
+	"\x12CloseLastReception\x12!.pvz.v1.CloseLastReceptionRequest\x1a\".pvz.v1.CloseLastReceptionResponse\x12O\n" +
+	"\x0eListReceptions\x12\x1d.pvz.v1.ListReceptionsRequest\x1a\x1e.pvz.v1.ListReceptionsResponse\x12I\n" +
+	"\fGetReception\x12\x1b.pvz.v1.GetReceptionRequest\x1a\x1c.pvz.v1.GetReceptionResponse\x12a\n" +
+	"\x14SetReceptionManifest\x12#.pvz.v1.SetReceptionManifestRequest\x1a$.pvz.v1.SetReceptionManifestResponse\x12a\n" +
+	"\x14GetReceptionManifest\x12#.pvz.v1.GetReceptionManifestRequest\x1a$.pvz.v1.GetReceptionManifestResponse\x12X\n" +
+	"\x11GetReconciliation\x12 .pvz.v1.GetReconciliationRequest\x1a!.pvz.v1.GetReconciliationResponse\x12p\n" +
+	"\x19AcknowledgeReconciliation\x12(.pvz.v1.AcknowledgeReconciliationRequest\x1a).pvz.v1.AcknowledgeReconciliationResponse\x12R\n" +
+	"\x0fReopenReception\x12\x1e.pvz.v1.ReopenReceptionRequest\x1a\x1f.pvz.v1.ReopenReceptionResponse\x12O\n" +
+	"\x0eAmendReception\x12\x1d.pvz.v1.AmendReceptionRequest\x1a\x1e.pvz.v1.AmendReceptionResponse\x12m\n" +
+	"\x18ListReceptionCorrections\x12'.pvz.v1.ListReceptionCorrectionsRequest\x1a(.pvz.v1.ListReceptionCorrectionsResponse\x12C\n" +
+	"\n" +
+	"AddProduct\x12\x19.pvz.v1.AddProductRequest\x1a\x1a.pvz.v1.AddProductResponse\x12G\n" +
+	"\vAddProducts\x12\x19.pvz.v1.AddProductRequest\x1a\x1b.pvz.v1.AddProductsResponse(\x01\x12X\n" +
+	"\x11DeleteLastProduct\x12 .pvz.v1.DeleteLastProductRequest\x1a!.pvz.v1.DeleteLastProductResponse\x12I\n" +
+	"\fIssueProduct\x12\x1b.pvz.v1.IssueProductRequest\x1a\x1c.pvz.v1.IssueProductResponse\x12L\n" +
+	"\rReturnProduct\x12\x1c.pvz.v1.ReturnProductRequest\x1a\x1d.pvz.v1.ReturnProductResponse\x12X\n" +
+	"\x11GetProductHistory\x12 .pvz.v1.GetProductHistoryRequest\x1a!.pvz.v1.GetProductHistoryResponse\x12d\n" +
+	"\x15FindProductsByBarcode\x12$.pvz.v1.FindProductsByBarcodeRequest\x1a%.pvz.v1.FindProductsByBarcodeResponse\x12C\n" +
+	"\n" +
+	"ListCities\x12\x19.pvz.v1.ListCitiesRequest\x1a\x1a.pvz.v1.ListCitiesResponse\x12C\n" +
+	"\n" +
+	"CreateCity\x12\x19.pvz.v1.CreateCityRequest\x1a\x1a.pvz.v1.CreateCityResponse\x12C\n" +
 	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseB@Z>github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1;pvz_v1b\x06proto3"
+	"DeleteCity\x12\x19.pvz.v1.DeleteCityRequest\x1a\x1a.pvz.v1.DeleteCityResponse\x12U\n" +
+	"\x10ListProductTypes\x12\x1f.pvz.v1.ListProductTypesRequest\x1a .pvz.v1.ListProductTypesResponse\x12X\n" +
+	"\x11CreateProductType\x12 .pvz.v1.CreateProductTypeRequest\x1a!.pvz.v1.CreateProductTypeResponse\x12X\n" +
+	"\x11DeleteProductType\x12 .pvz.v1.DeleteProductTypeRequest\x1a!.pvz.v1.DeleteProductTypeResponse\x12L\n" +
+	"\rCreateWebhook\x12\x1c.pvz.v1.CreateWebhookRequest\x1a\x1d.pvz.v1.CreateWebhookResponse\x12I\n" +
+	"\fListWebhooks\x12\x1b.pvz.v1.ListWebhooksRequest\x1a\x1c.pvz.v1.ListWebhooksResponse\x12L\n" +
+	"\rDeleteWebhook\x12\x1c.pvz.v1.DeleteWebhookRequest\x1a\x1d.pvz.v1.DeleteWebhookResponse\x12U\n" +
+	"\x10ListAuditRecords\x12\x1f.pvz.v1.ListAuditRecordsRequest\x1a .pvz.v1.ListAuditRecordsResponse\x12^\n" +
+	"\x13GetReceptionsReport\x12\".pvz.v1.GetReceptionsReportRequest\x1a#.pvz.v1.GetReceptionsReportResponse\x12X\n" +
+	"\x11GetProductsReport\x12 .pvz.v1.GetProductsReportRequest\x1a!.pvz.v1.GetProductsReportResponseB?Z=github.com/DarRo9/pvz_service/internal/grpc/pvz/pvz_v1;pvz_v1b\x06proto3"
 
 var (
 	file_api_proto_pvz_proto_rawDescOnce sync.Once
@@ -240,25 +6493,308 @@ func file_api_proto_pvz_proto_rawDescGZIP() []byte {
 	return file_api_proto_pvz_proto_rawDescData
 }
 
-var file_api_proto_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_proto_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 110)
 var file_api_proto_pvz_proto_goTypes = []any{
-	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(PVZStatus)(0),                            // 0: pvz.v1.PVZStatus
+	(ReceptionStatus)(0),                      // 1: pvz.v1.ReceptionStatus
+	(ProductStatus)(0),                        // 2: pvz.v1.ProductStatus
+	(*PVZ)(nil),                               // 3: pvz.v1.PVZ
+	(*PVZLocation)(nil),                       // 4: pvz.v1.PVZLocation
+	(*Reception)(nil),                         // 5: pvz.v1.Reception
+	(*ManifestItem)(nil),                      // 6: pvz.v1.ManifestItem
+	(*ReceptionManifest)(nil),                 // 7: pvz.v1.ReceptionManifest
+	(*Reconciliation)(nil),                    // 8: pvz.v1.Reconciliation
+	(*ReceptionCorrection)(nil),               // 9: pvz.v1.ReceptionCorrection
+	(*Product)(nil),                           // 10: pvz.v1.Product
+	(*ProductDetails)(nil),                    // 11: pvz.v1.ProductDetails
+	(*NewProduct)(nil),                        // 12: pvz.v1.NewProduct
+	(*ProductStatusChange)(nil),               // 13: pvz.v1.ProductStatusChange
+	(*DictionaryEntry)(nil),                   // 14: pvz.v1.DictionaryEntry
+	(*PVZEmployee)(nil),                       // 15: pvz.v1.PVZEmployee
+	(*WebhookSubscription)(nil),               // 16: pvz.v1.WebhookSubscription
+	(*AuditRecord)(nil),                       // 17: pvz.v1.AuditRecord
+	(*ReceptionReportRow)(nil),                // 18: pvz.v1.ReceptionReportRow
+	(*ProductReportRow)(nil),                  // 19: pvz.v1.ProductReportRow
+	(*User)(nil),                              // 20: pvz.v1.User
+	(*ReceptionWithProducts)(nil),             // 21: pvz.v1.ReceptionWithProducts
+	(*PVZWithReceptions)(nil),                 // 22: pvz.v1.PVZWithReceptions
+	(*GetPVZListRequest)(nil),                 // 23: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),                // 24: pvz.v1.GetPVZListResponse
+	(*DummyLoginRequest)(nil),                 // 25: pvz.v1.DummyLoginRequest
+	(*RegisterRequest)(nil),                   // 26: pvz.v1.RegisterRequest
+	(*RegisterResponse)(nil),                  // 27: pvz.v1.RegisterResponse
+	(*LoginRequest)(nil),                      // 28: pvz.v1.LoginRequest
+	(*TokenResponse)(nil),                     // 29: pvz.v1.TokenResponse
+	(*RefreshTokenRequest)(nil),               // 30: pvz.v1.RefreshTokenRequest
+	(*LogoutRequest)(nil),                     // 31: pvz.v1.LogoutRequest
+	(*LogoutResponse)(nil),                    // 32: pvz.v1.LogoutResponse
+	(*CreatePVZRequest)(nil),                  // 33: pvz.v1.CreatePVZRequest
+	(*CreatePVZResponse)(nil),                 // 34: pvz.v1.CreatePVZResponse
+	(*ListPVZRequest)(nil),                    // 35: pvz.v1.ListPVZRequest
+	(*ListPVZResponse)(nil),                   // 36: pvz.v1.ListPVZResponse
+	(*GetPVZRequest)(nil),                     // 37: pvz.v1.GetPVZRequest
+	(*GetPVZResponse)(nil),                    // 38: pvz.v1.GetPVZResponse
+	(*UpdatePVZRequest)(nil),                  // 39: pvz.v1.UpdatePVZRequest
+	(*UpdatePVZResponse)(nil),                 // 40: pvz.v1.UpdatePVZResponse
+	(*DeactivatePVZRequest)(nil),              // 41: pvz.v1.DeactivatePVZRequest
+	(*DeactivatePVZResponse)(nil),             // 42: pvz.v1.DeactivatePVZResponse
+	(*ListNearbyPVZRequest)(nil),              // 43: pvz.v1.ListNearbyPVZRequest
+	(*NearbyPVZ)(nil),                         // 44: pvz.v1.NearbyPVZ
+	(*ListNearbyPVZResponse)(nil),             // 45: pvz.v1.ListNearbyPVZResponse
+	(*CreateReceptionRequest)(nil),            // 46: pvz.v1.CreateReceptionRequest
+	(*CreateReceptionResponse)(nil),           // 47: pvz.v1.CreateReceptionResponse
+	(*CloseLastReceptionRequest)(nil),         // 48: pvz.v1.CloseLastReceptionRequest
+	(*CloseLastReceptionResponse)(nil),        // 49: pvz.v1.CloseLastReceptionResponse
+	(*ListReceptionsRequest)(nil),             // 50: pvz.v1.ListReceptionsRequest
+	(*ListReceptionsResponse)(nil),            // 51: pvz.v1.ListReceptionsResponse
+	(*GetReceptionRequest)(nil),               // 52: pvz.v1.GetReceptionRequest
+	(*GetReceptionResponse)(nil),              // 53: pvz.v1.GetReceptionResponse
+	(*SetReceptionManifestRequest)(nil),       // 54: pvz.v1.SetReceptionManifestRequest
+	(*SetReceptionManifestResponse)(nil),      // 55: pvz.v1.SetReceptionManifestResponse
+	(*GetReceptionManifestRequest)(nil),       // 56: pvz.v1.GetReceptionManifestRequest
+	(*GetReceptionManifestResponse)(nil),      // 57: pvz.v1.GetReceptionManifestResponse
+	(*GetReconciliationRequest)(nil),          // 58: pvz.v1.GetReconciliationRequest
+	(*GetReconciliationResponse)(nil),         // 59: pvz.v1.GetReconciliationResponse
+	(*AcknowledgeReconciliationRequest)(nil),  // 60: pvz.v1.AcknowledgeReconciliationRequest
+	(*AcknowledgeReconciliationResponse)(nil), // 61: pvz.v1.AcknowledgeReconciliationResponse
+	(*ReopenReceptionRequest)(nil),            // 62: pvz.v1.ReopenReceptionRequest
+	(*ReopenReceptionResponse)(nil),           // 63: pvz.v1.ReopenReceptionResponse
+	(*AmendReceptionRequest)(nil),             // 64: pvz.v1.AmendReceptionRequest
+	(*AmendReceptionResponse)(nil),            // 65: pvz.v1.AmendReceptionResponse
+	(*ListReceptionCorrectionsRequest)(nil),   // 66: pvz.v1.ListReceptionCorrectionsRequest
+	(*ListReceptionCorrectionsResponse)(nil),  // 67: pvz.v1.ListReceptionCorrectionsResponse
+	(*AddProductRequest)(nil),                 // 68: pvz.v1.AddProductRequest
+	(*AddProductResponse)(nil),                // 69: pvz.v1.AddProductResponse
+	(*AddProductResult)(nil),                  // 70: pvz.v1.AddProductResult
+	(*AddProductsResponse)(nil),               // 71: pvz.v1.AddProductsResponse
+	(*DeleteLastProductRequest)(nil),          // 72: pvz.v1.DeleteLastProductRequest
+	(*DeleteLastProductResponse)(nil),         // 73: pvz.v1.DeleteLastProductResponse
+	(*IssueProductRequest)(nil),               // 74: pvz.v1.IssueProductRequest
+	(*IssueProductResponse)(nil),              // 75: pvz.v1.IssueProductResponse
+	(*ReturnProductRequest)(nil),              // 76: pvz.v1.ReturnProductRequest
+	(*ReturnProductResponse)(nil),             // 77: pvz.v1.ReturnProductResponse
+	(*GetProductHistoryRequest)(nil),          // 78: pvz.v1.GetProductHistoryRequest
+	(*GetProductHistoryResponse)(nil),         // 79: pvz.v1.GetProductHistoryResponse
+	(*FindProductsByBarcodeRequest)(nil),      // 80: pvz.v1.FindProductsByBarcodeRequest
+	(*FindProductsByBarcodeResponse)(nil),     // 81: pvz.v1.FindProductsByBarcodeResponse
+	(*ListCitiesRequest)(nil),                 // 82: pvz.v1.ListCitiesRequest
+	(*ListCitiesResponse)(nil),                // 83: pvz.v1.ListCitiesResponse
+	(*CreateCityRequest)(nil),                 // 84: pvz.v1.CreateCityRequest
+	(*CreateCityResponse)(nil),                // 85: pvz.v1.CreateCityResponse
+	(*DeleteCityRequest)(nil),                 // 86: pvz.v1.DeleteCityRequest
+	(*DeleteCityResponse)(nil),                // 87: pvz.v1.DeleteCityResponse
+	(*ListProductTypesRequest)(nil),           // 88: pvz.v1.ListProductTypesRequest
+	(*ListProductTypesResponse)(nil),          // 89: pvz.v1.ListProductTypesResponse
+	(*CreateProductTypeRequest)(nil),          // 90: pvz.v1.CreateProductTypeRequest
+	(*CreateProductTypeResponse)(nil),         // 91: pvz.v1.CreateProductTypeResponse
+	(*DeleteProductTypeRequest)(nil),          // 92: pvz.v1.DeleteProductTypeRequest
+	(*DeleteProductTypeResponse)(nil),         // 93: pvz.v1.DeleteProductTypeResponse
+	(*CreateWebhookRequest)(nil),              // 94: pvz.v1.CreateWebhookRequest
+	(*CreateWebhookResponse)(nil),             // 95: pvz.v1.CreateWebhookResponse
+	(*ListWebhooksRequest)(nil),               // 96: pvz.v1.ListWebhooksRequest
+	(*ListWebhooksResponse)(nil),              // 97: pvz.v1.ListWebhooksResponse
+	(*DeleteWebhookRequest)(nil),              // 98: pvz.v1.DeleteWebhookRequest
+	(*DeleteWebhookResponse)(nil),             // 99: pvz.v1.DeleteWebhookResponse
+	(*ListPVZEmployeesRequest)(nil),           // 100: pvz.v1.ListPVZEmployeesRequest
+	(*ListPVZEmployeesResponse)(nil),          // 101: pvz.v1.ListPVZEmployeesResponse
+	(*AssignEmployeeRequest)(nil),             // 102: pvz.v1.AssignEmployeeRequest
+	(*AssignEmployeeResponse)(nil),            // 103: pvz.v1.AssignEmployeeResponse
+	(*UnassignEmployeeRequest)(nil),           // 104: pvz.v1.UnassignEmployeeRequest
+	(*UnassignEmployeeResponse)(nil),          // 105: pvz.v1.UnassignEmployeeResponse
+	(*ListAuditRecordsRequest)(nil),           // 106: pvz.v1.ListAuditRecordsRequest
+	(*ListAuditRecordsResponse)(nil),          // 107: pvz.v1.ListAuditRecordsResponse
+	(*GetReceptionsReportRequest)(nil),        // 108: pvz.v1.GetReceptionsReportRequest
+	(*GetReceptionsReportResponse)(nil),       // 109: pvz.v1.GetReceptionsReportResponse
+	(*GetProductsReportRequest)(nil),          // 110: pvz.v1.GetProductsReportRequest
+	(*GetProductsReportResponse)(nil),         // 111: pvz.v1.GetProductsReportResponse
+	nil,                                       // 112: pvz.v1.ProductDetails.AttributesEntry
+	(*timestamppb.Timestamp)(nil),             // 113: google.protobuf.Timestamp
 }
 var file_api_proto_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	113, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	0,   // 1: pvz.v1.PVZ.status:type_name -> pvz.v1.PVZStatus
+	113, // 2: pvz.v1.PVZ.deactivated_at:type_name -> google.protobuf.Timestamp
+	4,   // 3: pvz.v1.PVZ.location:type_name -> pvz.v1.PVZLocation
+	113, // 4: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	1,   // 5: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	113, // 6: pvz.v1.ReceptionManifest.uploaded_at:type_name -> google.protobuf.Timestamp
+	6,   // 7: pvz.v1.ReceptionManifest.items:type_name -> pvz.v1.ManifestItem
+	6,   // 8: pvz.v1.Reconciliation.matched:type_name -> pvz.v1.ManifestItem
+	6,   // 9: pvz.v1.Reconciliation.missing:type_name -> pvz.v1.ManifestItem
+	6,   // 10: pvz.v1.Reconciliation.extra:type_name -> pvz.v1.ManifestItem
+	113, // 11: pvz.v1.Reconciliation.acknowledged_at:type_name -> google.protobuf.Timestamp
+	113, // 12: pvz.v1.Reconciliation.created_at:type_name -> google.protobuf.Timestamp
+	10,  // 13: pvz.v1.ReceptionCorrection.added:type_name -> pvz.v1.Product
+	10,  // 14: pvz.v1.ReceptionCorrection.removed:type_name -> pvz.v1.Product
+	113, // 15: pvz.v1.ReceptionCorrection.created_at:type_name -> google.protobuf.Timestamp
+	113, // 16: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	2,   // 17: pvz.v1.Product.status:type_name -> pvz.v1.ProductStatus
+	11,  // 18: pvz.v1.Product.details:type_name -> pvz.v1.ProductDetails
+	112, // 19: pvz.v1.ProductDetails.attributes:type_name -> pvz.v1.ProductDetails.AttributesEntry
+	11,  // 20: pvz.v1.NewProduct.details:type_name -> pvz.v1.ProductDetails
+	2,   // 21: pvz.v1.ProductStatusChange.from_status:type_name -> pvz.v1.ProductStatus
+	2,   // 22: pvz.v1.ProductStatusChange.to_status:type_name -> pvz.v1.ProductStatus
+	113, // 23: pvz.v1.ProductStatusChange.date_time:type_name -> google.protobuf.Timestamp
+	113, // 24: pvz.v1.DictionaryEntry.created_at:type_name -> google.protobuf.Timestamp
+	113, // 25: pvz.v1.PVZEmployee.assigned_at:type_name -> google.protobuf.Timestamp
+	113, // 26: pvz.v1.WebhookSubscription.created_at:type_name -> google.protobuf.Timestamp
+	113, // 27: pvz.v1.AuditRecord.created_at:type_name -> google.protobuf.Timestamp
+	113, // 28: pvz.v1.ReceptionReportRow.period:type_name -> google.protobuf.Timestamp
+	113, // 29: pvz.v1.ProductReportRow.period:type_name -> google.protobuf.Timestamp
+	5,   // 30: pvz.v1.ReceptionWithProducts.reception:type_name -> pvz.v1.Reception
+	10,  // 31: pvz.v1.ReceptionWithProducts.products:type_name -> pvz.v1.Product
+	3,   // 32: pvz.v1.PVZWithReceptions.pvz:type_name -> pvz.v1.PVZ
+	21,  // 33: pvz.v1.PVZWithReceptions.receptions:type_name -> pvz.v1.ReceptionWithProducts
+	3,   // 34: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	20,  // 35: pvz.v1.RegisterResponse.user:type_name -> pvz.v1.User
+	4,   // 36: pvz.v1.CreatePVZRequest.location:type_name -> pvz.v1.PVZLocation
+	3,   // 37: pvz.v1.CreatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	113, // 38: pvz.v1.ListPVZRequest.start_date:type_name -> google.protobuf.Timestamp
+	113, // 39: pvz.v1.ListPVZRequest.end_date:type_name -> google.protobuf.Timestamp
+	22,  // 40: pvz.v1.ListPVZResponse.pvzs:type_name -> pvz.v1.PVZWithReceptions
+	22,  // 41: pvz.v1.GetPVZResponse.pvz:type_name -> pvz.v1.PVZWithReceptions
+	4,   // 42: pvz.v1.UpdatePVZRequest.location:type_name -> pvz.v1.PVZLocation
+	3,   // 43: pvz.v1.UpdatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	3,   // 44: pvz.v1.DeactivatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	3,   // 45: pvz.v1.NearbyPVZ.pvz:type_name -> pvz.v1.PVZ
+	44,  // 46: pvz.v1.ListNearbyPVZResponse.pvzs:type_name -> pvz.v1.NearbyPVZ
+	5,   // 47: pvz.v1.CreateReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,   // 48: pvz.v1.CloseLastReceptionResponse.reception:type_name -> pvz.v1.Reception
+	113, // 49: pvz.v1.ListReceptionsRequest.start_date:type_name -> google.protobuf.Timestamp
+	113, // 50: pvz.v1.ListReceptionsRequest.end_date:type_name -> google.protobuf.Timestamp
+	5,   // 51: pvz.v1.ListReceptionsResponse.receptions:type_name -> pvz.v1.Reception
+	21,  // 52: pvz.v1.GetReceptionResponse.reception:type_name -> pvz.v1.ReceptionWithProducts
+	6,   // 53: pvz.v1.SetReceptionManifestRequest.items:type_name -> pvz.v1.ManifestItem
+	7,   // 54: pvz.v1.SetReceptionManifestResponse.manifest:type_name -> pvz.v1.ReceptionManifest
+	7,   // 55: pvz.v1.GetReceptionManifestResponse.manifest:type_name -> pvz.v1.ReceptionManifest
+	8,   // 56: pvz.v1.GetReconciliationResponse.reconciliation:type_name -> pvz.v1.Reconciliation
+	8,   // 57: pvz.v1.AcknowledgeReconciliationResponse.reconciliation:type_name -> pvz.v1.Reconciliation
+	5,   // 58: pvz.v1.ReopenReceptionResponse.reception:type_name -> pvz.v1.Reception
+	12,  // 59: pvz.v1.AmendReceptionRequest.add_products:type_name -> pvz.v1.NewProduct
+	9,   // 60: pvz.v1.AmendReceptionResponse.correction:type_name -> pvz.v1.ReceptionCorrection
+	9,   // 61: pvz.v1.ListReceptionCorrectionsResponse.corrections:type_name -> pvz.v1.ReceptionCorrection
+	11,  // 62: pvz.v1.AddProductRequest.details:type_name -> pvz.v1.ProductDetails
+	10,  // 63: pvz.v1.AddProductResponse.product:type_name -> pvz.v1.Product
+	10,  // 64: pvz.v1.AddProductResult.product:type_name -> pvz.v1.Product
+	70,  // 65: pvz.v1.AddProductsResponse.results:type_name -> pvz.v1.AddProductResult
+	10,  // 66: pvz.v1.DeleteLastProductResponse.product:type_name -> pvz.v1.Product
+	10,  // 67: pvz.v1.IssueProductResponse.product:type_name -> pvz.v1.Product
+	10,  // 68: pvz.v1.ReturnProductResponse.product:type_name -> pvz.v1.Product
+	13,  // 69: pvz.v1.GetProductHistoryResponse.history:type_name -> pvz.v1.ProductStatusChange
+	10,  // 70: pvz.v1.FindProductsByBarcodeResponse.products:type_name -> pvz.v1.Product
+	14,  // 71: pvz.v1.ListCitiesResponse.cities:type_name -> pvz.v1.DictionaryEntry
+	14,  // 72: pvz.v1.CreateCityResponse.city:type_name -> pvz.v1.DictionaryEntry
+	14,  // 73: pvz.v1.ListProductTypesResponse.product_types:type_name -> pvz.v1.DictionaryEntry
+	14,  // 74: pvz.v1.CreateProductTypeResponse.product_type:type_name -> pvz.v1.DictionaryEntry
+	16,  // 75: pvz.v1.CreateWebhookResponse.subscription:type_name -> pvz.v1.WebhookSubscription
+	16,  // 76: pvz.v1.ListWebhooksResponse.subscriptions:type_name -> pvz.v1.WebhookSubscription
+	15,  // 77: pvz.v1.ListPVZEmployeesResponse.employees:type_name -> pvz.v1.PVZEmployee
+	15,  // 78: pvz.v1.AssignEmployeeResponse.employee:type_name -> pvz.v1.PVZEmployee
+	113, // 79: pvz.v1.ListAuditRecordsRequest.from:type_name -> google.protobuf.Timestamp
+	113, // 80: pvz.v1.ListAuditRecordsRequest.to:type_name -> google.protobuf.Timestamp
+	17,  // 81: pvz.v1.ListAuditRecordsResponse.records:type_name -> pvz.v1.AuditRecord
+	113, // 82: pvz.v1.GetReceptionsReportRequest.from:type_name -> google.protobuf.Timestamp
+	113, // 83: pvz.v1.GetReceptionsReportRequest.to:type_name -> google.protobuf.Timestamp
+	18,  // 84: pvz.v1.GetReceptionsReportResponse.rows:type_name -> pvz.v1.ReceptionReportRow
+	113, // 85: pvz.v1.GetProductsReportRequest.from:type_name -> google.protobuf.Timestamp
+	113, // 86: pvz.v1.GetProductsReportRequest.to:type_name -> google.protobuf.Timestamp
+	19,  // 87: pvz.v1.GetProductsReportResponse.rows:type_name -> pvz.v1.ProductReportRow
+	23,  // 88: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	25,  // 89: pvz.v1.PVZService.DummyLogin:input_type -> pvz.v1.DummyLoginRequest
+	26,  // 90: pvz.v1.PVZService.Register:input_type -> pvz.v1.RegisterRequest
+	28,  // 91: pvz.v1.PVZService.Login:input_type -> pvz.v1.LoginRequest
+	30,  // 92: pvz.v1.PVZService.RefreshToken:input_type -> pvz.v1.RefreshTokenRequest
+	31,  // 93: pvz.v1.PVZService.Logout:input_type -> pvz.v1.LogoutRequest
+	33,  // 94: pvz.v1.PVZService.CreatePVZ:input_type -> pvz.v1.CreatePVZRequest
+	35,  // 95: pvz.v1.PVZService.ListPVZ:input_type -> pvz.v1.ListPVZRequest
+	37,  // 96: pvz.v1.PVZService.GetPVZ:input_type -> pvz.v1.GetPVZRequest
+	39,  // 97: pvz.v1.PVZService.UpdatePVZ:input_type -> pvz.v1.UpdatePVZRequest
+	41,  // 98: pvz.v1.PVZService.DeactivatePVZ:input_type -> pvz.v1.DeactivatePVZRequest
+	43,  // 99: pvz.v1.PVZService.ListNearbyPVZ:input_type -> pvz.v1.ListNearbyPVZRequest
+	100, // 100: pvz.v1.PVZService.ListPVZEmployees:input_type -> pvz.v1.ListPVZEmployeesRequest
+	102, // 101: pvz.v1.PVZService.AssignEmployee:input_type -> pvz.v1.AssignEmployeeRequest
+	104, // 102: pvz.v1.PVZService.UnassignEmployee:input_type -> pvz.v1.UnassignEmployeeRequest
+	46,  // 103: pvz.v1.PVZService.CreateReception:input_type -> pvz.v1.CreateReceptionRequest
+	48,  // 104: pvz.v1.PVZService.CloseLastReception:input_type -> pvz.v1.CloseLastReceptionRequest
+	50,  // 105: pvz.v1.PVZService.ListReceptions:input_type -> pvz.v1.ListReceptionsRequest
+	52,  // 106: pvz.v1.PVZService.GetReception:input_type -> pvz.v1.GetReceptionRequest
+	54,  // 107: pvz.v1.PVZService.SetReceptionManifest:input_type -> pvz.v1.SetReceptionManifestRequest
+	56,  // 108: pvz.v1.PVZService.GetReceptionManifest:input_type -> pvz.v1.GetReceptionManifestRequest
+	58,  // 109: pvz.v1.PVZService.GetReconciliation:input_type -> pvz.v1.GetReconciliationRequest
+	60,  // 110: pvz.v1.PVZService.AcknowledgeReconciliation:input_type -> pvz.v1.AcknowledgeReconciliationRequest
+	62,  // 111: pvz.v1.PVZService.ReopenReception:input_type -> pvz.v1.ReopenReceptionRequest
+	64,  // 112: pvz.v1.PVZService.AmendReception:input_type -> pvz.v1.AmendReceptionRequest
+	66,  // 113: pvz.v1.PVZService.ListReceptionCorrections:input_type -> pvz.v1.ListReceptionCorrectionsRequest
+	68,  // 114: pvz.v1.PVZService.AddProduct:input_type -> pvz.v1.AddProductRequest
+	68,  // 115: pvz.v1.PVZService.AddProducts:input_type -> pvz.v1.AddProductRequest
+	72,  // 116: pvz.v1.PVZService.DeleteLastProduct:input_type -> pvz.v1.DeleteLastProductRequest
+	74,  // 117: pvz.v1.PVZService.IssueProduct:input_type -> pvz.v1.IssueProductRequest
+	76,  // 118: pvz.v1.PVZService.ReturnProduct:input_type -> pvz.v1.ReturnProductRequest
+	78,  // 119: pvz.v1.PVZService.GetProductHistory:input_type -> pvz.v1.GetProductHistoryRequest
+	80,  // 120: pvz.v1.PVZService.FindProductsByBarcode:input_type -> pvz.v1.FindProductsByBarcodeRequest
+	82,  // 121: pvz.v1.PVZService.ListCities:input_type -> pvz.v1.ListCitiesRequest
+	84,  // 122: pvz.v1.PVZService.CreateCity:input_type -> pvz.v1.CreateCityRequest
+	86,  // 123: pvz.v1.PVZService.DeleteCity:input_type -> pvz.v1.DeleteCityRequest
+	88,  // 124: pvz.v1.PVZService.ListProductTypes:input_type -> pvz.v1.ListProductTypesRequest
+	90,  // 125: pvz.v1.PVZService.CreateProductType:input_type -> pvz.v1.CreateProductTypeRequest
+	92,  // 126: pvz.v1.PVZService.DeleteProductType:input_type -> pvz.v1.DeleteProductTypeRequest
+	94,  // 127: pvz.v1.PVZService.CreateWebhook:input_type -> pvz.v1.CreateWebhookRequest
+	96,  // 128: pvz.v1.PVZService.ListWebhooks:input_type -> pvz.v1.ListWebhooksRequest
+	98,  // 129: pvz.v1.PVZService.DeleteWebhook:input_type -> pvz.v1.DeleteWebhookRequest
+	106, // 130: pvz.v1.PVZService.ListAuditRecords:input_type -> pvz.v1.ListAuditRecordsRequest
+	108, // 131: pvz.v1.PVZService.GetReceptionsReport:input_type -> pvz.v1.GetReceptionsReportRequest
+	110, // 132: pvz.v1.PVZService.GetProductsReport:input_type -> pvz.v1.GetProductsReportRequest
+	24,  // 133: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	29,  // 134: pvz.v1.PVZService.DummyLogin:output_type -> pvz.v1.TokenResponse
+	27,  // 135: pvz.v1.PVZService.Register:output_type -> pvz.v1.RegisterResponse
+	29,  // 136: pvz.v1.PVZService.Login:output_type -> pvz.v1.TokenResponse
+	29,  // 137: pvz.v1.PVZService.RefreshToken:output_type -> pvz.v1.TokenResponse
+	32,  // 138: pvz.v1.PVZService.Logout:output_type -> pvz.v1.LogoutResponse
+	34,  // 139: pvz.v1.PVZService.CreatePVZ:output_type -> pvz.v1.CreatePVZResponse
+	36,  // 140: pvz.v1.PVZService.ListPVZ:output_type -> pvz.v1.ListPVZResponse
+	38,  // 141: pvz.v1.PVZService.GetPVZ:output_type -> pvz.v1.GetPVZResponse
+	40,  // 142: pvz.v1.PVZService.UpdatePVZ:output_type -> pvz.v1.UpdatePVZResponse
+	42,  // 143: pvz.v1.PVZService.DeactivatePVZ:output_type -> pvz.v1.DeactivatePVZResponse
+	45,  // 144: pvz.v1.PVZService.ListNearbyPVZ:output_type -> pvz.v1.ListNearbyPVZResponse
+	101, // 145: pvz.v1.PVZService.ListPVZEmployees:output_type -> pvz.v1.ListPVZEmployeesResponse
+	103, // 146: pvz.v1.PVZService.AssignEmployee:output_type -> pvz.v1.AssignEmployeeResponse
+	105, // 147: pvz.v1.PVZService.UnassignEmployee:output_type -> pvz.v1.UnassignEmployeeResponse
+	47,  // 148: pvz.v1.PVZService.CreateReception:output_type -> pvz.v1.CreateReceptionResponse
+	49,  // 149: pvz.v1.PVZService.CloseLastReception:output_type -> pvz.v1.CloseLastReceptionResponse
+	51,  // 150: pvz.v1.PVZService.ListReceptions:output_type -> pvz.v1.ListReceptionsResponse
+	53,  // 151: pvz.v1.PVZService.GetReception:output_type -> pvz.v1.GetReceptionResponse
+	55,  // 152: pvz.v1.PVZService.SetReceptionManifest:output_type -> pvz.v1.SetReceptionManifestResponse
+	57,  // 153: pvz.v1.PVZService.GetReceptionManifest:output_type -> pvz.v1.GetReceptionManifestResponse
+	59,  // 154: pvz.v1.PVZService.GetReconciliation:output_type -> pvz.v1.GetReconciliationResponse
+	61,  // 155: pvz.v1.PVZService.AcknowledgeReconciliation:output_type -> pvz.v1.AcknowledgeReconciliationResponse
+	63,  // 156: pvz.v1.PVZService.ReopenReception:output_type -> pvz.v1.ReopenReceptionResponse
+	65,  // 157: pvz.v1.PVZService.AmendReception:output_type -> pvz.v1.AmendReceptionResponse
+	67,  // 158: pvz.v1.PVZService.ListReceptionCorrections:output_type -> pvz.v1.ListReceptionCorrectionsResponse
+	69,  // 159: pvz.v1.PVZService.AddProduct:output_type -> pvz.v1.AddProductResponse
+	71,  // 160: pvz.v1.PVZService.AddProducts:output_type -> pvz.v1.AddProductsResponse
+	73,  // 161: pvz.v1.PVZService.DeleteLastProduct:output_type -> pvz.v1.DeleteLastProductResponse
+	75,  // 162: pvz.v1.PVZService.IssueProduct:output_type -> pvz.v1.IssueProductResponse
+	77,  // 163: pvz.v1.PVZService.ReturnProduct:output_type -> pvz.v1.ReturnProductResponse
+	79,  // 164: pvz.v1.PVZService.GetProductHistory:output_type -> pvz.v1.GetProductHistoryResponse
+	81,  // 165: pvz.v1.PVZService.FindProductsByBarcode:output_type -> pvz.v1.FindProductsByBarcodeResponse
+	83,  // 166: pvz.v1.PVZService.ListCities:output_type -> pvz.v1.ListCitiesResponse
+	85,  // 167: pvz.v1.PVZService.CreateCity:output_type -> pvz.v1.CreateCityResponse
+	87,  // 168: pvz.v1.PVZService.DeleteCity:output_type -> pvz.v1.DeleteCityResponse
+	89,  // 169: pvz.v1.PVZService.ListProductTypes:output_type -> pvz.v1.ListProductTypesResponse
+	91,  // 170: pvz.v1.PVZService.CreateProductType:output_type -> pvz.v1.CreateProductTypeResponse
+	93,  // 171: pvz.v1.PVZService.DeleteProductType:output_type -> pvz.v1.DeleteProductTypeResponse
+	95,  // 172: pvz.v1.PVZService.CreateWebhook:output_type -> pvz.v1.CreateWebhookResponse
+	97,  // 173: pvz.v1.PVZService.ListWebhooks:output_type -> pvz.v1.ListWebhooksResponse
+	99,  // 174: pvz.v1.PVZService.DeleteWebhook:output_type -> pvz.v1.DeleteWebhookResponse
+	107, // 175: pvz.v1.PVZService.ListAuditRecords:output_type -> pvz.v1.ListAuditRecordsResponse
+	109, // 176: pvz.v1.PVZService.GetReceptionsReport:output_type -> pvz.v1.GetReceptionsReportResponse
+	111, // 177: pvz.v1.PVZService.GetProductsReport:output_type -> pvz.v1.GetProductsReportResponse
+	133, // [133:178] is the sub-list for method output_type
+	88,  // [88:133] is the sub-list for method input_type
+	88,  // [88:88] is the sub-list for extension type_name
+	88,  // [88:88] is the sub-list for extension extendee
+	0,   // [0:88] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_pvz_proto_init() }
@@ -266,13 +6802,24 @@ func file_api_proto_pvz_proto_init() {
 	if File_api_proto_pvz_proto != nil {
 		return
 	}
+	file_api_proto_pvz_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[8].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[15].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[16].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[32].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[36].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[47].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[103].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[105].OneofWrappers = []any{}
+	file_api_proto_pvz_proto_msgTypes[107].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_pvz_proto_rawDesc), len(file_api_proto_pvz_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   110,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
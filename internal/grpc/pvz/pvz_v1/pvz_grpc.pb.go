@@ -19,7 +19,51 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PVZService_GetPVZList_FullMethodName = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_GetPVZList_FullMethodName                = "/pvz.v1.PVZService/GetPVZList"
+	PVZService_DummyLogin_FullMethodName                = "/pvz.v1.PVZService/DummyLogin"
+	PVZService_Register_FullMethodName                  = "/pvz.v1.PVZService/Register"
+	PVZService_Login_FullMethodName                     = "/pvz.v1.PVZService/Login"
+	PVZService_RefreshToken_FullMethodName              = "/pvz.v1.PVZService/RefreshToken"
+	PVZService_Logout_FullMethodName                    = "/pvz.v1.PVZService/Logout"
+	PVZService_CreatePVZ_FullMethodName                 = "/pvz.v1.PVZService/CreatePVZ"
+	PVZService_ListPVZ_FullMethodName                   = "/pvz.v1.PVZService/ListPVZ"
+	PVZService_GetPVZ_FullMethodName                    = "/pvz.v1.PVZService/GetPVZ"
+	PVZService_UpdatePVZ_FullMethodName                 = "/pvz.v1.PVZService/UpdatePVZ"
+	PVZService_DeactivatePVZ_FullMethodName             = "/pvz.v1.PVZService/DeactivatePVZ"
+	PVZService_ListNearbyPVZ_FullMethodName             = "/pvz.v1.PVZService/ListNearbyPVZ"
+	PVZService_ListPVZEmployees_FullMethodName          = "/pvz.v1.PVZService/ListPVZEmployees"
+	PVZService_AssignEmployee_FullMethodName            = "/pvz.v1.PVZService/AssignEmployee"
+	PVZService_UnassignEmployee_FullMethodName          = "/pvz.v1.PVZService/UnassignEmployee"
+	PVZService_CreateReception_FullMethodName           = "/pvz.v1.PVZService/CreateReception"
+	PVZService_CloseLastReception_FullMethodName        = "/pvz.v1.PVZService/CloseLastReception"
+	PVZService_ListReceptions_FullMethodName            = "/pvz.v1.PVZService/ListReceptions"
+	PVZService_GetReception_FullMethodName              = "/pvz.v1.PVZService/GetReception"
+	PVZService_SetReceptionManifest_FullMethodName      = "/pvz.v1.PVZService/SetReceptionManifest"
+	PVZService_GetReceptionManifest_FullMethodName      = "/pvz.v1.PVZService/GetReceptionManifest"
+	PVZService_GetReconciliation_FullMethodName         = "/pvz.v1.PVZService/GetReconciliation"
+	PVZService_AcknowledgeReconciliation_FullMethodName = "/pvz.v1.PVZService/AcknowledgeReconciliation"
+	PVZService_ReopenReception_FullMethodName           = "/pvz.v1.PVZService/ReopenReception"
+	PVZService_AmendReception_FullMethodName            = "/pvz.v1.PVZService/AmendReception"
+	PVZService_ListReceptionCorrections_FullMethodName  = "/pvz.v1.PVZService/ListReceptionCorrections"
+	PVZService_AddProduct_FullMethodName                = "/pvz.v1.PVZService/AddProduct"
+	PVZService_AddProducts_FullMethodName               = "/pvz.v1.PVZService/AddProducts"
+	PVZService_DeleteLastProduct_FullMethodName         = "/pvz.v1.PVZService/DeleteLastProduct"
+	PVZService_IssueProduct_FullMethodName              = "/pvz.v1.PVZService/IssueProduct"
+	PVZService_ReturnProduct_FullMethodName             = "/pvz.v1.PVZService/ReturnProduct"
+	PVZService_GetProductHistory_FullMethodName         = "/pvz.v1.PVZService/GetProductHistory"
+	PVZService_FindProductsByBarcode_FullMethodName     = "/pvz.v1.PVZService/FindProductsByBarcode"
+	PVZService_ListCities_FullMethodName                = "/pvz.v1.PVZService/ListCities"
+	PVZService_CreateCity_FullMethodName                = "/pvz.v1.PVZService/CreateCity"
+	PVZService_DeleteCity_FullMethodName                = "/pvz.v1.PVZService/DeleteCity"
+	PVZService_ListProductTypes_FullMethodName          = "/pvz.v1.PVZService/ListProductTypes"
+	PVZService_CreateProductType_FullMethodName         = "/pvz.v1.PVZService/CreateProductType"
+	PVZService_DeleteProductType_FullMethodName         = "/pvz.v1.PVZService/DeleteProductType"
+	PVZService_CreateWebhook_FullMethodName             = "/pvz.v1.PVZService/CreateWebhook"
+	PVZService_ListWebhooks_FullMethodName              = "/pvz.v1.PVZService/ListWebhooks"
+	PVZService_DeleteWebhook_FullMethodName             = "/pvz.v1.PVZService/DeleteWebhook"
+	PVZService_ListAuditRecords_FullMethodName          = "/pvz.v1.PVZService/ListAuditRecords"
+	PVZService_GetReceptionsReport_FullMethodName       = "/pvz.v1.PVZService/GetReceptionsReport"
+	PVZService_GetProductsReport_FullMethodName         = "/pvz.v1.PVZService/GetProductsReport"
 )
 
 // PVZServiceClient is the client API for PVZService service.
@@ -27,6 +71,50 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PVZServiceClient interface {
 	GetPVZList(ctx context.Context, in *GetPVZListRequest, opts ...grpc.CallOption) (*GetPVZListResponse, error)
+	DummyLogin(ctx context.Context, in *DummyLoginRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error)
+	ListPVZ(ctx context.Context, in *ListPVZRequest, opts ...grpc.CallOption) (*ListPVZResponse, error)
+	GetPVZ(ctx context.Context, in *GetPVZRequest, opts ...grpc.CallOption) (*GetPVZResponse, error)
+	UpdatePVZ(ctx context.Context, in *UpdatePVZRequest, opts ...grpc.CallOption) (*UpdatePVZResponse, error)
+	DeactivatePVZ(ctx context.Context, in *DeactivatePVZRequest, opts ...grpc.CallOption) (*DeactivatePVZResponse, error)
+	ListNearbyPVZ(ctx context.Context, in *ListNearbyPVZRequest, opts ...grpc.CallOption) (*ListNearbyPVZResponse, error)
+	ListPVZEmployees(ctx context.Context, in *ListPVZEmployeesRequest, opts ...grpc.CallOption) (*ListPVZEmployeesResponse, error)
+	AssignEmployee(ctx context.Context, in *AssignEmployeeRequest, opts ...grpc.CallOption) (*AssignEmployeeResponse, error)
+	UnassignEmployee(ctx context.Context, in *UnassignEmployeeRequest, opts ...grpc.CallOption) (*UnassignEmployeeResponse, error)
+	CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error)
+	CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error)
+	ListReceptions(ctx context.Context, in *ListReceptionsRequest, opts ...grpc.CallOption) (*ListReceptionsResponse, error)
+	GetReception(ctx context.Context, in *GetReceptionRequest, opts ...grpc.CallOption) (*GetReceptionResponse, error)
+	SetReceptionManifest(ctx context.Context, in *SetReceptionManifestRequest, opts ...grpc.CallOption) (*SetReceptionManifestResponse, error)
+	GetReceptionManifest(ctx context.Context, in *GetReceptionManifestRequest, opts ...grpc.CallOption) (*GetReceptionManifestResponse, error)
+	GetReconciliation(ctx context.Context, in *GetReconciliationRequest, opts ...grpc.CallOption) (*GetReconciliationResponse, error)
+	AcknowledgeReconciliation(ctx context.Context, in *AcknowledgeReconciliationRequest, opts ...grpc.CallOption) (*AcknowledgeReconciliationResponse, error)
+	ReopenReception(ctx context.Context, in *ReopenReceptionRequest, opts ...grpc.CallOption) (*ReopenReceptionResponse, error)
+	AmendReception(ctx context.Context, in *AmendReceptionRequest, opts ...grpc.CallOption) (*AmendReceptionResponse, error)
+	ListReceptionCorrections(ctx context.Context, in *ListReceptionCorrectionsRequest, opts ...grpc.CallOption) (*ListReceptionCorrectionsResponse, error)
+	AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error)
+	AddProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddProductRequest, AddProductsResponse], error)
+	DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error)
+	IssueProduct(ctx context.Context, in *IssueProductRequest, opts ...grpc.CallOption) (*IssueProductResponse, error)
+	ReturnProduct(ctx context.Context, in *ReturnProductRequest, opts ...grpc.CallOption) (*ReturnProductResponse, error)
+	GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*GetProductHistoryResponse, error)
+	FindProductsByBarcode(ctx context.Context, in *FindProductsByBarcodeRequest, opts ...grpc.CallOption) (*FindProductsByBarcodeResponse, error)
+	ListCities(ctx context.Context, in *ListCitiesRequest, opts ...grpc.CallOption) (*ListCitiesResponse, error)
+	CreateCity(ctx context.Context, in *CreateCityRequest, opts ...grpc.CallOption) (*CreateCityResponse, error)
+	DeleteCity(ctx context.Context, in *DeleteCityRequest, opts ...grpc.CallOption) (*DeleteCityResponse, error)
+	ListProductTypes(ctx context.Context, in *ListProductTypesRequest, opts ...grpc.CallOption) (*ListProductTypesResponse, error)
+	CreateProductType(ctx context.Context, in *CreateProductTypeRequest, opts ...grpc.CallOption) (*CreateProductTypeResponse, error)
+	DeleteProductType(ctx context.Context, in *DeleteProductTypeRequest, opts ...grpc.CallOption) (*DeleteProductTypeResponse, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error)
+	GetReceptionsReport(ctx context.Context, in *GetReceptionsReportRequest, opts ...grpc.CallOption) (*GetReceptionsReportResponse, error)
+	GetProductsReport(ctx context.Context, in *GetProductsReportRequest, opts ...grpc.CallOption) (*GetProductsReportResponse, error)
 }
 
 type pVZServiceClient struct {
@@ -47,59 +135,1459 @@ func (c *pVZServiceClient) GetPVZList(ctx context.Context, in *GetPVZListRequest
 	return out, nil
 }
 
+func (c *pVZServiceClient) DummyLogin(ctx context.Context, in *DummyLoginRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, PVZService_DummyLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, PVZService_Register_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, PVZService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*TokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenResponse)
+	err := c.cc.Invoke(ctx, PVZService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, PVZService_Logout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreatePVZ(ctx context.Context, in *CreatePVZRequest, opts ...grpc.CallOption) (*CreatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListPVZ(ctx context.Context, in *ListPVZRequest, opts ...grpc.CallOption) (*ListPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetPVZ(ctx context.Context, in *GetPVZRequest, opts ...grpc.CallOption) (*GetPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) UpdatePVZ(ctx context.Context, in *UpdatePVZRequest, opts ...grpc.CallOption) (*UpdatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_UpdatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeactivatePVZ(ctx context.Context, in *DeactivatePVZRequest, opts ...grpc.CallOption) (*DeactivatePVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivatePVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeactivatePVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListNearbyPVZ(ctx context.Context, in *ListNearbyPVZRequest, opts ...grpc.CallOption) (*ListNearbyPVZResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNearbyPVZResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListNearbyPVZ_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListPVZEmployees(ctx context.Context, in *ListPVZEmployeesRequest, opts ...grpc.CallOption) (*ListPVZEmployeesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPVZEmployeesResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListPVZEmployees_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AssignEmployee(ctx context.Context, in *AssignEmployeeRequest, opts ...grpc.CallOption) (*AssignEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignEmployeeResponse)
+	err := c.cc.Invoke(ctx, PVZService_AssignEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) UnassignEmployee(ctx context.Context, in *UnassignEmployeeRequest, opts ...grpc.CallOption) (*UnassignEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnassignEmployeeResponse)
+	err := c.cc.Invoke(ctx, PVZService_UnassignEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreateReception(ctx context.Context, in *CreateReceptionRequest, opts ...grpc.CallOption) (*CreateReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreateReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CloseLastReception(ctx context.Context, in *CloseLastReceptionRequest, opts ...grpc.CallOption) (*CloseLastReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseLastReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_CloseLastReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListReceptions(ctx context.Context, in *ListReceptionsRequest, opts ...grpc.CallOption) (*ListReceptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReceptionsResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListReceptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetReception(ctx context.Context, in *GetReceptionRequest, opts ...grpc.CallOption) (*GetReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) SetReceptionManifest(ctx context.Context, in *SetReceptionManifestRequest, opts ...grpc.CallOption) (*SetReceptionManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReceptionManifestResponse)
+	err := c.cc.Invoke(ctx, PVZService_SetReceptionManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetReceptionManifest(ctx context.Context, in *GetReceptionManifestRequest, opts ...grpc.CallOption) (*GetReceptionManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceptionManifestResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetReceptionManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetReconciliation(ctx context.Context, in *GetReconciliationRequest, opts ...grpc.CallOption) (*GetReconciliationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReconciliationResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AcknowledgeReconciliation(ctx context.Context, in *AcknowledgeReconciliationRequest, opts ...grpc.CallOption) (*AcknowledgeReconciliationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeReconciliationResponse)
+	err := c.cc.Invoke(ctx, PVZService_AcknowledgeReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ReopenReception(ctx context.Context, in *ReopenReceptionRequest, opts ...grpc.CallOption) (*ReopenReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_ReopenReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AmendReception(ctx context.Context, in *AmendReceptionRequest, opts ...grpc.CallOption) (*AmendReceptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AmendReceptionResponse)
+	err := c.cc.Invoke(ctx, PVZService_AmendReception_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListReceptionCorrections(ctx context.Context, in *ListReceptionCorrectionsRequest, opts ...grpc.CallOption) (*ListReceptionCorrectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReceptionCorrectionsResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListReceptionCorrections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AddProduct(ctx context.Context, in *AddProductRequest, opts ...grpc.CallOption) (*AddProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_AddProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) AddProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AddProductRequest, AddProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &PVZService_ServiceDesc.Streams[0], PVZService_AddProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AddProductRequest, AddProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_AddProductsClient = grpc.ClientStreamingClient[AddProductRequest, AddProductsResponse]
+
+func (c *pVZServiceClient) DeleteLastProduct(ctx context.Context, in *DeleteLastProductRequest, opts ...grpc.CallOption) (*DeleteLastProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteLastProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteLastProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) IssueProduct(ctx context.Context, in *IssueProductRequest, opts ...grpc.CallOption) (*IssueProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_IssueProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ReturnProduct(ctx context.Context, in *ReturnProductRequest, opts ...grpc.CallOption) (*ReturnProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReturnProductResponse)
+	err := c.cc.Invoke(ctx, PVZService_ReturnProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetProductHistory(ctx context.Context, in *GetProductHistoryRequest, opts ...grpc.CallOption) (*GetProductHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductHistoryResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetProductHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) FindProductsByBarcode(ctx context.Context, in *FindProductsByBarcodeRequest, opts ...grpc.CallOption) (*FindProductsByBarcodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindProductsByBarcodeResponse)
+	err := c.cc.Invoke(ctx, PVZService_FindProductsByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListCities(ctx context.Context, in *ListCitiesRequest, opts ...grpc.CallOption) (*ListCitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCitiesResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreateCity(ctx context.Context, in *CreateCityRequest, opts ...grpc.CallOption) (*CreateCityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCityResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreateCity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeleteCity(ctx context.Context, in *DeleteCityRequest, opts ...grpc.CallOption) (*DeleteCityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCityResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteCity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListProductTypes(ctx context.Context, in *ListProductTypesRequest, opts ...grpc.CallOption) (*ListProductTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductTypesResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListProductTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreateProductType(ctx context.Context, in *CreateProductTypeRequest, opts ...grpc.CallOption) (*CreateProductTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductTypeResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreateProductType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeleteProductType(ctx context.Context, in *DeleteProductTypeRequest, opts ...grpc.CallOption) (*DeleteProductTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductTypeResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteProductType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, PVZService_CreateWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, PVZService_DeleteWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) ListAuditRecords(ctx context.Context, in *ListAuditRecordsRequest, opts ...grpc.CallOption) (*ListAuditRecordsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAuditRecordsResponse)
+	err := c.cc.Invoke(ctx, PVZService_ListAuditRecords_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetReceptionsReport(ctx context.Context, in *GetReceptionsReportRequest, opts ...grpc.CallOption) (*GetReceptionsReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceptionsReportResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetReceptionsReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pVZServiceClient) GetProductsReport(ctx context.Context, in *GetProductsReportRequest, opts ...grpc.CallOption) (*GetProductsReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductsReportResponse)
+	err := c.cc.Invoke(ctx, PVZService_GetProductsReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PVZServiceServer is the server API for PVZService service.
 // All implementations must embed UnimplementedPVZServiceServer
 // for forward compatibility.
 type PVZServiceServer interface {
 	GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error)
+	DummyLogin(context.Context, *DummyLoginRequest) (*TokenResponse, error)
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	Login(context.Context, *LoginRequest) (*TokenResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*TokenResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error)
+	ListPVZ(context.Context, *ListPVZRequest) (*ListPVZResponse, error)
+	GetPVZ(context.Context, *GetPVZRequest) (*GetPVZResponse, error)
+	UpdatePVZ(context.Context, *UpdatePVZRequest) (*UpdatePVZResponse, error)
+	DeactivatePVZ(context.Context, *DeactivatePVZRequest) (*DeactivatePVZResponse, error)
+	ListNearbyPVZ(context.Context, *ListNearbyPVZRequest) (*ListNearbyPVZResponse, error)
+	ListPVZEmployees(context.Context, *ListPVZEmployeesRequest) (*ListPVZEmployeesResponse, error)
+	AssignEmployee(context.Context, *AssignEmployeeRequest) (*AssignEmployeeResponse, error)
+	UnassignEmployee(context.Context, *UnassignEmployeeRequest) (*UnassignEmployeeResponse, error)
+	CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error)
+	CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error)
+	ListReceptions(context.Context, *ListReceptionsRequest) (*ListReceptionsResponse, error)
+	GetReception(context.Context, *GetReceptionRequest) (*GetReceptionResponse, error)
+	SetReceptionManifest(context.Context, *SetReceptionManifestRequest) (*SetReceptionManifestResponse, error)
+	GetReceptionManifest(context.Context, *GetReceptionManifestRequest) (*GetReceptionManifestResponse, error)
+	GetReconciliation(context.Context, *GetReconciliationRequest) (*GetReconciliationResponse, error)
+	AcknowledgeReconciliation(context.Context, *AcknowledgeReconciliationRequest) (*AcknowledgeReconciliationResponse, error)
+	ReopenReception(context.Context, *ReopenReceptionRequest) (*ReopenReceptionResponse, error)
+	AmendReception(context.Context, *AmendReceptionRequest) (*AmendReceptionResponse, error)
+	ListReceptionCorrections(context.Context, *ListReceptionCorrectionsRequest) (*ListReceptionCorrectionsResponse, error)
+	AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error)
+	AddProducts(grpc.ClientStreamingServer[AddProductRequest, AddProductsResponse]) error
+	DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error)
+	IssueProduct(context.Context, *IssueProductRequest) (*IssueProductResponse, error)
+	ReturnProduct(context.Context, *ReturnProductRequest) (*ReturnProductResponse, error)
+	GetProductHistory(context.Context, *GetProductHistoryRequest) (*GetProductHistoryResponse, error)
+	FindProductsByBarcode(context.Context, *FindProductsByBarcodeRequest) (*FindProductsByBarcodeResponse, error)
+	ListCities(context.Context, *ListCitiesRequest) (*ListCitiesResponse, error)
+	CreateCity(context.Context, *CreateCityRequest) (*CreateCityResponse, error)
+	DeleteCity(context.Context, *DeleteCityRequest) (*DeleteCityResponse, error)
+	ListProductTypes(context.Context, *ListProductTypesRequest) (*ListProductTypesResponse, error)
+	CreateProductType(context.Context, *CreateProductTypeRequest) (*CreateProductTypeResponse, error)
+	DeleteProductType(context.Context, *DeleteProductTypeRequest) (*DeleteProductTypeResponse, error)
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error)
+	GetReceptionsReport(context.Context, *GetReceptionsReportRequest) (*GetReceptionsReportResponse, error)
+	GetProductsReport(context.Context, *GetProductsReportRequest) (*GetProductsReportResponse, error)
+	mustEmbedUnimplementedPVZServiceServer()
+}
+
+// UnimplementedPVZServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPVZServiceServer struct{}
+
+func (UnimplementedPVZServiceServer) GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZList not implemented")
+}
+func (UnimplementedPVZServiceServer) DummyLogin(context.Context, *DummyLoginRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DummyLogin not implemented")
+}
+func (UnimplementedPVZServiceServer) Register(context.Context, *RegisterRequest) (*RegisterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Register not implemented")
+}
+func (UnimplementedPVZServiceServer) Login(context.Context, *LoginRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedPVZServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*TokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedPVZServiceServer) Logout(context.Context, *LogoutRequest) (*LogoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
+}
+func (UnimplementedPVZServiceServer) CreatePVZ(context.Context, *CreatePVZRequest) (*CreatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) ListPVZ(context.Context, *ListPVZRequest) (*ListPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) GetPVZ(context.Context, *GetPVZRequest) (*GetPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) UpdatePVZ(context.Context, *UpdatePVZRequest) (*UpdatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) DeactivatePVZ(context.Context, *DeactivatePVZRequest) (*DeactivatePVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivatePVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) ListNearbyPVZ(context.Context, *ListNearbyPVZRequest) (*ListNearbyPVZResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNearbyPVZ not implemented")
+}
+func (UnimplementedPVZServiceServer) ListPVZEmployees(context.Context, *ListPVZEmployeesRequest) (*ListPVZEmployeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPVZEmployees not implemented")
+}
+func (UnimplementedPVZServiceServer) AssignEmployee(context.Context, *AssignEmployeeRequest) (*AssignEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignEmployee not implemented")
+}
+func (UnimplementedPVZServiceServer) UnassignEmployee(context.Context, *UnassignEmployeeRequest) (*UnassignEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignEmployee not implemented")
+}
+func (UnimplementedPVZServiceServer) CreateReception(context.Context, *CreateReceptionRequest) (*CreateReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReception not implemented")
+}
+func (UnimplementedPVZServiceServer) CloseLastReception(context.Context, *CloseLastReceptionRequest) (*CloseLastReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLastReception not implemented")
+}
+func (UnimplementedPVZServiceServer) ListReceptions(context.Context, *ListReceptionsRequest) (*ListReceptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReceptions not implemented")
+}
+func (UnimplementedPVZServiceServer) GetReception(context.Context, *GetReceptionRequest) (*GetReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReception not implemented")
+}
+func (UnimplementedPVZServiceServer) SetReceptionManifest(context.Context, *SetReceptionManifestRequest) (*SetReceptionManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReceptionManifest not implemented")
+}
+func (UnimplementedPVZServiceServer) GetReceptionManifest(context.Context, *GetReceptionManifestRequest) (*GetReceptionManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceptionManifest not implemented")
+}
+func (UnimplementedPVZServiceServer) GetReconciliation(context.Context, *GetReconciliationRequest) (*GetReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReconciliation not implemented")
+}
+func (UnimplementedPVZServiceServer) AcknowledgeReconciliation(context.Context, *AcknowledgeReconciliationRequest) (*AcknowledgeReconciliationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeReconciliation not implemented")
+}
+func (UnimplementedPVZServiceServer) ReopenReception(context.Context, *ReopenReceptionRequest) (*ReopenReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenReception not implemented")
+}
+func (UnimplementedPVZServiceServer) AmendReception(context.Context, *AmendReceptionRequest) (*AmendReceptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendReception not implemented")
+}
+func (UnimplementedPVZServiceServer) ListReceptionCorrections(context.Context, *ListReceptionCorrectionsRequest) (*ListReceptionCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReceptionCorrections not implemented")
+}
+func (UnimplementedPVZServiceServer) AddProduct(context.Context, *AddProductRequest) (*AddProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) AddProducts(grpc.ClientStreamingServer[AddProductRequest, AddProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method AddProducts not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteLastProduct(context.Context, *DeleteLastProductRequest) (*DeleteLastProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLastProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) IssueProduct(context.Context, *IssueProductRequest) (*IssueProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) ReturnProduct(context.Context, *ReturnProductRequest) (*ReturnProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnProduct not implemented")
+}
+func (UnimplementedPVZServiceServer) GetProductHistory(context.Context, *GetProductHistoryRequest) (*GetProductHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductHistory not implemented")
+}
+func (UnimplementedPVZServiceServer) FindProductsByBarcode(context.Context, *FindProductsByBarcodeRequest) (*FindProductsByBarcodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindProductsByBarcode not implemented")
+}
+func (UnimplementedPVZServiceServer) ListCities(context.Context, *ListCitiesRequest) (*ListCitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCities not implemented")
+}
+func (UnimplementedPVZServiceServer) CreateCity(context.Context, *CreateCityRequest) (*CreateCityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCity not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteCity(context.Context, *DeleteCityRequest) (*DeleteCityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCity not implemented")
+}
+func (UnimplementedPVZServiceServer) ListProductTypes(context.Context, *ListProductTypesRequest) (*ListProductTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductTypes not implemented")
+}
+func (UnimplementedPVZServiceServer) CreateProductType(context.Context, *CreateProductTypeRequest) (*CreateProductTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductType not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteProductType(context.Context, *DeleteProductTypeRequest) (*DeleteProductTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductType not implemented")
+}
+func (UnimplementedPVZServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedPVZServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedPVZServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedPVZServiceServer) ListAuditRecords(context.Context, *ListAuditRecordsRequest) (*ListAuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditRecords not implemented")
+}
+func (UnimplementedPVZServiceServer) GetReceptionsReport(context.Context, *GetReceptionsReportRequest) (*GetReceptionsReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceptionsReport not implemented")
+}
+func (UnimplementedPVZServiceServer) GetProductsReport(context.Context, *GetProductsReportRequest) (*GetProductsReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductsReport not implemented")
+}
+func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
+func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafePVZServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PVZServiceServer will
+// result in compilation errors.
+type UnsafePVZServiceServer interface {
 	mustEmbedUnimplementedPVZServiceServer()
 }
 
-// UnimplementedPVZServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedPVZServiceServer struct{}
+func RegisterPVZServiceServer(s grpc.ServiceRegistrar, srv PVZServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPVZServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PVZService_ServiceDesc, srv)
+}
+
+func _PVZService_GetPVZList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetPVZList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetPVZList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetPVZList(ctx, req.(*GetPVZListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DummyLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DummyLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DummyLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DummyLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DummyLogin(ctx, req.(*DummyLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_Logout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreatePVZ(ctx, req.(*CreatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListPVZ(ctx, req.(*ListPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetPVZ(ctx, req.(*GetPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_UpdatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).UpdatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_UpdatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).UpdatePVZ(ctx, req.(*UpdatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeactivatePVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivatePVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeactivatePVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeactivatePVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeactivatePVZ(ctx, req.(*DeactivatePVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListNearbyPVZ_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNearbyPVZRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListNearbyPVZ(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListNearbyPVZ_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListNearbyPVZ(ctx, req.(*ListNearbyPVZRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListPVZEmployees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPVZEmployeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListPVZEmployees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListPVZEmployees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListPVZEmployees(ctx, req.(*ListPVZEmployeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AssignEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AssignEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AssignEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AssignEmployee(ctx, req.(*AssignEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_UnassignEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).UnassignEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_UnassignEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).UnassignEmployee(ctx, req.(*UnassignEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreateReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreateReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreateReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreateReception(ctx, req.(*CreateReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CloseLastReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseLastReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CloseLastReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CloseLastReception(ctx, req.(*CloseLastReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListReceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReceptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListReceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListReceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListReceptions(ctx, req.(*ListReceptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetReception(ctx, req.(*GetReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_SetReceptionManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReceptionManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).SetReceptionManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_SetReceptionManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).SetReceptionManifest(ctx, req.(*SetReceptionManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetReceptionManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceptionManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetReceptionManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetReceptionManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetReceptionManifest(ctx, req.(*GetReceptionManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetReconciliation(ctx, req.(*GetReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AcknowledgeReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AcknowledgeReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AcknowledgeReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AcknowledgeReconciliation(ctx, req.(*AcknowledgeReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ReopenReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ReopenReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ReopenReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ReopenReception(ctx, req.(*ReopenReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AmendReception_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AmendReceptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AmendReception(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AmendReception_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AmendReception(ctx, req.(*AmendReceptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListReceptionCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReceptionCorrectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListReceptionCorrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListReceptionCorrections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListReceptionCorrections(ctx, req.(*ListReceptionCorrectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AddProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).AddProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_AddProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).AddProduct(ctx, req.(*AddProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_AddProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PVZServiceServer).AddProducts(&grpc.GenericServerStream[AddProductRequest, AddProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type PVZService_AddProductsServer = grpc.ClientStreamingServer[AddProductRequest, AddProductsResponse]
+
+func _PVZService_DeleteLastProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLastProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteLastProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteLastProduct(ctx, req.(*DeleteLastProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_IssueProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).IssueProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_IssueProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).IssueProduct(ctx, req.(*IssueProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ReturnProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ReturnProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ReturnProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ReturnProduct(ctx, req.(*ReturnProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetProductHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetProductHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetProductHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetProductHistory(ctx, req.(*GetProductHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_FindProductsByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindProductsByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).FindProductsByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_FindProductsByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).FindProductsByBarcode(ctx, req.(*FindProductsByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListCities(ctx, req.(*ListCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
 
-func (UnimplementedPVZServiceServer) GetPVZList(context.Context, *GetPVZListRequest) (*GetPVZListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPVZList not implemented")
+func _PVZService_CreateCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreateCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreateCity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreateCity(ctx, req.(*CreateCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedPVZServiceServer) mustEmbedUnimplementedPVZServiceServer() {}
-func (UnimplementedPVZServiceServer) testEmbeddedByValue()                    {}
 
-// UnsafePVZServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to PVZServiceServer will
-// result in compilation errors.
-type UnsafePVZServiceServer interface {
-	mustEmbedUnimplementedPVZServiceServer()
+func _PVZService_DeleteCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteCity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteCity(ctx, req.(*DeleteCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterPVZServiceServer(s grpc.ServiceRegistrar, srv PVZServiceServer) {
-	// If the following call pancis, it indicates UnimplementedPVZServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _PVZService_ListProductTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&PVZService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListProductTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListProductTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListProductTypes(ctx, req.(*ListProductTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _PVZService_GetPVZList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPVZListRequest)
+func _PVZService_CreateProductType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(PVZServiceServer).GetPVZList(ctx, in)
+		return srv.(PVZServiceServer).CreateProductType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: PVZService_GetPVZList_FullMethodName,
+		FullMethod: PVZService_CreateProductType_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PVZServiceServer).GetPVZList(ctx, req.(*GetPVZListRequest))
+		return srv.(PVZServiceServer).CreateProductType(ctx, req.(*CreateProductTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeleteProductType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteProductType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteProductType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteProductType(ctx, req.(*DeleteProductTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_ListAuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).ListAuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_ListAuditRecords_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).ListAuditRecords(ctx, req.(*ListAuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetReceptionsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceptionsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetReceptionsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetReceptionsReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetReceptionsReport(ctx, req.(*GetReceptionsReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PVZService_GetProductsReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PVZServiceServer).GetProductsReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PVZService_GetProductsReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PVZServiceServer).GetProductsReport(ctx, req.(*GetProductsReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -115,7 +1603,185 @@ var PVZService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPVZList",
 			Handler:    _PVZService_GetPVZList_Handler,
 		},
+		{
+			MethodName: "DummyLogin",
+			Handler:    _PVZService_DummyLogin_Handler,
+		},
+		{
+			MethodName: "Register",
+			Handler:    _PVZService_Register_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _PVZService_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _PVZService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "Logout",
+			Handler:    _PVZService_Logout_Handler,
+		},
+		{
+			MethodName: "CreatePVZ",
+			Handler:    _PVZService_CreatePVZ_Handler,
+		},
+		{
+			MethodName: "ListPVZ",
+			Handler:    _PVZService_ListPVZ_Handler,
+		},
+		{
+			MethodName: "GetPVZ",
+			Handler:    _PVZService_GetPVZ_Handler,
+		},
+		{
+			MethodName: "UpdatePVZ",
+			Handler:    _PVZService_UpdatePVZ_Handler,
+		},
+		{
+			MethodName: "DeactivatePVZ",
+			Handler:    _PVZService_DeactivatePVZ_Handler,
+		},
+		{
+			MethodName: "ListNearbyPVZ",
+			Handler:    _PVZService_ListNearbyPVZ_Handler,
+		},
+		{
+			MethodName: "ListPVZEmployees",
+			Handler:    _PVZService_ListPVZEmployees_Handler,
+		},
+		{
+			MethodName: "AssignEmployee",
+			Handler:    _PVZService_AssignEmployee_Handler,
+		},
+		{
+			MethodName: "UnassignEmployee",
+			Handler:    _PVZService_UnassignEmployee_Handler,
+		},
+		{
+			MethodName: "CreateReception",
+			Handler:    _PVZService_CreateReception_Handler,
+		},
+		{
+			MethodName: "CloseLastReception",
+			Handler:    _PVZService_CloseLastReception_Handler,
+		},
+		{
+			MethodName: "ListReceptions",
+			Handler:    _PVZService_ListReceptions_Handler,
+		},
+		{
+			MethodName: "GetReception",
+			Handler:    _PVZService_GetReception_Handler,
+		},
+		{
+			MethodName: "SetReceptionManifest",
+			Handler:    _PVZService_SetReceptionManifest_Handler,
+		},
+		{
+			MethodName: "GetReceptionManifest",
+			Handler:    _PVZService_GetReceptionManifest_Handler,
+		},
+		{
+			MethodName: "GetReconciliation",
+			Handler:    _PVZService_GetReconciliation_Handler,
+		},
+		{
+			MethodName: "AcknowledgeReconciliation",
+			Handler:    _PVZService_AcknowledgeReconciliation_Handler,
+		},
+		{
+			MethodName: "ReopenReception",
+			Handler:    _PVZService_ReopenReception_Handler,
+		},
+		{
+			MethodName: "AmendReception",
+			Handler:    _PVZService_AmendReception_Handler,
+		},
+		{
+			MethodName: "ListReceptionCorrections",
+			Handler:    _PVZService_ListReceptionCorrections_Handler,
+		},
+		{
+			MethodName: "AddProduct",
+			Handler:    _PVZService_AddProduct_Handler,
+		},
+		{
+			MethodName: "DeleteLastProduct",
+			Handler:    _PVZService_DeleteLastProduct_Handler,
+		},
+		{
+			MethodName: "IssueProduct",
+			Handler:    _PVZService_IssueProduct_Handler,
+		},
+		{
+			MethodName: "ReturnProduct",
+			Handler:    _PVZService_ReturnProduct_Handler,
+		},
+		{
+			MethodName: "GetProductHistory",
+			Handler:    _PVZService_GetProductHistory_Handler,
+		},
+		{
+			MethodName: "FindProductsByBarcode",
+			Handler:    _PVZService_FindProductsByBarcode_Handler,
+		},
+		{
+			MethodName: "ListCities",
+			Handler:    _PVZService_ListCities_Handler,
+		},
+		{
+			MethodName: "CreateCity",
+			Handler:    _PVZService_CreateCity_Handler,
+		},
+		{
+			MethodName: "DeleteCity",
+			Handler:    _PVZService_DeleteCity_Handler,
+		},
+		{
+			MethodName: "ListProductTypes",
+			Handler:    _PVZService_ListProductTypes_Handler,
+		},
+		{
+			MethodName: "CreateProductType",
+			Handler:    _PVZService_CreateProductType_Handler,
+		},
+		{
+			MethodName: "DeleteProductType",
+			Handler:    _PVZService_DeleteProductType_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _PVZService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _PVZService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _PVZService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ListAuditRecords",
+			Handler:    _PVZService_ListAuditRecords_Handler,
+		},
+		{
+			MethodName: "GetReceptionsReport",
+			Handler:    _PVZService_GetReceptionsReport_Handler,
+		},
+		{
+			MethodName: "GetProductsReport",
+			Handler:    _PVZService_GetProductsReport_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "AddProducts",
+			Handler:       _PVZService_AddProducts_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/proto/pvz.proto",
 }
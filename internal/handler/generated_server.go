@@ -15,57 +15,261 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Получение журнала аудита изменений (только для модераторов)
+	// (GET /audit)
+	GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams)
+	// Получение справочника городов
+	// (GET /cities)
+	GetCities(w http.ResponseWriter, r *http.Request)
+	// Добавление города в справочник (только для модераторов)
+	// (POST /cities)
+	PostCities(w http.ResponseWriter, r *http.Request)
+	// Удаление города из справочника (только для модераторов)
+	// (DELETE /cities/{name})
+	DeleteCitiesName(w http.ResponseWriter, r *http.Request, name string)
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(w http.ResponseWriter, r *http.Request)
+	// Выгрузка приемок с товарами в CSV (UTF-8 с BOM, разделитель точка с запятой)
+	// (GET /export/receptions.csv)
+	GetExportReceptionsCsv(w http.ResponseWriter, r *http.Request, params GetExportReceptionsCsvParams)
+	// Выгрузка приемок с товарами в XLSX
+	// (GET /export/receptions.xlsx)
+	GetExportReceptionsXlsx(w http.ResponseWriter, r *http.Request, params GetExportReceptionsXlsxParams)
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(w http.ResponseWriter, r *http.Request)
+	// Выход из системы с отзывом текущего токена
+	// (POST /logout)
+	PostLogout(w http.ResponseWriter, r *http.Request)
+	// Получение справочника типов товаров
+	// (GET /product_types)
+	GetProductTypes(w http.ResponseWriter, r *http.Request)
+	// Добавление типа товара в справочник (только для модераторов)
+	// (POST /product_types)
+	PostProductTypes(w http.ResponseWriter, r *http.Request)
+	// Удаление типа товара из справочника (только для модераторов)
+	// (DELETE /product_types/{name})
+	DeleteProductTypesName(w http.ResponseWriter, r *http.Request, name string)
+	// Поиск товаров по штрихкоду во всех приемках
+	// (GET /products)
+	GetProducts(w http.ResponseWriter, r *http.Request, params GetProductsParams)
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(w http.ResponseWriter, r *http.Request)
+	// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(w http.ResponseWriter, r *http.Request)
+	// История смены статусов товара
+	// (GET /products/{productId}/history)
+	GetProductsProductIdHistory(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Выдача товара клиенту (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
+	// Возврат товара отправителю, в том числе после возврата клиентом (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/return)
+	PostProductsProductIdReturn(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID)
 	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
 	// (GET /pvz)
 	GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams)
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(w http.ResponseWriter, r *http.Request)
+	// Поиск ближайших активных ПВЗ, отсортированных по расстоянию
+	// (GET /pvz/nearby)
+	GetPvzNearby(w http.ResponseWriter, r *http.Request, params GetPvzNearbyParams)
+	// Получение ПВЗ с приемками и товарами
+	// (GET /pvz/{pvzId})
+	GetPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Изменение данных ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
 	PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Деактивация ПВЗ с сохранением истории приемок (только для модераторов)
+	// (POST /pvz/{pvzId}/deactivate)
+	PostPvzPvzIdDeactivate(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Получение сотрудников, закрепленных за ПВЗ (только для модераторов)
+	// (GET /pvz/{pvzId}/employees)
+	GetPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Закрепление сотрудника за ПВЗ (только для модераторов)
+	// (POST /pvz/{pvzId}/employees)
+	PostPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID)
+	// Открепление сотрудника от ПВЗ (только для модераторов)
+	// (DELETE /pvz/{pvzId}/employees/{userId})
+	DeletePvzPvzIdEmployeesUserId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, userId openapi_types.UUID)
+	// История приемок ПВЗ
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams)
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(w http.ResponseWriter, r *http.Request)
+	// Получение приемки с товарами
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// История открытий и исправлений приемки
+	// (GET /receptions/{receptionId}/corrections)
+	GetReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Исправление состава закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/corrections)
+	PostReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Манифест приемки
+	// (GET /receptions/{receptionId}/manifest)
+	GetReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Загрузка манифеста открытой приемки (только для сотрудников ПВЗ)
+	// (PUT /receptions/{receptionId}/manifest)
+	PutReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Сверка приемки с манифестом
+	// (GET /receptions/{receptionId}/reconciliation)
+	GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Подтверждение текущих расхождений открытой приемки с манифестом (только для сотрудников ПВЗ)
+	// (POST /receptions/{receptionId}/reconciliation/acknowledge)
+	PostReceptionsReceptionIdReconciliationAcknowledge(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
+	// Открытие закрытой приемки заново (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID)
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(w http.ResponseWriter, r *http.Request)
+	// Количество принятых товаров по периодам (только для модераторов)
+	// (GET /reports/products)
+	GetReportsProducts(w http.ResponseWriter, r *http.Request, params GetReportsProductsParams)
+	// Количество приемок и принятых товаров по периодам (только для модераторов)
+	// (GET /reports/receptions)
+	GetReportsReceptions(w http.ResponseWriter, r *http.Request, params GetReportsReceptionsParams)
+	// Выдача пары access и refresh токенов по email и паролю
+	// (POST /token)
+	PostToken(w http.ResponseWriter, r *http.Request)
+	// Обновление пары токенов по refresh токену
+	// (POST /token/refresh)
+	PostTokenRefresh(w http.ResponseWriter, r *http.Request)
+	// Получение списка подписок на вебхуки (только для модераторов)
+	// (GET /webhooks)
+	GetWebhooks(w http.ResponseWriter, r *http.Request)
+	// Создание подписки на вебхуки о доменных событиях (только для модераторов)
+	// (POST /webhooks)
+	PostWebhooks(w http.ResponseWriter, r *http.Request)
+	// Удаление подписки на вебхуки (только для модераторов)
+	// (DELETE /webhooks/{webhookId})
+	DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
 
 type Unimplemented struct{}
 
+// Получение журнала аудита изменений (только для модераторов)
+// (GET /audit)
+func (_ Unimplemented) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение справочника городов
+// (GET /cities)
+func (_ Unimplemented) GetCities(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавление города в справочник (только для модераторов)
+// (POST /cities)
+func (_ Unimplemented) PostCities(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление города из справочника (только для модераторов)
+// (DELETE /cities/{name})
+func (_ Unimplemented) DeleteCitiesName(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получение тестового токена
 // (POST /dummyLogin)
 func (_ Unimplemented) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Выгрузка приемок с товарами в CSV (UTF-8 с BOM, разделитель точка с запятой)
+// (GET /export/receptions.csv)
+func (_ Unimplemented) GetExportReceptionsCsv(w http.ResponseWriter, r *http.Request, params GetExportReceptionsCsvParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выгрузка приемок с товарами в XLSX
+// (GET /export/receptions.xlsx)
+func (_ Unimplemented) GetExportReceptionsXlsx(w http.ResponseWriter, r *http.Request, params GetExportReceptionsXlsxParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Авторизация пользователя
 // (POST /login)
 func (_ Unimplemented) PostLogin(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Выход из системы с отзывом текущего токена
+// (POST /logout)
+func (_ Unimplemented) PostLogout(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение справочника типов товаров
+// (GET /product_types)
+func (_ Unimplemented) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Добавление типа товара в справочник (только для модераторов)
+// (POST /product_types)
+func (_ Unimplemented) PostProductTypes(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление типа товара из справочника (только для модераторов)
+// (DELETE /product_types/{name})
+func (_ Unimplemented) DeleteProductTypesName(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Поиск товаров по штрихкоду во всех приемках
+// (GET /products)
+func (_ Unimplemented) GetProducts(w http.ResponseWriter, r *http.Request, params GetProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 // (POST /products)
 func (_ Unimplemented) PostProducts(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+// (POST /products/batch)
+func (_ Unimplemented) PostProductsBatch(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История смены статусов товара
+// (GET /products/{productId}/history)
+func (_ Unimplemented) GetProductsProductIdHistory(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выдача товара клиенту (только для сотрудников ПВЗ)
+// (POST /products/{productId}/issue)
+func (_ Unimplemented) PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Возврат товара отправителю, в том числе после возврата клиентом (только для сотрудников ПВЗ)
+// (POST /products/{productId}/return)
+func (_ Unimplemented) PostProductsProductIdReturn(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
 // (GET /pvz)
 func (_ Unimplemented) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
@@ -78,30 +282,168 @@ func (_ Unimplemented) PostPvz(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Поиск ближайших активных ПВЗ, отсортированных по расстоянию
+// (GET /pvz/nearby)
+func (_ Unimplemented) GetPvzNearby(w http.ResponseWriter, r *http.Request, params GetPvzNearbyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение ПВЗ с приемками и товарами
+// (GET /pvz/{pvzId})
+func (_ Unimplemented) GetPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Изменение данных ПВЗ (только для модераторов)
+// (PATCH /pvz/{pvzId})
+func (_ Unimplemented) PatchPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Закрытие последней открытой приемки товаров в рамках ПВЗ
 // (POST /pvz/{pvzId}/close_last_reception)
 func (_ Unimplemented) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Деактивация ПВЗ с сохранением истории приемок (только для модераторов)
+// (POST /pvz/{pvzId}/deactivate)
+func (_ Unimplemented) PostPvzPvzIdDeactivate(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 // (POST /pvz/{pvzId}/delete_last_product)
 func (_ Unimplemented) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получение сотрудников, закрепленных за ПВЗ (только для модераторов)
+// (GET /pvz/{pvzId}/employees)
+func (_ Unimplemented) GetPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Закрепление сотрудника за ПВЗ (только для модераторов)
+// (POST /pvz/{pvzId}/employees)
+func (_ Unimplemented) PostPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Открепление сотрудника от ПВЗ (только для модераторов)
+// (DELETE /pvz/{pvzId}/employees/{userId})
+func (_ Unimplemented) DeletePvzPvzIdEmployeesUserId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, userId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История приемок ПВЗ
+// (GET /pvz/{pvzId}/receptions)
+func (_ Unimplemented) GetPvzPvzIdReceptions(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Создание новой приемки товаров (только для сотрудников ПВЗ)
 // (POST /receptions)
 func (_ Unimplemented) PostReceptions(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Получение приемки с товарами
+// (GET /receptions/{receptionId})
+func (_ Unimplemented) GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// История открытий и исправлений приемки
+// (GET /receptions/{receptionId}/corrections)
+func (_ Unimplemented) GetReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Исправление состава закрытой приемки (только для модераторов)
+// (POST /receptions/{receptionId}/corrections)
+func (_ Unimplemented) PostReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Манифест приемки
+// (GET /receptions/{receptionId}/manifest)
+func (_ Unimplemented) GetReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Загрузка манифеста открытой приемки (только для сотрудников ПВЗ)
+// (PUT /receptions/{receptionId}/manifest)
+func (_ Unimplemented) PutReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Сверка приемки с манифестом
+// (GET /receptions/{receptionId}/reconciliation)
+func (_ Unimplemented) GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Подтверждение текущих расхождений открытой приемки с манифестом (только для сотрудников ПВЗ)
+// (POST /receptions/{receptionId}/reconciliation/acknowledge)
+func (_ Unimplemented) PostReceptionsReceptionIdReconciliationAcknowledge(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Открытие закрытой приемки заново (только для модераторов)
+// (POST /receptions/{receptionId}/reopen)
+func (_ Unimplemented) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Регистрация пользователя
 // (POST /register)
 func (_ Unimplemented) PostRegister(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Количество принятых товаров по периодам (только для модераторов)
+// (GET /reports/products)
+func (_ Unimplemented) GetReportsProducts(w http.ResponseWriter, r *http.Request, params GetReportsProductsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Количество приемок и принятых товаров по периодам (только для модераторов)
+// (GET /reports/receptions)
+func (_ Unimplemented) GetReportsReceptions(w http.ResponseWriter, r *http.Request, params GetReportsReceptionsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Выдача пары access и refresh токенов по email и паролю
+// (POST /token)
+func (_ Unimplemented) PostToken(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Обновление пары токенов по refresh токену
+// (POST /token/refresh)
+func (_ Unimplemented) PostTokenRefresh(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Получение списка подписок на вебхуки (только для модераторов)
+// (GET /webhooks)
+func (_ Unimplemented) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Создание подписки на вебхуки о доменных событиях (только для модераторов)
+// (POST /webhooks)
+func (_ Unimplemented) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Удаление подписки на вебхуки (только для модераторов)
+// (DELETE /webhooks/{webhookId})
+func (_ Unimplemented) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // ServerInterfaceWrapper converts contexts to parameters.
 type ServerInterfaceWrapper struct {
 	Handler            ServerInterface
@@ -111,11 +453,1247 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+
+	// ------------- Optional query parameter "entityType" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityType", r.URL.Query(), &params.EntityType)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityType", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "entityId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "entityId", r.URL.Query(), &params.EntityId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entityId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "actorId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actorId", r.URL.Query(), &params.ActorId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "actorId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAudit(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCities operation middleware
+func (siw *ServerInterfaceWrapper) GetCities(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostCities operation middleware
+func (siw *ServerInterfaceWrapper) PostCities(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostCities(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCitiesName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCitiesName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCitiesName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostDummyLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExportReceptionsCsv operation middleware
+func (siw *ServerInterfaceWrapper) GetExportReceptionsCsv(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportReceptionsCsvParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportReceptionsCsv(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetExportReceptionsXlsx operation middleware
+func (siw *ServerInterfaceWrapper) GetExportReceptionsXlsx(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetExportReceptionsXlsxParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExportReceptionsXlsx(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostLogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductTypes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductTypes(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProductTypesName operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductTypesName(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProductTypesName(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetProducts(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsParams
+
+	// ------------- Required query parameter "barcode" -------------
+
+	if paramValue := r.URL.Query().Get("barcode"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "barcode"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "barcode", r.URL.Query(), &params.Barcode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "barcode", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProducts(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProducts(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProductsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsBatch(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsBatch(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProductsProductIdHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsProductIdHistory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProductsProductIdHistory(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProductsProductIdIssue operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsProductIdIssue(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProductsProductIdReturn operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdReturn(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", chi.URLParam(r, "productId"), &productId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "productId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProductsProductIdReturn(w, r, productId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", r.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "city", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "receptionStatus" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "receptionStatus", r.URL.Query(), &params.ReceptionStatus)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionStatus", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "includeEmpty" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeEmpty", r.URL.Query(), &params.IncludeEmpty)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeEmpty", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvz(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvz(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzNearby operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzNearby(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzNearbyParams
+
+	// ------------- Required query parameter "lat" -------------
+
+	if paramValue := r.URL.Query().Get("lat"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lat"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lat", r.URL.Query(), &params.Lat)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lat", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "lon" -------------
+
+	if paramValue := r.URL.Query().Get("lon"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "lon"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "lon", r.URL.Query(), &params.Lon)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "lon", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "radius" -------------
+
+	if paramValue := r.URL.Query().Get("radius"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "radius"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "radius", r.URL.Query(), &params.Radius)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "radius", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzNearby(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzId(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) PatchPvzPvzId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchPvzPvzId(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdCloseLastReception(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdDeactivate operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeactivate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdDeactivate(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzIdEmployees operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzIdEmployees(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostPvzPvzIdEmployees operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostPvzPvzIdEmployees(w, r, pvzId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePvzPvzIdEmployeesUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeletePvzPvzIdEmployeesUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", chi.URLParam(r, "userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePvzPvzIdEmployeesUserId(w, r, pvzId, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPvzPvzIdReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdReceptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdReceptionsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPvzPvzIdReceptions(w, r, pvzId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionId operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionId(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdCorrections operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionIdCorrections(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostReceptionsReceptionIdCorrections operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostReceptionsReceptionIdCorrections(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdManifest operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReceptionsReceptionIdManifest(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutReceptionsReceptionIdManifest operation middleware
+func (siw *ServerInterfaceWrapper) PutReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutReceptionsReceptionIdManifest(w, r, receptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReceptionsReceptionIdReconciliation operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostDummyLogin(w, r)
+		siw.Handler.GetReceptionsReceptionIdReconciliation(w, r, receptionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -125,11 +1703,28 @@ func (siw *ServerInterfaceWrapper) PostDummyLogin(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Request) {
+// PostReceptionsReceptionIdReconciliationAcknowledge operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReconciliationAcknowledge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostLogin(w, r)
+		siw.Handler.PostReceptionsReceptionIdReconciliationAcknowledge(w, r, receptionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -139,8 +1734,19 @@ func (siw *ServerInterfaceWrapper) PostLogin(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.Request) {
+// PostReceptionsReceptionIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", chi.URLParam(r, "receptionId"), &receptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "receptionId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -149,7 +1755,7 @@ func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostProducts(w, r)
+		siw.Handler.PostReceptionsReceptionIdReopen(w, r, receptionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -159,8 +1765,22 @@ func (siw *ServerInterfaceWrapper) PostProducts(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request) {
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostRegister(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReportsProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsProducts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -171,42 +1791,80 @@ func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
+	var params GetReportsProductsParams
 
-	// ------------- Optional query parameter "startDate" -------------
+	// ------------- Required query parameter "startDate" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", r.URL.Query(), &params.StartDate)
+	if paramValue := r.URL.Query().Get("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "startDate"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "startDate", r.URL.Query(), &params.StartDate)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	// ------------- Required query parameter "endDate" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", r.URL.Query(), &params.EndDate)
+	if paramValue := r.URL.Query().Get("endDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "endDate"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "endDate", r.URL.Query(), &params.EndDate)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "period" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "groupBy" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", r.URL.Query(), &params.GroupBy)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", r.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "city", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPvz(w, r, params)
+		siw.Handler.GetReportsProducts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -216,8 +1874,10 @@ func (siw *ServerInterfaceWrapper) GetPvz(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Request) {
+// GetReportsReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetReportsReceptions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -225,39 +1885,73 @@ func (siw *ServerInterfaceWrapper) PostPvz(w http.ResponseWriter, r *http.Reques
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvz(w, r)
-	}))
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReportsReceptionsParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	// ------------- Required query parameter "startDate" -------------
+
+	if paramValue := r.URL.Query().Get("startDate"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "startDate"})
+		return
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	err = runtime.BindQueryParameter("form", true, true, "startDate", r.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "startDate", Err: err})
+		return
+	}
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request) {
+	// ------------- Required query parameter "endDate" -------------
 
-	var err error
+	if paramValue := r.URL.Query().Get("endDate"); paramValue != "" {
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "endDate"})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, true, "endDate", r.URL.Query(), &params.EndDate)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endDate", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "period" -------------
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pvzId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pvzId", r.URL.Query(), &params.PvzId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "city" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "city", r.URL.Query(), &params.City)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "city", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvzPvzIdCloseLastReception(w, r, pvzId)
+		siw.Handler.GetReportsReceptions(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -267,20 +1961,37 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request) {
+// PostToken operation middleware
+func (siw *ServerInterfaceWrapper) PostToken(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostToken(w, r)
+	}))
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId openapi_types.UUID
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", chi.URLParam(r, "pvzId"), &pvzId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pvzId", Err: err})
-		return
+	handler.ServeHTTP(w, r)
+}
+
+// PostTokenRefresh operation middleware
+func (siw *ServerInterfaceWrapper) PostTokenRefresh(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostTokenRefresh(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// GetWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -288,7 +1999,7 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.Response
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostPvzPvzIdDeleteLastProduct(w, r, pvzId)
+		siw.Handler.GetWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -298,8 +2009,8 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http.Request) {
+// PostWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostWebhooks(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -308,7 +2019,7 @@ func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostReceptions(w, r)
+		siw.Handler.PostWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -318,11 +2029,28 @@ func (siw *ServerInterfaceWrapper) PostReceptions(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhooksWebhookId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookId" -------------
+	var webhookId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookId", chi.URLParam(r, "webhookId"), &webhookId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostRegister(w, r)
+		siw.Handler.DeleteWebhooksWebhookId(w, r, webhookId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -445,33 +2173,147 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/audit", wrapper.GetAudit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/cities", wrapper.GetCities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/cities", wrapper.PostCities)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/cities/{name}", wrapper.DeleteCitiesName)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/receptions.csv", wrapper.GetExportReceptionsCsv)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/export/receptions.xlsx", wrapper.GetExportReceptionsXlsx)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/login", wrapper.PostLogin)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/logout", wrapper.PostLogout)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/product_types", wrapper.GetProductTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/product_types", wrapper.PostProductTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/product_types/{name}", wrapper.DeleteProductTypesName)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products", wrapper.GetProducts)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/products", wrapper.PostProducts)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/batch", wrapper.PostProductsBatch)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/products/{productId}/history", wrapper.GetProductsProductIdHistory)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/issue", wrapper.PostProductsProductIdIssue)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/products/{productId}/return", wrapper.PostProductsProductIdReturn)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/pvz", wrapper.GetPvz)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pvz", wrapper.PostPvz)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz/nearby", wrapper.GetPvzNearby)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz/{pvzId}", wrapper.GetPvzPvzId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/pvz/{pvzId}", wrapper.PatchPvzPvzId)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pvz/{pvzId}/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pvz/{pvzId}/deactivate", wrapper.PostPvzPvzIdDeactivate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/pvz/{pvzId}/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz/{pvzId}/employees", wrapper.GetPvzPvzIdEmployees)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/pvz/{pvzId}/employees", wrapper.PostPvzPvzIdEmployees)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/pvz/{pvzId}/employees/{userId}", wrapper.DeletePvzPvzIdEmployeesUserId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/pvz/{pvzId}/receptions", wrapper.GetPvzPvzIdReceptions)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/receptions", wrapper.PostReceptions)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}", wrapper.GetReceptionsReceptionId)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}/corrections", wrapper.GetReceptionsReceptionIdCorrections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/corrections", wrapper.PostReceptionsReceptionIdCorrections)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}/manifest", wrapper.GetReceptionsReceptionIdManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/receptions/{receptionId}/manifest", wrapper.PutReceptionsReceptionIdManifest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/receptions/{receptionId}/reconciliation", wrapper.GetReceptionsReceptionIdReconciliation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/reconciliation/acknowledge", wrapper.PostReceptionsReceptionIdReconciliationAcknowledge)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/receptions/{receptionId}/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/register", wrapper.PostRegister)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/products", wrapper.GetReportsProducts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/reports/receptions", wrapper.GetReportsReceptions)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/token", wrapper.PostToken)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/token/refresh", wrapper.PostTokenRefresh)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/webhooks", wrapper.GetWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/webhooks", wrapper.PostWebhooks)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/webhooks/{webhookId}", wrapper.DeleteWebhooksWebhookId)
+	})
 
 	return r
 }
@@ -13,24 +13,46 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for PVZStatus.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	Active   PVZStatus = "active"
+	Inactive PVZStatus = "inactive"
 )
 
-// Defines values for ProductType.
+// Defines values for ProductStatus.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	Accepted ProductStatus = "accepted"
+	Issued   ProductStatus = "issued"
+	Returned ProductStatus = "returned"
+	Stored   ProductStatus = "stored"
+)
+
+// Defines values for ReceptionCorrectionAction.
+const (
+	Amend  ReceptionCorrectionAction = "amend"
+	Reopen ReceptionCorrectionAction = "reopen"
 )
 
 // Defines values for ReceptionStatus.
 const (
+	Amended    ReceptionStatus = "amended"
 	Close      ReceptionStatus = "close"
 	InProgress ReceptionStatus = "in_progress"
+	Reopened   ReceptionStatus = "reopened"
+)
+
+// Defines values for ReportDimension.
+const (
+	City ReportDimension = "city"
+	Pvz  ReportDimension = "pvz"
+	Type ReportDimension = "type"
+)
+
+// Defines values for ReportPeriod.
+const (
+	Day   ReportPeriod = "day"
+	Month ReportPeriod = "month"
+	Week  ReportPeriod = "week"
 )
 
 // Defines values for UserRole.
@@ -39,17 +61,39 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
+// Defines values for WebhookEventType.
+const (
+	PVZCreated        WebhookEventType = "PVZCreated"
+	PVZDeactivated    WebhookEventType = "PVZDeactivated"
+	PVZUpdated        WebhookEventType = "PVZUpdated"
+	ProductAdded      WebhookEventType = "ProductAdded"
+	ProductDeleted    WebhookEventType = "ProductDeleted"
+	ReceptionAmended  WebhookEventType = "ReceptionAmended"
+	ReceptionClosed   WebhookEventType = "ReceptionClosed"
+	ReceptionOpened   WebhookEventType = "ReceptionOpened"
+	ReceptionReopened WebhookEventType = "ReceptionReopened"
+)
+
 // Defines values for PostDummyLoginJSONBodyRole.
 const (
 	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
 	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for GetPvzParamsReceptionStatus.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	GetPvzParamsReceptionStatusAmended    GetPvzParamsReceptionStatus = "amended"
+	GetPvzParamsReceptionStatusClose      GetPvzParamsReceptionStatus = "close"
+	GetPvzParamsReceptionStatusInProgress GetPvzParamsReceptionStatus = "in_progress"
+	GetPvzParamsReceptionStatusReopened   GetPvzParamsReceptionStatus = "reopened"
+)
+
+// Defines values for GetPvzPvzIdReceptionsParamsStatus.
+const (
+	GetPvzPvzIdReceptionsParamsStatusAmended    GetPvzPvzIdReceptionsParamsStatus = "amended"
+	GetPvzPvzIdReceptionsParamsStatusClose      GetPvzPvzIdReceptionsParamsStatus = "close"
+	GetPvzPvzIdReceptionsParamsStatusInProgress GetPvzPvzIdReceptionsParamsStatus = "in_progress"
+	GetPvzPvzIdReceptionsParamsStatusReopened   GetPvzPvzIdReceptionsParamsStatus = "reopened"
 )
 
 // Defines values for PostRegisterJSONBodyRole.
@@ -58,31 +102,150 @@ const (
 	Moderator PostRegisterJSONBodyRole = "moderator"
 )
 
+// AuditRecord defines model for AuditRecord.
+type AuditRecord struct {
+	Action string `json:"action"`
+
+	// ActorId Пользователь из JWT токена. Отсутствует для запросов без токена
+	ActorId   *string `json:"actorId,omitempty"`
+	ActorRole *string `json:"actorRole,omitempty"`
+
+	// After Состояние сущности после изменения
+	After *map[string]interface{} `json:"after,omitempty"`
+
+	// Before Состояние сущности до изменения
+	Before    *map[string]interface{} `json:"before,omitempty"`
+	CreatedAt time.Time               `json:"createdAt"`
+	EntityId  string                  `json:"entityId"`
+
+	// EntityType Тип сущности - pvz, reception, product, city, product_type, webhook_subscription или user
+	EntityType string             `json:"entityType"`
+	Id         openapi_types.UUID `json:"id"`
+	RequestId  *string            `json:"requestId,omitempty"`
+}
+
+// DictionaryEntry defines model for DictionaryEntry.
+type DictionaryEntry struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Name      string     `json:"name"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// ManifestItem Строка манифеста - конкретный товар по штрихкоду или количество товаров типа
+type ManifestItem struct {
+	// Barcode Штрихкод ожидаемого товара, количество для такой строки равно 1
+	Barcode  *string `json:"barcode,omitempty"`
+	Quantity int     `json:"quantity"`
+
+	// Type Тип ожидаемых товаров
+	Type *string `json:"type,omitempty"`
+}
+
+// NearbyPVZ defines model for NearbyPVZ.
+type NearbyPVZ struct {
+	// Distance Расстояние до точки поиска в метрах
+	Distance float64 `json:"distance"`
+	Pvz      PVZ     `json:"pvz"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity             `json:"city"`
-	Id               *openapi_types.UUID `json:"id,omitempty"`
-	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
+	// City Город из справочника /cities
+	City          string              `json:"city"`
+	DeactivatedAt *time.Time          `json:"deactivatedAt,omitempty"`
+	House         *string             `json:"house,omitempty"`
+	Id            *openapi_types.UUID `json:"id,omitempty"`
+
+	// Latitude Широта, передается вместе с долготой
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Longitude Долгота, передается вместе с широтой
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// OpeningHours Часы работы в свободной форме, например "Пн-Пт 09:00-21:00"
+	OpeningHours     *string    `json:"openingHours,omitempty"`
+	PostalCode       *string    `json:"postalCode,omitempty"`
+	RegistrationDate *time.Time `json:"registrationDate,omitempty"`
+
+	// Status В неактивном ПВЗ нельзя открыть новую приемку
+	Status *PVZStatus `json:"status,omitempty"`
+	Street *string    `json:"street,omitempty"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZStatus В неактивном ПВЗ нельзя открыть новую приемку
+type PVZStatus string
+
+// PVZEmployee defines model for PVZEmployee.
+type PVZEmployee struct {
+	AssignedAt *time.Time           `json:"assignedAt,omitempty"`
+	Email      *openapi_types.Email `json:"email,omitempty"`
+	PvzId      openapi_types.UUID   `json:"pvzId"`
+	UserId     openapi_types.UUID   `json:"userId"`
+}
 
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+	// Attributes Произвольные атрибуты товара, например номер заказа
+	Attributes *map[string]string `json:"attributes,omitempty"`
+
+	// Barcode Штрихкод или трек-номер, уникален в пределах приемки
+	Barcode  *string    `json:"barcode,omitempty"`
+	DateTime *time.Time `json:"dateTime,omitempty"`
+
+	// HeightMm Высота в миллиметрах
+	HeightMm *int                `json:"heightMm,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+
+	// LengthMm Длина в миллиметрах
+	LengthMm    *int               `json:"lengthMm,omitempty"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	Status      *ProductStatus     `json:"status,omitempty"`
+	Type        string             `json:"type"`
+
+	// WeightGrams Вес в граммах
+	WeightGrams *int `json:"weightGrams,omitempty"`
+
+	// WidthMm Ширина в миллиметрах
+	WidthMm *int `json:"widthMm,omitempty"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductBatchResult defines model for ProductBatchResult.
+type ProductBatchResult struct {
+	// Error Причина, по которой товар не добавлен
+	Error *string `json:"error,omitempty"`
+
+	// Index Позиция товара в запросе
+	Index   int      `json:"index"`
+	Product *Product `json:"product,omitempty"`
+}
+
+// ProductReportRow Разрезы, по которым не группировали, в строке отсутствуют
+type ProductReportRow struct {
+	City *string `json:"city,omitempty"`
+
+	// Period Начало дня, недели или месяца
+	Period   time.Time           `json:"period"`
+	Products int                 `json:"products"`
+	PvzId    *openapi_types.UUID `json:"pvzId,omitempty"`
+	Type     *string             `json:"type,omitempty"`
+}
+
+// ProductStatus defines model for ProductStatus.
+type ProductStatus string
+
+// ProductStatusChange defines model for ProductStatusChange.
+type ProductStatusChange struct {
+	ChangedBy  *string             `json:"changedBy,omitempty"`
+	DateTime   time.Time           `json:"dateTime"`
+	FromStatus *ProductStatus      `json:"fromStatus,omitempty"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+	ProductId  openapi_types.UUID  `json:"productId"`
+	ToStatus   ProductStatus       `json:"toStatus"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
@@ -95,9 +258,82 @@ type Reception struct {
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionCorrection Открытие заново или исправление закрытой приемки модератором
+type ReceptionCorrection struct {
+	Action ReceptionCorrectionAction `json:"action"`
+
+	// Added Товары, добавленные в приемку сразу на хранение
+	Added       []Product          `json:"added"`
+	ChangedBy   *string            `json:"changedBy,omitempty"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Id          openapi_types.UUID `json:"id"`
+	Reason      string             `json:"reason"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Removed Товары, удаленные из приемки
+	Removed []Product `json:"removed"`
+}
+
+// ReceptionCorrectionAction defines model for ReceptionCorrection.Action.
+type ReceptionCorrectionAction string
+
+// ReceptionManifest defines model for ReceptionManifest.
+type ReceptionManifest struct {
+	// BlockOnDiscrepancies Запрещает закрывать приемку с неподтвержденными расхождениями
+	BlockOnDiscrepancies bool               `json:"blockOnDiscrepancies"`
+	Items                []ManifestItem     `json:"items"`
+	ReceptionId          openapi_types.UUID `json:"receptionId"`
+	UploadedAt           time.Time          `json:"uploadedAt"`
+	UploadedBy           *string            `json:"uploadedBy,omitempty"`
+}
+
+// ReceptionReportRow Разрезы, по которым не группировали, в строке отсутствуют
+type ReceptionReportRow struct {
+	City *string `json:"city,omitempty"`
+
+	// Closed Сколько из приемок закрыто
+	Closed int `json:"closed"`
+
+	// Period Начало дня, недели или месяца
+	Period time.Time `json:"period"`
+
+	// Products Сколько товаров принято в эти приемки
+	Products   int                 `json:"products"`
+	PvzId      *openapi_types.UUID `json:"pvzId,omitempty"`
+	Receptions int                 `json:"receptions"`
+}
+
+// Reconciliation Сверка товаров приемки с манифестом. Для открытой приемки считается по текущим товарам
+type Reconciliation struct {
+	AcknowledgedAt *time.Time `json:"acknowledgedAt,omitempty"`
+	AcknowledgedBy *string    `json:"acknowledgedBy,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+
+	// Extra Приняты, но не ожидались по манифесту
+	Extra            []ManifestItem `json:"extra"`
+	HasDiscrepancies bool           `json:"hasDiscrepancies"`
+	Matched          []ManifestItem `json:"matched"`
+
+	// Missing Ожидались по манифесту, но не приняты
+	Missing     []ManifestItem     `json:"missing"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+}
+
+// ReportDimension defines model for ReportDimension.
+type ReportDimension string
+
+// ReportPeriod defines model for ReportPeriod.
+type ReportPeriod string
+
 // Token defines model for Token.
 type Token = string
 
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	RefreshToken string `json:"refreshToken"`
+	Token        string `json:"token"`
+}
+
 // User defines model for User.
 type User struct {
 	Email openapi_types.Email `json:"email"`
@@ -108,28 +344,147 @@ type User struct {
 // UserRole defines model for User.Role.
 type UserRole string
 
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// EventTypes События, на которые оформлена подписка. Пустой список означает все события
+	EventTypes []WebhookEventType  `json:"eventTypes"`
+	Id         *openapi_types.UUID `json:"id,omitempty"`
+
+	// Secret Ключ для проверки HMAC-SHA256 подписи в заголовке X-Webhook-Signature. Возвращается только при создании
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	EntityType *string `form:"entityType,omitempty" json:"entityType,omitempty"`
+	EntityId   *string `form:"entityId,omitempty" json:"entityId,omitempty"`
+	ActorId    *string `form:"actorId,omitempty" json:"actorId,omitempty"`
+
+	// From Начало периода
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец периода
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostCitiesJSONBody defines parameters for PostCities.
+type PostCitiesJSONBody struct {
+	Name string `json:"name"`
+}
+
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
-	Role PostDummyLoginJSONBodyRole `json:"role"`
+	// PvzId ПВЗ, за которым закрепляется тестовый сотрудник, только для роли employee
+	PvzId *openapi_types.UUID        `json:"pvzId,omitempty"`
+	Role  PostDummyLoginJSONBodyRole `json:"role"`
 }
 
 // PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBodyRole string
 
+// GetExportReceptionsCsvParams defines parameters for GetExportReceptionsCsv.
+type GetExportReceptionsCsvParams struct {
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+}
+
+// GetExportReceptionsXlsxParams defines parameters for GetExportReceptionsXlsx.
+type GetExportReceptionsXlsxParams struct {
+	// StartDate Начальная дата диапазона
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата диапазона
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+}
+
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
+// PostLogoutJSONBody defines parameters for PostLogout.
+type PostLogoutJSONBody struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
+}
+
+// PostProductTypesJSONBody defines parameters for PostProductTypes.
+type PostProductTypesJSONBody struct {
+	Name string `json:"name"`
+}
+
+// GetProductsParams defines parameters for GetProducts.
+type GetProductsParams struct {
+	// Barcode Штрихкод или трек-номер товара
+	Barcode string `form:"barcode" json:"barcode"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	// Attributes Произвольные атрибуты товара, например номер заказа
+	Attributes *map[string]string `json:"attributes,omitempty"`
+
+	// Barcode Штрихкод или трек-номер, уникален в пределах приемки
+	Barcode *string `json:"barcode,omitempty"`
+
+	// HeightMm Высота в миллиметрах
+	HeightMm *int `json:"heightMm,omitempty"`
+
+	// LengthMm Длина в миллиметрах
+	LengthMm *int               `json:"lengthMm,omitempty"`
+	PvzId    openapi_types.UUID `json:"pvzId"`
+
+	// Type Тип товара из справочника /product_types
+	Type string `json:"type"`
+
+	// WeightGrams Вес в граммах
+	WeightGrams *int `json:"weightGrams,omitempty"`
+
+	// WidthMm Ширина в миллиметрах
+	WidthMm *int `json:"widthMm,omitempty"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	Products []struct {
+		// Attributes Произвольные атрибуты товара, например номер заказа
+		Attributes *map[string]string `json:"attributes,omitempty"`
+
+		// Barcode Штрихкод или трек-номер, уникален в пределах приемки
+		Barcode *string `json:"barcode,omitempty"`
+
+		// HeightMm Высота в миллиметрах
+		HeightMm *int `json:"heightMm,omitempty"`
+
+		// LengthMm Длина в миллиметрах
+		LengthMm *int `json:"lengthMm,omitempty"`
+
+		// Type Тип товара из справочника /product_types
+		Type string `json:"type"`
+
+		// WeightGrams Вес в граммах
+		WeightGrams *int `json:"weightGrams,omitempty"`
+
+		// WidthMm Ширина в миллиметрах
+		WidthMm *int `json:"widthMm,omitempty"`
+	} `json:"products"`
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
@@ -138,6 +493,75 @@ type GetPvzParams struct {
 
 	// EndDate Конечная дата диапазона
 	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
+	City    *string    `form:"city,omitempty" json:"city,omitempty"`
+
+	// ReceptionStatus Статус приемки
+	ReceptionStatus *GetPvzParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// IncludeEmpty Возвращать ПВЗ без подходящих приемок
+	IncludeEmpty *bool `form:"includeEmpty,omitempty" json:"includeEmpty,omitempty"`
+
+	// Page Номер страницы, не используется вместе с cursor
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Cursor Курсор из заголовка X-Next-Cursor предыдущей страницы, пустое значение - первая страница
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPvzParamsReceptionStatus defines parameters for GetPvz.
+type GetPvzParamsReceptionStatus string
+
+// GetPvzNearbyParams defines parameters for GetPvzNearby.
+type GetPvzNearbyParams struct {
+	// Lat Широта точки поиска
+	Lat float64 `form:"lat" json:"lat"`
+
+	// Lon Долгота точки поиска
+	Lon float64 `form:"lon" json:"lon"`
+
+	// Radius Радиус поиска в метрах
+	Radius float64 `form:"radius" json:"radius"`
+
+	// Limit Максимальное количество ПВЗ
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchPvzPvzIdJSONBody defines parameters for PatchPvzPvzId.
+type PatchPvzPvzIdJSONBody struct {
+	// City Город из справочника /cities
+	City  *string `json:"city,omitempty"`
+	House *string `json:"house,omitempty"`
+
+	// Latitude Широта, передается вместе с долготой
+	Latitude *float64 `json:"latitude,omitempty"`
+
+	// Longitude Долгота, передается вместе с широтой
+	Longitude *float64 `json:"longitude,omitempty"`
+
+	// OpeningHours Часы работы в свободной форме, например "Пн-Пт 09:00-21:00"
+	OpeningHours *string `json:"openingHours,omitempty"`
+	PostalCode   *string `json:"postalCode,omitempty"`
+	Street       *string `json:"street,omitempty"`
+}
+
+// PostPvzPvzIdEmployeesJSONBody defines parameters for PostPvzPvzIdEmployees.
+type PostPvzPvzIdEmployeesJSONBody struct {
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// GetPvzPvzIdReceptionsParams defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParams struct {
+	// Status Статус приемки
+	Status *GetPvzPvzIdReceptionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// StartDate Начальная дата приемки
+	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
+
+	// EndDate Конечная дата приемки
+	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
 
 	// Page Номер страницы
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
@@ -146,11 +570,56 @@ type GetPvzParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetPvzPvzIdReceptionsParamsStatus defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParamsStatus string
+
 // PostReceptionsJSONBody defines parameters for PostReceptions.
 type PostReceptionsJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
+// PostReceptionsReceptionIdCorrectionsJSONBody defines parameters for PostReceptionsReceptionIdCorrections.
+type PostReceptionsReceptionIdCorrectionsJSONBody struct {
+	AddProducts *[]struct {
+		// Attributes Произвольные атрибуты товара, например номер заказа
+		Attributes *map[string]string `json:"attributes,omitempty"`
+
+		// Barcode Штрихкод или трек-номер, уникален в пределах приемки
+		Barcode *string `json:"barcode,omitempty"`
+
+		// HeightMm Высота в миллиметрах
+		HeightMm *int `json:"heightMm,omitempty"`
+
+		// LengthMm Длина в миллиметрах
+		LengthMm *int `json:"lengthMm,omitempty"`
+
+		// Type Тип товара из справочника /product_types
+		Type string `json:"type"`
+
+		// WeightGrams Вес в граммах
+		WeightGrams *int `json:"weightGrams,omitempty"`
+
+		// WidthMm Ширина в миллиметрах
+		WidthMm *int `json:"widthMm,omitempty"`
+	} `json:"addProducts,omitempty"`
+
+	// Reason Причина, сохраняется в истории исправлений приемки
+	Reason           string                `json:"reason"`
+	RemoveProductIds *[]openapi_types.UUID `json:"removeProductIds,omitempty"`
+}
+
+// PutReceptionsReceptionIdManifestJSONBody defines parameters for PutReceptionsReceptionIdManifest.
+type PutReceptionsReceptionIdManifestJSONBody struct {
+	BlockOnDiscrepancies *bool          `json:"blockOnDiscrepancies,omitempty"`
+	Items                []ManifestItem `json:"items"`
+}
+
+// PostReceptionsReceptionIdReopenJSONBody defines parameters for PostReceptionsReceptionIdReopen.
+type PostReceptionsReceptionIdReopenJSONBody struct {
+	// Reason Причина, сохраняется в истории исправлений приемки
+	Reason string `json:"reason"`
+}
+
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
 	Email    openapi_types.Email      `json:"email"`
@@ -161,20 +630,108 @@ type PostRegisterJSONBody struct {
 // PostRegisterJSONBodyRole defines parameters for PostRegister.
 type PostRegisterJSONBodyRole string
 
+// GetReportsProductsParams defines parameters for GetReportsProducts.
+type GetReportsProductsParams struct {
+	// StartDate Начало периода
+	StartDate time.Time `form:"startDate" json:"startDate"`
+
+	// EndDate Конец периода, не включается. Период не длиннее 366 дней
+	EndDate time.Time `form:"endDate" json:"endDate"`
+
+	// Period Группировка по времени
+	Period *ReportPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// GroupBy Дополнительные разрезы
+	GroupBy *[]ReportDimension  `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+	PvzId   *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+	City    *string             `form:"city,omitempty" json:"city,omitempty"`
+	Type    *string             `form:"type,omitempty" json:"type,omitempty"`
+}
+
+// GetReportsReceptionsParams defines parameters for GetReportsReceptions.
+type GetReportsReceptionsParams struct {
+	// StartDate Начало периода
+	StartDate time.Time `form:"startDate" json:"startDate"`
+
+	// EndDate Конец периода, не включается. Период не длиннее 366 дней
+	EndDate time.Time `form:"endDate" json:"endDate"`
+
+	// Period Группировка по времени
+	Period *ReportPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// GroupBy Дополнительные разрезы, type для приемок не поддерживается
+	GroupBy *[]ReportDimension  `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+	PvzId   *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+	City    *string             `form:"city,omitempty" json:"city,omitempty"`
+}
+
+// PostTokenJSONBody defines parameters for PostToken.
+type PostTokenJSONBody struct {
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
+
+// PostTokenRefreshJSONBody defines parameters for PostTokenRefresh.
+type PostTokenRefreshJSONBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// PostWebhooksJSONBody defines parameters for PostWebhooks.
+type PostWebhooksJSONBody struct {
+	EventTypes *[]WebhookEventType `json:"eventTypes,omitempty"`
+	Url        string              `json:"url"`
+}
+
+// PostCitiesJSONRequestBody defines body for PostCities for application/json ContentType.
+type PostCitiesJSONRequestBody PostCitiesJSONBody
+
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostLogoutJSONRequestBody defines body for PostLogout for application/json ContentType.
+type PostLogoutJSONRequestBody PostLogoutJSONBody
+
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody PostProductTypesJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PatchPvzPvzIdJSONRequestBody defines body for PatchPvzPvzId for application/json ContentType.
+type PatchPvzPvzIdJSONRequestBody PatchPvzPvzIdJSONBody
+
+// PostPvzPvzIdEmployeesJSONRequestBody defines body for PostPvzPvzIdEmployees for application/json ContentType.
+type PostPvzPvzIdEmployeesJSONRequestBody PostPvzPvzIdEmployeesJSONBody
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdCorrectionsJSONRequestBody defines body for PostReceptionsReceptionIdCorrections for application/json ContentType.
+type PostReceptionsReceptionIdCorrectionsJSONRequestBody PostReceptionsReceptionIdCorrectionsJSONBody
+
+// PutReceptionsReceptionIdManifestJSONRequestBody defines body for PutReceptionsReceptionIdManifest for application/json ContentType.
+type PutReceptionsReceptionIdManifestJSONRequestBody PutReceptionsReceptionIdManifestJSONBody
+
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody PostReceptionsReceptionIdReopenJSONBody
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
+
+// PostTokenJSONRequestBody defines body for PostToken for application/json ContentType.
+type PostTokenJSONRequestBody PostTokenJSONBody
+
+// PostTokenRefreshJSONRequestBody defines body for PostTokenRefresh for application/json ContentType.
+type PostTokenRefreshJSONRequestBody PostTokenRefreshJSONBody
+
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody PostWebhooksJSONBody
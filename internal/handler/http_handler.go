@@ -3,10 +3,15 @@ package handler
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"errors"
+	"io"
+	"log/slog"
 	"net/http"
+	"time"
 
+	"github.com/DarRo9/pvz_service/internal/export"
 	"github.com/DarRo9/pvz_service/internal/metrics"
+	"github.com/DarRo9/pvz_service/internal/repository"
 	"github.com/DarRo9/pvz_service/internal/service"
 	"github.com/DarRo9/pvz_service/internal/utils"
 	"github.com/golang-jwt/jwt/v5"
@@ -39,6 +44,12 @@ func validateRole(ctx context.Context, w http.ResponseWriter, allowedRoles []str
 	return false
 }
 
+func userIDFromContext(ctx context.Context) string {
+	user, _ := ctx.Value("user").(jwt.MapClaims)
+	userID, _ := user["user_id"].(string)
+	return userID
+}
+
 func writeResponse(w http.ResponseWriter, statusCode int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
@@ -54,137 +65,440 @@ func WriteError(w http.ResponseWriter, statusCode int, message string) {
 }
 
 func (h *HTTPHandler) PostDummyLogin(w http.ResponseWriter, r *http.Request) {
-	log.Println("Got request in PostDummyLogin")
+	slog.DebugContext(r.Context(), "Got request in PostDummyLogin")
 
 	var request PostDummyLoginJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		log.Println("Error decoding request body:", err)
+		slog.WarnContext(r.Context(), "Error decoding request body", "error", err)
 		WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	if !h.service.IsValidRole(service.UserRole(request.Role)) {
-		log.Println("Invalid role:", request.Role)
+		slog.WarnContext(r.Context(), "Invalid role", "role", request.Role)
 		WriteError(w, http.StatusBadRequest, "Invalid role")
 		return
 	}
 
-	token, err := utils.GenerateJWT("dummy_id", "dummy_email", string(request.Role))
+	pvzID := ""
+	if request.PvzId != nil {
+		if request.Role != PostDummyLoginJSONBodyRoleEmployee {
+			slog.WarnContext(r.Context(), "PVZ id passed for non-employee role", "role", request.Role)
+			WriteError(w, http.StatusBadRequest, "pvzId is allowed only for employee role")
+			return
+		}
+		pvzID = request.PvzId.String()
+	}
+
+	token, err := utils.GenerateDummyJWT(string(request.Role), pvzID)
 	if err != nil {
-		log.Println("Error generating token:", err)
+		slog.ErrorContext(r.Context(), "Error generating token", "error", err)
 		WriteError(w, http.StatusInternalServerError, "Failed to generate token")
 		return
 	}
 
-	log.Println("Token generated")
+	slog.InfoContext(r.Context(), "Token generated")
 	response := Token(token)
 	writeResponse(w, http.StatusOK, response)
 }
 
 func (h *HTTPHandler) PostLogin(w http.ResponseWriter, r *http.Request) {
-	log.Println("Got request in PostLogin")
+	slog.DebugContext(r.Context(), "Got request in PostLogin")
 	ctx := r.Context()
 
 	var request PostLoginJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		log.Println("Error decoding request body:", err)
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
 		WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	user, err := h.service.GetUserByEmail(ctx, string(request.Email))
+	user, ok := h.authenticate(ctx, w, string(request.Email), request.Password)
+	if !ok {
+		return
+	}
+
+	token, err := utils.GenerateJWT(user.ID, user.Email, user.Role)
 	if err != nil {
-		log.Println("Error getting user by email:", err)
-		WriteError(w, http.StatusBadRequest, err.Error())
+		slog.ErrorContext(ctx, "Error generating token", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to generate token")
 		return
 	}
 
-	if err := h.service.CheckPassword(ctx, user, string(request.Password)); err != nil {
-		log.Println("Invalid password:", err)
-		WriteError(w, http.StatusUnauthorized, "Invalid password")
+	slog.InfoContext(ctx, "Token generated")
+	response := Token(token)
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Выдача пары access и refresh токенов по email и паролю
+// (POST /token)
+func (h *HTTPHandler) PostToken(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostToken")
+	ctx := r.Context()
+
+	var request PostTokenJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	token, err := utils.GenerateJWT(user.ID, user.Email, user.Role)
+	user, ok := h.authenticate(ctx, w, string(request.Email), request.Password)
+	if !ok {
+		return
+	}
+
+	tokens, err := h.service.IssueTokens(ctx, user)
 	if err != nil {
-		log.Println("Error generating token:", err)
+		slog.ErrorContext(ctx, "Error generating token", "error", err)
 		WriteError(w, http.StatusInternalServerError, "Failed to generate token")
 		return
 	}
 
-	log.Println("Token generated")
-	response := Token(token)
+	slog.InfoContext(ctx, "Tokens generated")
+	response := tokenPairServiceToHTTP(tokens)
+	writeResponse(w, http.StatusOK, response)
+}
+
+// authenticate проверяет email и пароль и сам отвечает клиенту, если проверка не прошла
+func (h *HTTPHandler) authenticate(ctx context.Context, w http.ResponseWriter, email, password string) (*repository.User, bool) {
+	user, err := h.service.GetUserByEmail(ctx, email)
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting user by email", "error", err)
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return nil, false
+	}
+
+	if err := h.service.CheckPassword(ctx, user, password); err != nil {
+		slog.WarnContext(ctx, "Invalid password", "error", err)
+		WriteError(w, http.StatusUnauthorized, "Invalid password")
+		return nil, false
+	}
+
+	return user, true
+}
+
+// Обновление пары токенов по refresh токену
+// (POST /token/refresh)
+func (h *HTTPHandler) PostTokenRefresh(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostTokenRefresh")
+	ctx := r.Context()
+
+	var request PostTokenRefreshJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil || request.RefreshToken == "" {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	tokens, err := h.service.RefreshTokens(ctx, request.RefreshToken)
+	if errors.Is(err, service.ErrInvalidRefreshToken) {
+		slog.WarnContext(ctx, "Invalid refresh token")
+		WriteError(w, http.StatusUnauthorized, "Invalid refresh token")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error refreshing tokens", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to refresh token")
+		return
+	}
+
+	slog.InfoContext(ctx, "Tokens refreshed")
+	response := tokenPairServiceToHTTP(tokens)
 	writeResponse(w, http.StatusOK, response)
 }
 
+// Выход из системы с отзывом текущего токена
+// (POST /logout)
+func (h *HTTPHandler) PostLogout(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostLogout")
+	ctx := r.Context()
+
+	claims, ok := ctx.Value("user").(jwt.MapClaims)
+	if !ok {
+		WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	jti, _ := claims["jti"].(string)
+	expiresAt, err := claims.GetExpirationTime()
+	if jti == "" || err != nil || expiresAt == nil {
+		slog.WarnContext(ctx, "Invalid token claims", "error", err)
+		WriteError(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	var request PostLogoutJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil && !errors.Is(err, io.EOF) {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	refreshToken := ""
+	if request.RefreshToken != nil {
+		refreshToken = *request.RefreshToken
+	}
+
+	if err := h.service.Logout(ctx, userIDFromContext(ctx), jti, expiresAt.Time, refreshToken); err != nil {
+		slog.ErrorContext(ctx, "Error revoking tokens", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to logout")
+		return
+	}
+
+	slog.InfoContext(ctx, "User logged out")
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 // (POST /products)
 func (h *HTTPHandler) PostProducts(w http.ResponseWriter, r *http.Request) {
-	log.Println("Got request in PostProducts")
+	slog.DebugContext(r.Context(), "Got request in PostProducts")
 	ctx := r.Context()
 
 	if !validateRole(ctx, w, []string{"employee"}) {
-		log.Println("Unauthorized")
+		slog.WarnContext(ctx, "Unauthorized")
 		return
 	}
 
-	var request Product
+	var request PostProductsJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		log.Println("Error decoding request body:", err)
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
 		WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	product, err := h.service.CreateProduct(
 		ctx,
-		request.ReceptionId.String(),
-		string(request.Type),
+		request.PvzId.String(),
+		repository.NewProduct{
+			Type: request.Type,
+			ProductDetails: productDetailsHTTPToRepository(
+				request.Barcode, request.WeightGrams, request.LengthMm, request.WidthMm, request.HeightMm, request.Attributes,
+			),
+		},
+		userIDFromContext(ctx),
 	)
 	if err != nil {
-		log.Println("Error creating product:", err)
-		WriteError(w, http.StatusBadRequest, err.Error())
+		slog.WarnContext(ctx, "Error creating product", "error", err)
+		writeProductError(w, err)
 		return
 	}
 
-	log.Println("Product created")
+	slog.InfoContext(ctx, "Product created")
 	metrics.ProductsAddedTotal.Inc()
 	response := productRepositoryToHTTP(product)
 	writeResponse(w, http.StatusCreated, response)
 }
 
-// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
+// Поиск товаров по штрихкоду во всех приемках
+// (GET /products)
+func (h *HTTPHandler) GetProducts(w http.ResponseWriter, r *http.Request, params GetProductsParams) {
+	slog.DebugContext(r.Context(), "Got request in GetProducts")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	products, err := h.service.FindProductsByBarcode(ctx, params.Barcode)
+	if errors.Is(err, service.ErrInvalidProductDetails) {
+		slog.WarnContext(ctx, "Invalid barcode", "error", err)
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error finding products by barcode", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to find products")
+		return
+	}
+
+	response := make([]*Product, len(products))
+	for i := range products {
+		response[i] = productRepositoryToHTTP(products[i])
+	}
+	slog.InfoContext(ctx, "Products found by barcode", "count", len(response))
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Пакетное добавление товаров в текущую приемку (только для сотрудников ПВЗ)
+// (POST /products/batch)
+func (h *HTTPHandler) PostProductsBatch(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostProductsBatch")
+	ctx := r.Context()
+
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PostProductsBatchJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	newProducts := make([]repository.NewProduct, len(request.Products))
+	for i, product := range request.Products {
+		newProducts[i] = repository.NewProduct{
+			Type: product.Type,
+			ProductDetails: productDetailsHTTPToRepository(
+				product.Barcode, product.WeightGrams, product.LengthMm, product.WidthMm, product.HeightMm, product.Attributes,
+			),
+		}
+	}
+
+	results, err := h.service.CreateProducts(ctx, request.PvzId.String(), newProducts, userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating products", "error", err)
+		writeEmployeeError(w, err)
+		return
+	}
+
+	response := productBatchResultsToHTTP(results)
+	added := 0
+	for _, result := range response {
+		if result.Product != nil {
+			added++
+		}
+	}
+	slog.InfoContext(ctx, "Products created", "added", added, "total", len(response))
+	metrics.ProductsAddedTotal.Add(float64(added))
+	writeResponse(w, http.StatusOK, response)
+}
+
+// История смены статусов товара
+// (GET /products/{productId}/history)
+func (h *HTTPHandler) GetProductsProductIdHistory(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in GetProductsProductIdHistory")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	history, err := h.service.GetProductHistory(ctx, productId.String())
+	if errors.Is(err, service.ErrProductNotFound) {
+		WriteError(w, http.StatusNotFound, "Product not found")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error getting product history", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to get product history")
+		return
+	}
+
+	response := make([]*ProductStatusChange, len(history))
+	for i := range history {
+		response[i] = productStatusChangeRepositoryToHTTP(history[i])
+	}
+	slog.InfoContext(ctx, "Product history retrieved")
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Выдача товара клиенту (только для сотрудников ПВЗ)
+// (POST /products/{productId}/issue)
+func (h *HTTPHandler) PostProductsProductIdIssue(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostProductsProductIdIssue")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	product, err := h.service.IssueProduct(ctx, productId.String(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error issuing product", "error", err)
+		writeProductStatusError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Product issued")
+	metrics.ProductsIssuedTotal.Inc()
+	response := productRepositoryToHTTP(product)
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Возврат товара отправителю, в том числе после возврата клиентом (только для сотрудников ПВЗ)
+// (POST /products/{productId}/return)
+func (h *HTTPHandler) PostProductsProductIdReturn(w http.ResponseWriter, r *http.Request, productId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostProductsProductIdReturn")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	product, err := h.service.ReturnProduct(ctx, productId.String(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error returning product", "error", err)
+		writeProductStatusError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Product returned")
+	metrics.ProductsReturnedTotal.Inc()
+	response := productRepositoryToHTTP(product)
+	writeResponse(w, http.StatusOK, response)
+}
+
+func writeProductStatusError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrProductNotFound):
+		WriteError(w, http.StatusNotFound, "Product not found")
+	case errors.Is(err, service.ErrInvalidProductStatusTransition):
+		WriteError(w, http.StatusBadRequest, err.Error())
+	default:
+		WriteError(w, http.StatusInternalServerError, "Failed to change product status")
+	}
+}
+
+// Получение списка ПВЗ с фильтрацией по дате и статусу приемки, городу и пагинацией. С параметром cursor
+// список листается по курсору, курсор следующей страницы возвращается в заголовке X-Next-Cursor
 // (GET /pvz)
 func (h *HTTPHandler) GetPvz(w http.ResponseWriter, r *http.Request, params GetPvzParams) {
-	log.Println("Got request in GetPvz")
+	slog.DebugContext(r.Context(), "Got request in GetPvz")
 	ctx := r.Context()
 	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
-		log.Println("Unauthorized")
+		slog.WarnContext(ctx, "Unauthorized")
 		return
 	}
 
-	page := 1
 	limit := 10
-
-	if params.Page != nil {
-		page = *params.Page
-	}
 	if params.Limit != nil {
 		limit = *params.Limit
 	}
 
-	if page <= 0 {
-		WriteError(w, http.StatusBadRequest, "Page must be greater than 0")
+	if limit <= 0 || limit > 100 {
+		WriteError(w, http.StatusBadRequest, "Limit must be between 1 and 100")
 		return
 	}
 
-	if limit <= 0 || limit >= 31 {
-		WriteError(w, http.StatusBadRequest, "Limit must be between 1 and 30")
-		return
+	var (
+		pvzs       []*repository.PVZWithReceptions
+		nextCursor string
+		err        error
+	)
+	if params.Cursor != nil {
+		if params.Page != nil {
+			WriteError(w, http.StatusBadRequest, "Page and cursor can not be used together")
+			return
+		}
+		pvzs, nextCursor, err = h.service.ListPVZByCursor(ctx, pvzFilterHTTPToRepository(params), *params.Cursor, limit)
+	} else {
+		page := 1
+		if params.Page != nil {
+			page = *params.Page
+		}
+		if page <= 0 {
+			WriteError(w, http.StatusBadRequest, "Page must be greater than 0")
+			return
+		}
+		pvzs, err = h.service.ListPVZ(ctx, pvzFilterHTTPToRepository(params), page, limit)
 	}
-
-	pvzs, err := h.service.ListPVZ(ctx, params.StartDate, params.EndDate, page, limit)
 	if err != nil {
-		log.Println("Error getting PVZ list:", err)
+		slog.WarnContext(ctx, "Error getting PVZ list", "error", err)
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
@@ -193,137 +507,1095 @@ func (h *HTTPHandler) GetPvz(w http.ResponseWriter, r *http.Request, params GetP
 	for i := range pvzs {
 		response[i] = pvzWithReceptionsRepositoryToHTTP(pvzs[i])
 	}
-	log.Println("PVZ list retrieved")
+	if nextCursor != "" {
+		w.Header().Set("X-Next-Cursor", nextCursor)
+	}
+	slog.InfoContext(ctx, "PVZ list retrieved")
 	writeResponse(w, http.StatusOK, response)
 }
 
 // Создание ПВЗ (только для модераторов)
 // (POST /pvz)
 func (h *HTTPHandler) PostPvz(w http.ResponseWriter, r *http.Request) {
-	log.Println("Got request in PostPvz")
+	slog.DebugContext(r.Context(), "Got request in PostPvz")
 	ctx := r.Context()
 
 	if !validateRole(ctx, w, []string{"moderator"}) {
-		log.Println("Unauthorized")
+		slog.WarnContext(ctx, "Unauthorized")
 		return
 	}
 
 	var request PVZ
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		log.Println("Error decoding request body:", err)
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
 		WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
 	pvz, err := h.service.CreatePVZ(
 		ctx,
-		string(request.City),
+		request.City,
+		repository.PVZLocation{
+			Street:       request.Street,
+			House:        request.House,
+			PostalCode:   request.PostalCode,
+			Latitude:     request.Latitude,
+			Longitude:    request.Longitude,
+			OpeningHours: request.OpeningHours,
+		},
 	)
 	if err != nil {
-		log.Println("Error creating PVZ:", err)
+		slog.WarnContext(ctx, "Error creating PVZ", "error", err)
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	metrics.PVZCreatedTotal.Inc()
 
-	log.Println("PVZ created")
+	slog.InfoContext(ctx, "PVZ created")
 	response := pvzRepositoryToHTTP(pvz)
 	writeResponse(w, http.StatusCreated, response)
 }
 
-// Закрытие последней открытой приемки товаров в рамках ПВЗ
-// (POST /pvz/{pvzId}/close_last_reception)
-func (h *HTTPHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
-	log.Println("Got request in PostPvzPvzIdCloseLastReception")
+// Поиск ближайших активных ПВЗ, отсортированных по расстоянию
+// (GET /pvz/nearby)
+func (h *HTTPHandler) GetPvzNearby(w http.ResponseWriter, r *http.Request, params GetPvzNearbyParams) {
+	slog.DebugContext(r.Context(), "Got request in GetPvzNearby")
 	ctx := r.Context()
-	if !validateRole(ctx, w, []string{"employee"}) {
-		log.Println("Unauthorized")
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
 		return
 	}
 
-	rc, err := h.service.CloseReception(ctx, pvzId.String())
-	if err != nil {
-		log.Println("Error closing reception:", err)
+	limit := 10
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit <= 0 || limit >= 31 {
+		WriteError(w, http.StatusBadRequest, "Limit must be between 1 and 30")
+		return
+	}
+
+	nearby, err := h.service.ListNearbyPVZ(ctx, params.Lat, params.Lon, params.Radius, limit)
+	if errors.Is(err, service.ErrInvalidLocation) {
 		WriteError(w, http.StatusBadRequest, err.Error())
 		return
 	}
-	log.Println("Reception closed")
-	response := receptionRepositoryToHTTP(rc)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error searching nearby PVZ", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to search nearby PVZ")
+		return
+	}
+
+	response := make([]*NearbyPVZ, len(nearby))
+	for i := range nearby {
+		response[i] = nearbyPVZRepositoryToHTTP(nearby[i])
+	}
+	slog.InfoContext(ctx, "Nearby PVZ retrieved")
 	writeResponse(w, http.StatusOK, response)
 }
 
-// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-// (POST /pvz/{pvzId}/delete_last_product)
-func (h *HTTPHandler) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
-	log.Println("Got request in PostPvzPvzIdDeleteLastProduct")
+// Получение ПВЗ с приемками и товарами
+// (GET /pvz/{pvzId})
+func (h *HTTPHandler) GetPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in GetPvzPvzId")
 	ctx := r.Context()
-	if !validateRole(ctx, w, []string{"employee"}) {
-		log.Println("Unauthorized")
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
 		return
 	}
 
-	_, err := h.service.DeleteProduct(ctx, pvzId.String())
+	pvz, err := h.service.GetPVZ(ctx, pvzId.String())
+	if errors.Is(err, service.ErrPVZNotFound) {
+		WriteError(w, http.StatusNotFound, "PVZ not found")
+		return
+	}
 	if err != nil {
-		log.Println("Error deleting product:", err)
-		WriteError(w, http.StatusBadRequest, err.Error())
+		slog.ErrorContext(ctx, "Error getting PVZ", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to get PVZ")
 		return
 	}
-	log.Println("Product deleted")
+
+	slog.InfoContext(ctx, "PVZ retrieved")
+	writeResponse(w, http.StatusOK, pvzWithReceptionsRepositoryToHTTP(pvz))
 }
 
-// Создание новой приемки товаров (только для сотрудников ПВЗ)
-// (POST /receptions)
-func (h *HTTPHandler) PostReceptions(w http.ResponseWriter, r *http.Request) {
-	log.Println("Got request in PostReceptions")
+// Изменение данных ПВЗ (только для модераторов)
+// (PATCH /pvz/{pvzId})
+func (h *HTTPHandler) PatchPvzPvzId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PatchPvzPvzId")
 	ctx := r.Context()
-
-	if !validateRole(ctx, w, []string{"employee"}) {
-		log.Println("Unauthorized")
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
 		return
 	}
 
-	var request Reception
+	var request PatchPvzPvzIdJSONBody
 	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		log.Println("Error decoding request body:", err)
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
 		WriteError(w, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 
-	rc, err := h.service.CreateReception(ctx, request.PvzId.String())
+	pvz, err := h.service.UpdatePVZ(ctx, pvzId.String(), repository.PVZUpdate{
+		City: request.City,
+		PVZLocation: repository.PVZLocation{
+			Street:       request.Street,
+			House:        request.House,
+			PostalCode:   request.PostalCode,
+			Latitude:     request.Latitude,
+			Longitude:    request.Longitude,
+			OpeningHours: request.OpeningHours,
+		},
+	})
 	if err != nil {
-		log.Println("Error creating reception:", err)
-		WriteError(w, http.StatusBadRequest, err.Error())
+		slog.WarnContext(ctx, "Error updating PVZ", "error", err)
+		writePVZError(w, err)
 		return
 	}
 
-	log.Println("Reception created")
-	metrics.ReceptionsCreatedTotal.Inc()
-	response := receptionRepositoryToHTTP(rc)
-	writeResponse(w, http.StatusCreated, response)
+	slog.InfoContext(ctx, "PVZ updated")
+	writeResponse(w, http.StatusOK, pvzRepositoryToHTTP(pvz))
 }
 
-// Регистрация пользователя
-// (POST /register)
-func (h *HTTPHandler) PostRegister(w http.ResponseWriter, r *http.Request) {
-	log.Println("Got request in PostRegister")
+// Деактивация ПВЗ с сохранением истории приемок (только для модераторов)
+// (POST /pvz/{pvzId}/deactivate)
+func (h *HTTPHandler) PostPvzPvzIdDeactivate(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostPvzPvzIdDeactivate")
 	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
 
-	var request PostRegisterJSONBody
-	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
-		log.Println("Error decoding request body:", err)
-		WriteError(w, http.StatusBadRequest, "Invalid request body")
+	pvz, err := h.service.DeactivatePVZ(ctx, pvzId.String())
+	if err != nil {
+		slog.WarnContext(ctx, "Error deactivating PVZ", "error", err)
+		writePVZError(w, err)
 		return
 	}
 
-	user, err := h.service.RegisterUser(ctx, string(request.Email), string(request.Password), string(request.Role))
+	slog.InfoContext(ctx, "PVZ deactivated")
+	writeResponse(w, http.StatusOK, pvzRepositoryToHTTP(pvz))
+}
+
+func writePVZError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrPVZNotFound) {
+		WriteError(w, http.StatusNotFound, "PVZ not found")
+		return
+	}
+	WriteError(w, http.StatusBadRequest, err.Error())
+}
+
+// Закрытие последней открытой приемки товаров в рамках ПВЗ
+// (POST /pvz/{pvzId}/close_last_reception)
+func (h *HTTPHandler) PostPvzPvzIdCloseLastReception(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostPvzPvzIdCloseLastReception")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	rc, err := h.service.CloseReception(ctx, pvzId.String(), userIDFromContext(ctx))
+	if errors.Is(err, service.ErrUnacknowledgedDiscrepancies) {
+		slog.WarnContext(ctx, "Reception has unacknowledged discrepancies", "error", err)
+		WriteError(w, http.StatusConflict, err.Error())
+		return
+	}
 	if err != nil {
-		log.Println("Error registering user:", err)
-		WriteError(w, http.StatusBadRequest, err.Error())
+		slog.WarnContext(ctx, "Error closing reception", "error", err)
+		writeEmployeeError(w, err)
 		return
 	}
+	slog.InfoContext(ctx, "Reception closed")
+	response := receptionRepositoryToHTTP(rc)
+	writeResponse(w, http.StatusOK, response)
+}
 
-	log.Println("User registered")
-	response := userRepositoryToHTTP(user)
-	writeResponse(w, http.StatusCreated, response)
+// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+// (POST /pvz/{pvzId}/delete_last_product)
+func (h *HTTPHandler) PostPvzPvzIdDeleteLastProduct(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostPvzPvzIdDeleteLastProduct")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	_, err := h.service.DeleteProduct(ctx, pvzId.String(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error deleting product", "error", err)
+		writeEmployeeError(w, err)
+		return
+	}
+	slog.InfoContext(ctx, "Product deleted")
+}
+
+// writeEmployeeError отвечает 403, если сотрудник не закреплен за ПВЗ, иначе 400
+// writeProductError дополнительно отвечает 409 на повторное сканирование штрихкода
+func writeProductError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrDuplicateBarcode) {
+		WriteError(w, http.StatusConflict, err.Error())
+		return
+	}
+	writeEmployeeError(w, err)
+}
+
+func writeEmployeeError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrEmployeeNotAssigned) {
+		WriteError(w, http.StatusForbidden, err.Error())
+		return
+	}
+	WriteError(w, http.StatusBadRequest, err.Error())
+}
+
+// Получение сотрудников, закрепленных за ПВЗ (только для модераторов)
+// (GET /pvz/{pvzId}/employees)
+func (h *HTTPHandler) GetPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in GetPvzPvzIdEmployees")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	employees, err := h.service.ListPVZEmployees(ctx, pvzId.String())
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing PVZ employees", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to list PVZ employees")
+		return
+	}
+
+	response := make([]*PVZEmployee, len(employees))
+	for i := range employees {
+		response[i] = pvzEmployeeRepositoryToHTTP(employees[i])
+	}
+	slog.InfoContext(ctx, "PVZ employees retrieved")
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Закрепление сотрудника за ПВЗ (только для модераторов)
+// (POST /pvz/{pvzId}/employees)
+func (h *HTTPHandler) PostPvzPvzIdEmployees(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostPvzPvzIdEmployees")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PostPvzPvzIdEmployeesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	employee, err := h.service.AssignEmployee(ctx, pvzId.String(), request.UserId.String())
+	switch {
+	case errors.Is(err, service.ErrPVZNotFound):
+		WriteError(w, http.StatusNotFound, "PVZ not found")
+		return
+	case errors.Is(err, service.ErrUserNotFound):
+		WriteError(w, http.StatusNotFound, "User not found")
+		return
+	case errors.Is(err, service.ErrAssignmentExists):
+		WriteError(w, http.StatusConflict, err.Error())
+		return
+	case errors.Is(err, service.ErrUserNotEmployee), errors.Is(err, service.ErrPVZInactive):
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	case err != nil:
+		slog.ErrorContext(ctx, "Error assigning employee", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to assign employee")
+		return
+	}
+
+	slog.InfoContext(ctx, "Employee assigned")
+	writeResponse(w, http.StatusCreated, pvzEmployeeRepositoryToHTTP(employee))
+}
+
+// Открепление сотрудника от ПВЗ (только для модераторов)
+// (DELETE /pvz/{pvzId}/employees/{userId})
+func (h *HTTPHandler) DeletePvzPvzIdEmployeesUserId(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, userId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in DeletePvzPvzIdEmployeesUserId")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	err := h.service.UnassignEmployee(ctx, pvzId.String(), userId.String())
+	if errors.Is(err, service.ErrAssignmentNotFound) {
+		WriteError(w, http.StatusNotFound, "Employee is not assigned to PVZ")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error unassigning employee", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to unassign employee")
+		return
+	}
+
+	slog.InfoContext(ctx, "Employee unassigned")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// История приемок ПВЗ
+// (GET /pvz/{pvzId}/receptions)
+func (h *HTTPHandler) GetPvzPvzIdReceptions(w http.ResponseWriter, r *http.Request, pvzId openapi_types.UUID, params GetPvzPvzIdReceptionsParams) {
+	slog.DebugContext(r.Context(), "Got request in GetPvzPvzIdReceptions")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	filter := receptionFilterHTTPToRepository(params)
+	if filter.Page <= 0 {
+		WriteError(w, http.StatusBadRequest, "Page must be greater than 0")
+		return
+	}
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		WriteError(w, http.StatusBadRequest, "Limit must be between 1 and 100")
+		return
+	}
+
+	receptions, err := h.service.ListReceptions(ctx, pvzId.String(), filter)
+	if errors.Is(err, service.ErrInvalidReceptionFilter) {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing receptions", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to list receptions")
+		return
+	}
+
+	response := make([]*Reception, len(receptions))
+	for i := range receptions {
+		response[i] = receptionRepositoryToHTTP(receptions[i])
+	}
+	slog.InfoContext(ctx, "Receptions retrieved")
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Создание новой приемки товаров (только для сотрудников ПВЗ)
+// (POST /receptions)
+func (h *HTTPHandler) PostReceptions(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostReceptions")
+	ctx := r.Context()
+
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request Reception
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	rc, err := h.service.CreateReception(ctx, request.PvzId.String(), userIDFromContext(ctx))
+	if errors.Is(err, service.ErrReceptionInProgress) {
+		slog.WarnContext(ctx, "PVZ already has a reception in progress", "error", err)
+		WriteError(w, http.StatusConflict, err.Error())
+		return
+	}
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating reception", "error", err)
+		writeEmployeeError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reception created")
+	metrics.ReceptionsCreatedTotal.Inc()
+	response := receptionRepositoryToHTTP(rc)
+	writeResponse(w, http.StatusCreated, response)
+}
+
+// Получение приемки с товарами
+// (GET /receptions/{receptionId})
+func (h *HTTPHandler) GetReceptionsReceptionId(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in GetReceptionsReceptionId")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	reception, err := h.service.GetReception(ctx, receptionId.String())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting reception", "error", err)
+		writeReceptionError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reception retrieved")
+	writeResponse(w, http.StatusOK, receptionWithProductsRepositoryToHTTP(reception))
+}
+
+// Манифест приемки
+// (GET /receptions/{receptionId}/manifest)
+func (h *HTTPHandler) GetReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in GetReceptionsReceptionIdManifest")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	manifest, err := h.service.GetReceptionManifest(ctx, receptionId.String())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting reception manifest", "error", err)
+		writeReceptionError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reception manifest retrieved")
+	writeResponse(w, http.StatusOK, receptionManifestRepositoryToHTTP(manifest))
+}
+
+// Загрузка манифеста открытой приемки (только для сотрудников ПВЗ)
+// (PUT /receptions/{receptionId}/manifest)
+func (h *HTTPHandler) PutReceptionsReceptionIdManifest(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PutReceptionsReceptionIdManifest")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PutReceptionsReceptionIdManifestJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	blockOnDiscrepancies := request.BlockOnDiscrepancies != nil && *request.BlockOnDiscrepancies
+
+	manifest, err := h.service.SetReceptionManifest(
+		ctx,
+		receptionId.String(),
+		manifestItemsHTTPToRepository(request.Items),
+		blockOnDiscrepancies,
+		userIDFromContext(ctx),
+	)
+	if err != nil {
+		slog.WarnContext(ctx, "Error setting reception manifest", "error", err)
+		writeReceptionError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reception manifest uploaded", "items", len(manifest.Items))
+	writeResponse(w, http.StatusOK, receptionManifestRepositoryToHTTP(manifest))
+}
+
+// Сверка приемки с манифестом
+// (GET /receptions/{receptionId}/reconciliation)
+func (h *HTTPHandler) GetReceptionsReceptionIdReconciliation(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in GetReceptionsReceptionIdReconciliation")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	reconciliation, err := h.service.GetReconciliation(ctx, receptionId.String())
+	if err != nil {
+		slog.WarnContext(ctx, "Error getting reconciliation", "error", err)
+		writeReceptionError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reconciliation retrieved")
+	writeResponse(w, http.StatusOK, reconciliationRepositoryToHTTP(reconciliation))
+}
+
+// Подтверждение текущих расхождений открытой приемки с манифестом (только для сотрудников ПВЗ)
+// (POST /receptions/{receptionId}/reconciliation/acknowledge)
+func (h *HTTPHandler) PostReceptionsReceptionIdReconciliationAcknowledge(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostReceptionsReceptionIdReconciliationAcknowledge")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	reconciliation, err := h.service.AcknowledgeReconciliation(ctx, receptionId.String(), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error acknowledging reconciliation", "error", err)
+		writeReceptionError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reconciliation acknowledged")
+	writeResponse(w, http.StatusOK, reconciliationRepositoryToHTTP(reconciliation))
+}
+
+// Открытие закрытой приемки заново (только для модераторов)
+// (POST /receptions/{receptionId}/reopen)
+func (h *HTTPHandler) PostReceptionsReceptionIdReopen(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostReceptionsReceptionIdReopen")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PostReceptionsReceptionIdReopenJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	rc, err := h.service.ReopenReception(ctx, receptionId.String(), request.Reason, userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error reopening reception", "error", err)
+		writeCorrectionError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reception reopened")
+	writeResponse(w, http.StatusOK, receptionRepositoryToHTTP(rc))
+}
+
+// История открытий и исправлений приемки
+// (GET /receptions/{receptionId}/corrections)
+func (h *HTTPHandler) GetReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in GetReceptionsReceptionIdCorrections")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	corrections, err := h.service.ListReceptionCorrections(ctx, receptionId.String())
+	if err != nil {
+		slog.WarnContext(ctx, "Error listing reception corrections", "error", err)
+		writeReceptionError(w, err)
+		return
+	}
+
+	response := make([]*ReceptionCorrection, len(corrections))
+	for i := range corrections {
+		response[i] = receptionCorrectionRepositoryToHTTP(corrections[i])
+	}
+	slog.InfoContext(ctx, "Reception corrections retrieved")
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Исправление состава закрытой приемки (только для модераторов)
+// (POST /receptions/{receptionId}/corrections)
+func (h *HTTPHandler) PostReceptionsReceptionIdCorrections(w http.ResponseWriter, r *http.Request, receptionId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in PostReceptionsReceptionIdCorrections")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PostReceptionsReceptionIdCorrectionsJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	correction, err := h.service.AmendReception(ctx, receptionId.String(), amendmentHTTPToRepository(request), userIDFromContext(ctx))
+	if err != nil {
+		slog.WarnContext(ctx, "Error amending reception", "error", err)
+		writeCorrectionError(w, err)
+		return
+	}
+
+	slog.InfoContext(ctx, "Reception amended",
+		"added", len(correction.Changes.Added), "removed", len(correction.Changes.Removed))
+	writeResponse(w, http.StatusCreated, receptionCorrectionRepositoryToHTTP(correction))
+}
+
+// writeReceptionError отвечает 404, если приемка, манифест или сверка не найдены
+func writeReceptionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrReceptionNotFound),
+		errors.Is(err, service.ErrManifestNotFound),
+		errors.Is(err, service.ErrReconciliationNotFound):
+		WriteError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, service.ErrReceptionClosed),
+		errors.Is(err, service.ErrInvalidManifest),
+		errors.Is(err, service.ErrEmployeeNotAssigned):
+		writeEmployeeError(w, err)
+	default:
+		WriteError(w, http.StatusInternalServerError, "Internal server error")
+	}
+}
+
+// writeCorrectionError отвечает 409, если приемку нельзя открыть заново или исправить в текущем состоянии
+func writeCorrectionError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, service.ErrReceptionNotClosed),
+		errors.Is(err, service.ErrNewerReceptionExists),
+		errors.Is(err, service.ErrProductNotAmendable),
+		errors.Is(err, service.ErrDuplicateBarcode):
+		WriteError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrInvalidCorrection),
+		errors.Is(err, service.ErrInvalidProductType),
+		errors.Is(err, service.ErrInvalidProductDetails),
+		errors.Is(err, service.ErrPVZInactive):
+		WriteError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeReceptionError(w, err)
+	}
+}
+
+// Регистрация пользователя
+// (POST /register)
+func (h *HTTPHandler) PostRegister(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostRegister")
+	ctx := r.Context()
+
+	var request PostRegisterJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	user, err := h.service.RegisterUser(ctx, string(request.Email), string(request.Password), string(request.Role))
+	if err != nil {
+		slog.WarnContext(ctx, "Error registering user", "error", err)
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	slog.InfoContext(ctx, "User registered")
+	response := userRepositoryToHTTP(user)
+	writeResponse(w, http.StatusCreated, response)
+}
+
+// Отчет по приемкам за период (только для модераторов)
+// (GET /reports/receptions)
+func (h *HTTPHandler) GetReportsReceptions(w http.ResponseWriter, r *http.Request, params GetReportsReceptionsParams) {
+	slog.DebugContext(r.Context(), "Got request in GetReportsReceptions")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	filter := reportFilterHTTPToRepository(params.StartDate, params.EndDate, params.Period, params.GroupBy, params.PvzId, params.City)
+	rows, err := h.service.ReceptionsReport(ctx, filter)
+	if err != nil {
+		slog.WarnContext(ctx, "Error building receptions report", "error", err)
+		writeReportError(w, err)
+		return
+	}
+
+	response := make([]*ReceptionReportRow, len(rows))
+	for i := range rows {
+		response[i] = receptionReportRowRepositoryToHTTP(rows[i])
+	}
+	slog.InfoContext(ctx, "Receptions report built")
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Отчет по принятым товарам за период (только для модераторов)
+// (GET /reports/products)
+func (h *HTTPHandler) GetReportsProducts(w http.ResponseWriter, r *http.Request, params GetReportsProductsParams) {
+	slog.DebugContext(r.Context(), "Got request in GetReportsProducts")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	filter := reportFilterHTTPToRepository(params.StartDate, params.EndDate, params.Period, params.GroupBy, params.PvzId, params.City)
+	filter.Type = params.Type
+	rows, err := h.service.ProductsReport(ctx, filter)
+	if err != nil {
+		slog.WarnContext(ctx, "Error building products report", "error", err)
+		writeReportError(w, err)
+		return
+	}
+
+	response := make([]*ProductReportRow, len(rows))
+	for i := range rows {
+		response[i] = productReportRowRepositoryToHTTP(rows[i])
+	}
+	slog.InfoContext(ctx, "Products report built")
+	writeResponse(w, http.StatusOK, response)
+}
+
+func writeReportError(w http.ResponseWriter, err error) {
+	if errors.Is(err, service.ErrInvalidReportFilter) {
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	WriteError(w, http.StatusInternalServerError, "Failed to build report")
+}
+
+// Выгрузка приемок с товарами в CSV
+// (GET /export/receptions.csv)
+func (h *HTTPHandler) GetExportReceptionsCsv(w http.ResponseWriter, r *http.Request, params GetExportReceptionsCsvParams) {
+	slog.DebugContext(r.Context(), "Got request in GetExportReceptionsCsv")
+	h.exportReceptions(w, r, params.StartDate, params.EndDate, "text/csv; charset=utf-8", "receptions.csv", func(w io.Writer) export.Writer {
+		return export.NewCSVWriter(w)
+	})
+}
+
+// Выгрузка приемок с товарами в XLSX
+// (GET /export/receptions.xlsx)
+func (h *HTTPHandler) GetExportReceptionsXlsx(w http.ResponseWriter, r *http.Request, params GetExportReceptionsXlsxParams) {
+	slog.DebugContext(r.Context(), "Got request in GetExportReceptionsXlsx")
+	h.exportReceptions(w, r, params.StartDate, params.EndDate, xlsxContentType, "receptions.xlsx", func(w io.Writer) export.Writer {
+		return export.NewXLSXWriter(w, "Приемки")
+	})
+}
+
+// exportReceptions пишет строки в ответ по мере чтения из базы. Заголовки ответа отправляются
+// с первой строкой, поэтому ошибку до нее еще можно вернуть как 500, а после - только оборвать файл
+func (h *HTTPHandler) exportReceptions(w http.ResponseWriter, r *http.Request, startDate, endDate *time.Time, contentType, filename string, newWriter func(io.Writer) export.Writer) {
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	writer := newWriter(w)
+	started := false
+	start := func() error {
+		started = true
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="`+filename+`"`)
+		w.WriteHeader(http.StatusOK)
+		return writer.WriteRow(receptionExportHeader)
+	}
+
+	rows := 0
+	err := h.service.ExportReceptions(ctx, startDate, endDate, func(row *repository.ReceptionExportRow) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		rows++
+		return writer.WriteRow(receptionExportRowToRecord(row))
+	})
+	if err == nil && !started {
+		err = start()
+	}
+	if err == nil {
+		err = writer.Close()
+	}
+	if err != nil {
+		if !started {
+			slog.ErrorContext(ctx, "Error exporting receptions", "error", err)
+			WriteError(w, http.StatusInternalServerError, "Failed to export receptions")
+			return
+		}
+		slog.ErrorContext(ctx, "Receptions export interrupted", "error", err, "rows", rows)
+		return
+	}
+
+	slog.InfoContext(ctx, "Receptions exported", "rows", rows)
+}
+
+// Получение списка подписок на вебхуки (только для модераторов)
+// (GET /webhooks)
+func (h *HTTPHandler) GetWebhooks(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in GetWebhooks")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	subscriptions, err := h.service.ListWebhookSubscriptions(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing webhook subscriptions", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to list webhook subscriptions")
+		return
+	}
+
+	response := make([]*WebhookSubscription, len(subscriptions))
+	for i := range subscriptions {
+		response[i] = webhookSubscriptionRepositoryToHTTP(subscriptions[i])
+	}
+	slog.InfoContext(ctx, "Webhook subscriptions retrieved")
+	writeResponse(w, http.StatusOK, response)
+}
+
+// Создание подписки на вебхуки о доменных событиях (только для модераторов)
+// (POST /webhooks)
+func (h *HTTPHandler) PostWebhooks(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostWebhooks")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PostWebhooksJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	var eventTypes []string
+	if request.EventTypes != nil {
+		for _, eventType := range *request.EventTypes {
+			eventTypes = append(eventTypes, string(eventType))
+		}
+	}
+
+	subscription, err := h.service.CreateWebhookSubscription(ctx, request.Url, eventTypes)
+	if errors.Is(err, service.ErrInvalidWebhookSubscription) {
+		slog.WarnContext(ctx, "Invalid webhook subscription", "error", err)
+		WriteError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error creating webhook subscription", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to create webhook subscription")
+		return
+	}
+
+	slog.InfoContext(ctx, "Webhook subscription created")
+	response := webhookSubscriptionRepositoryToHTTP(subscription)
+	response.Secret = &subscription.Secret
+	writeResponse(w, http.StatusCreated, response)
+}
+
+// Удаление подписки на вебхуки (только для модераторов)
+// (DELETE /webhooks/{webhookId})
+func (h *HTTPHandler) DeleteWebhooksWebhookId(w http.ResponseWriter, r *http.Request, webhookId openapi_types.UUID) {
+	slog.DebugContext(r.Context(), "Got request in DeleteWebhooksWebhookId")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	err := h.service.DeleteWebhookSubscription(ctx, webhookId.String())
+	if errors.Is(err, service.ErrWebhookSubscriptionNotFound) {
+		WriteError(w, http.StatusNotFound, "Webhook subscription not found")
+		return
+	}
+	if err != nil {
+		slog.ErrorContext(ctx, "Error deleting webhook subscription", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to delete webhook subscription")
+		return
+	}
+
+	slog.InfoContext(ctx, "Webhook subscription deleted")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Получение справочника городов
+// (GET /cities)
+func (h *HTTPHandler) GetCities(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in GetCities")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	cities, err := h.service.ListCities(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing cities", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to list cities")
+		return
+	}
+
+	slog.InfoContext(ctx, "Cities retrieved")
+	writeResponse(w, http.StatusOK, dictionaryEntriesRepositoryToHTTP(cities))
+}
+
+// Добавление города в справочник (только для модераторов)
+// (POST /cities)
+func (h *HTTPHandler) PostCities(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostCities")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PostCitiesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	entry, err := h.service.CreateCity(ctx, request.Name)
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating city", "error", err)
+		writeDictionaryError(w, err, "Failed to create city")
+		return
+	}
+
+	slog.InfoContext(ctx, "City created")
+	writeResponse(w, http.StatusCreated, dictionaryEntryRepositoryToHTTP(entry))
+}
+
+// Удаление города из справочника (только для модераторов)
+// (DELETE /cities/{name})
+func (h *HTTPHandler) DeleteCitiesName(w http.ResponseWriter, r *http.Request, name string) {
+	slog.DebugContext(r.Context(), "Got request in DeleteCitiesName")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	if err := h.service.DeleteCity(ctx, name); err != nil {
+		slog.WarnContext(ctx, "Error deleting city", "error", err)
+		writeDictionaryError(w, err, "Failed to delete city")
+		return
+	}
+
+	slog.InfoContext(ctx, "City deleted")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Получение справочника типов товаров
+// (GET /product_types)
+func (h *HTTPHandler) GetProductTypes(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in GetProductTypes")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"employee", "moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	productTypes, err := h.service.ListProductTypes(ctx)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing product types", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to list product types")
+		return
+	}
+
+	slog.InfoContext(ctx, "Product types retrieved")
+	writeResponse(w, http.StatusOK, dictionaryEntriesRepositoryToHTTP(productTypes))
+}
+
+// Добавление типа товара в справочник (только для модераторов)
+// (POST /product_types)
+func (h *HTTPHandler) PostProductTypes(w http.ResponseWriter, r *http.Request) {
+	slog.DebugContext(r.Context(), "Got request in PostProductTypes")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	var request PostProductTypesJSONBody
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		slog.WarnContext(ctx, "Error decoding request body", "error", err)
+		WriteError(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	entry, err := h.service.CreateProductType(ctx, request.Name)
+	if err != nil {
+		slog.WarnContext(ctx, "Error creating product type", "error", err)
+		writeDictionaryError(w, err, "Failed to create product type")
+		return
+	}
+
+	slog.InfoContext(ctx, "Product type created")
+	writeResponse(w, http.StatusCreated, dictionaryEntryRepositoryToHTTP(entry))
+}
+
+// Удаление типа товара из справочника (только для модераторов)
+// (DELETE /product_types/{name})
+func (h *HTTPHandler) DeleteProductTypesName(w http.ResponseWriter, r *http.Request, name string) {
+	slog.DebugContext(r.Context(), "Got request in DeleteProductTypesName")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	if err := h.service.DeleteProductType(ctx, name); err != nil {
+		slog.WarnContext(ctx, "Error deleting product type", "error", err)
+		writeDictionaryError(w, err, "Failed to delete product type")
+		return
+	}
+
+	slog.InfoContext(ctx, "Product type deleted")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeDictionaryError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, service.ErrInvalidDictionaryEntry):
+		WriteError(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, service.ErrDictionaryEntryExists):
+		WriteError(w, http.StatusConflict, err.Error())
+	case errors.Is(err, service.ErrDictionaryEntryNotFound):
+		WriteError(w, http.StatusNotFound, err.Error())
+	default:
+		WriteError(w, http.StatusInternalServerError, message)
+	}
+}
+
+// Получение журнала аудита изменений (только для модераторов)
+// (GET /audit)
+func (h *HTTPHandler) GetAudit(w http.ResponseWriter, r *http.Request, params GetAuditParams) {
+	slog.DebugContext(r.Context(), "Got request in GetAudit")
+	ctx := r.Context()
+	if !validateRole(ctx, w, []string{"moderator"}) {
+		slog.WarnContext(ctx, "Unauthorized")
+		return
+	}
+
+	filter := repository.AuditFilter{
+		EntityType: params.EntityType,
+		EntityID:   params.EntityId,
+		ActorID:    params.ActorId,
+		From:       params.From,
+		To:         params.To,
+		Page:       1,
+		Limit:      20,
+	}
+	if params.Page != nil {
+		filter.Page = *params.Page
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+
+	if filter.Page <= 0 {
+		WriteError(w, http.StatusBadRequest, "Page must be greater than 0")
+		return
+	}
+	if filter.Limit <= 0 || filter.Limit > 100 {
+		WriteError(w, http.StatusBadRequest, "Limit must be between 1 and 100")
+		return
+	}
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		WriteError(w, http.StatusBadRequest, "From must be before to")
+		return
+	}
+
+	records, err := h.service.ListAuditRecords(ctx, filter)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error listing audit records", "error", err)
+		WriteError(w, http.StatusInternalServerError, "Failed to list audit records")
+		return
+	}
+
+	response := make([]*AuditRecord, len(records))
+	for i := range records {
+		response[i] = auditRecordRepositoryToHTTP(records[i])
+	}
+	slog.InfoContext(ctx, "Audit records retrieved")
+	writeResponse(w, http.StatusOK, response)
 }
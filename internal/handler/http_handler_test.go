@@ -1,6 +1,7 @@
 package handler
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -13,8 +14,10 @@ import (
 
 	"github.com/DarRo9/pvz_service/internal/repository"
 	"github.com/DarRo9/pvz_service/internal/service"
+	"github.com/DarRo9/pvz_service/internal/utils"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -28,12 +31,12 @@ func (m *MockService) IsValidRole(role service.UserRole) bool {
 	return args.Bool(0)
 }
 
-func (s *MockService) IsValidCity(city string) bool {
-	return true
+func (s *MockService) IsValidCity(ctx context.Context, city string) (bool, error) {
+	return true, nil
 }
 
-func (s *MockService) IsValidProductType(city string) bool {
-	return true
+func (s *MockService) IsValidProductType(ctx context.Context, city string) (bool, error) {
+	return true, nil
 }
 
 func (m *MockService) GetUserByEmail(ctx context.Context, email string) (*repository.User, error) {
@@ -46,33 +49,196 @@ func (m *MockService) CheckPassword(ctx context.Context, user *repository.User,
 	return args.Error(0)
 }
 
-func (m *MockService) CreateProduct(ctx context.Context, receptionID, productType string) (*repository.Product, error) {
-	args := m.Called(ctx, receptionID, productType)
+func (m *MockService) CreateProduct(ctx context.Context, pvzID string, product repository.NewProduct, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, pvzID, product, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*repository.Product), args.Error(1)
 }
 
-func (m *MockService) ListPVZ(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*repository.PVZWithReceptions, error) {
-	args := m.Called(ctx, startDate, endDate, page, limit)
+func (m *MockService) CreateProducts(ctx context.Context, pvzID string, products []repository.NewProduct, userID string) ([]*service.ProductBatchResult, error) {
+	args := m.Called(ctx, pvzID, products, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*service.ProductBatchResult), args.Error(1)
+}
+
+func (m *MockService) FindProductsByBarcode(ctx context.Context, barcode string) ([]*repository.Product, error) {
+	args := m.Called(ctx, barcode)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.Product), args.Error(1)
+}
+
+func (m *MockService) ListPVZ(ctx context.Context, filter repository.PVZFilter, page, limit int) ([]*repository.PVZWithReceptions, error) {
+	args := m.Called(ctx, filter, page, limit)
 	return args.Get(0).([]*repository.PVZWithReceptions), args.Error(1)
 }
 
-func (m *MockService) CreatePVZ(ctx context.Context, city string) (*repository.PVZ, error) {
-	args := m.Called(ctx, city)
+func (m *MockService) ListPVZByCursor(ctx context.Context, filter repository.PVZFilter, cursor string, limit int) ([]*repository.PVZWithReceptions, string, error) {
+	args := m.Called(ctx, filter, cursor, limit)
+	if args.Get(0) == nil {
+		return nil, args.String(1), args.Error(2)
+	}
+	return args.Get(0).([]*repository.PVZWithReceptions), args.String(1), args.Error(2)
+}
+
+func (m *MockService) CreatePVZ(ctx context.Context, city string, location repository.PVZLocation) (*repository.PVZ, error) {
+	args := m.Called(ctx, city, location)
+	return args.Get(0).(*repository.PVZ), args.Error(1)
+}
+
+func (m *MockService) GetPVZ(ctx context.Context, pvzId string) (*repository.PVZWithReceptions, error) {
+	args := m.Called(ctx, pvzId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PVZWithReceptions), args.Error(1)
+}
+
+func (m *MockService) UpdatePVZ(ctx context.Context, pvzId string, update repository.PVZUpdate) (*repository.PVZ, error) {
+	args := m.Called(ctx, pvzId, update)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PVZ), args.Error(1)
+}
+
+func (m *MockService) ListNearbyPVZ(ctx context.Context, lat, lon, radius float64, limit int) ([]*repository.NearbyPVZ, error) {
+	args := m.Called(ctx, lat, lon, radius, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.NearbyPVZ), args.Error(1)
+}
+
+func (m *MockService) DeactivatePVZ(ctx context.Context, pvzId string) (*repository.PVZ, error) {
+	args := m.Called(ctx, pvzId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*repository.PVZ), args.Error(1)
 }
 
-func (m *MockService) CloseReception(ctx context.Context, pvzID string) (*repository.Reception, error) {
-	args := m.Called(ctx, pvzID)
+func (m *MockService) CloseReception(ctx context.Context, pvzID, userID string) (*repository.Reception, error) {
+	args := m.Called(ctx, pvzID, userID)
+	return args.Get(0).(*repository.Reception), args.Error(1)
+}
+
+func (m *MockService) ReceptionsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ReceptionReportRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ReceptionReportRow), args.Error(1)
+}
+
+func (m *MockService) ProductsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ProductReportRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ProductReportRow), args.Error(1)
+}
+
+// ExportReceptions передает в fn строки, заданные в Return, и возвращает заданную ошибку
+func (m *MockService) ExportReceptions(ctx context.Context, startDate, endDate *time.Time, fn func(*repository.ReceptionExportRow) error) error {
+	args := m.Called(ctx, startDate, endDate)
+	if rows, ok := args.Get(0).([]*repository.ReceptionExportRow); ok {
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockService) SetReceptionManifest(ctx context.Context, receptionID string, items []*repository.ManifestItem, blockOnDiscrepancies bool, userID string) (*repository.ReceptionManifest, error) {
+	args := m.Called(ctx, receptionID, items, blockOnDiscrepancies, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionManifest), args.Error(1)
+}
+
+func (m *MockService) ListReceptions(ctx context.Context, pvzID string, filter repository.ReceptionFilter) ([]*repository.Reception, error) {
+	args := m.Called(ctx, pvzID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.Reception), args.Error(1)
+}
+
+func (m *MockService) GetReception(ctx context.Context, receptionID string) (*repository.ReceptionWithProducts, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionWithProducts), args.Error(1)
+}
+
+func (m *MockService) ReopenReception(ctx context.Context, receptionID, reason, userID string) (*repository.Reception, error) {
+	args := m.Called(ctx, receptionID, reason, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*repository.Reception), args.Error(1)
 }
 
-func (m *MockService) DeleteProduct(ctx context.Context, pvzID string) (*repository.Product, error) {
-	args := m.Called(ctx, pvzID)
+func (m *MockService) AmendReception(ctx context.Context, receptionID string, amendment repository.ReceptionAmendment, userID string) (*repository.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionID, amendment, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionCorrection), args.Error(1)
+}
+
+func (m *MockService) ListReceptionCorrections(ctx context.Context, receptionID string) ([]*repository.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ReceptionCorrection), args.Error(1)
+}
+
+func (m *MockService) GetReceptionManifest(ctx context.Context, receptionID string) (*repository.ReceptionManifest, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionManifest), args.Error(1)
+}
+
+func (m *MockService) GetReconciliation(ctx context.Context, receptionID string) (*repository.Reconciliation, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reconciliation), args.Error(1)
+}
+
+func (m *MockService) AcknowledgeReconciliation(ctx context.Context, receptionID, userID string) (*repository.Reconciliation, error) {
+	args := m.Called(ctx, receptionID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reconciliation), args.Error(1)
+}
+
+func (m *MockService) DeleteProduct(ctx context.Context, pvzID, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, pvzID, userID)
 	return args.Get(0).(*repository.Product), args.Error(1)
 }
 
-func (m *MockService) CreateReception(ctx context.Context, pvzID string) (*repository.Reception, error) {
-	args := m.Called(ctx, pvzID)
+func (m *MockService) CreateReception(ctx context.Context, pvzID, userID string) (*repository.Reception, error) {
+	args := m.Called(ctx, pvzID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*repository.Reception), args.Error(1)
 }
 
@@ -85,13 +251,145 @@ func (m *MockService) ListAllPVZ(ctx context.Context) ([]*repository.PVZ, error)
 	return nil, nil
 }
 
+func (m *MockService) IssueTokens(ctx context.Context, user *repository.User) (*service.TokenPair, error) {
+	args := m.Called(ctx, user)
+	return args.Get(0).(*service.TokenPair), args.Error(1)
+}
+
+func (m *MockService) RefreshTokens(ctx context.Context, refreshToken string) (*service.TokenPair, error) {
+	args := m.Called(ctx, refreshToken)
+	return args.Get(0).(*service.TokenPair), args.Error(1)
+}
+
+func (m *MockService) Logout(ctx context.Context, userID, jti string, expiresAt time.Time, refreshToken string) error {
+	args := m.Called(ctx, userID, jti, expiresAt, refreshToken)
+	return args.Error(0)
+}
+
+func (m *MockService) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockService) IssueProduct(ctx context.Context, productID, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, productID, userID)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockService) ReturnProduct(ctx context.Context, productID, userID string) (*repository.Product, error) {
+	args := m.Called(ctx, productID, userID)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockService) GetProductHistory(ctx context.Context, productID string) ([]*repository.ProductStatusChange, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).([]*repository.ProductStatusChange), args.Error(1)
+}
+
+func (m *MockService) CreateWebhookSubscription(ctx context.Context, webhookURL string, eventTypes []string) (*repository.WebhookSubscription, error) {
+	args := m.Called(ctx, webhookURL, eventTypes)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockService) ListWebhookSubscriptions(ctx context.Context) ([]*repository.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockService) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockService) ListCities(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) CreateCity(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) DeleteCity(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockService) ListProductTypes(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) CreateProductType(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockService) DeleteProductType(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockService) ListPVZEmployees(ctx context.Context, pvzId string) ([]*repository.PVZEmployee, error) {
+	args := m.Called(ctx, pvzId)
+	return args.Get(0).([]*repository.PVZEmployee), args.Error(1)
+}
+
+func (m *MockService) AssignEmployee(ctx context.Context, pvzId string, userId string) (*repository.PVZEmployee, error) {
+	args := m.Called(ctx, pvzId, userId)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.PVZEmployee), args.Error(1)
+}
+
+func (m *MockService) UnassignEmployee(ctx context.Context, pvzId string, userId string) error {
+	args := m.Called(ctx, pvzId, userId)
+	return args.Error(0)
+}
+
+func (m *MockService) ListAuditRecords(ctx context.Context, filter repository.AuditFilter) ([]*repository.AuditRecord, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*repository.AuditRecord), args.Error(1)
+}
+
+func (m *MockService) BeginIdempotentRequest(ctx context.Context, userID, key, requestHash string) (*repository.IdempotencyRecord, error) {
+	args := m.Called(ctx, userID, key, requestHash)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.IdempotencyRecord), args.Error(1)
+}
+
+func (m *MockService) CompleteIdempotentRequest(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error {
+	args := m.Called(ctx, userID, key, statusCode, contentType, body)
+	return args.Error(0)
+}
+
+func (m *MockService) ReleaseIdempotentRequest(ctx context.Context, userID, key string) error {
+	args := m.Called(ctx, userID, key)
+	return args.Error(0)
+}
+
 func TestHTTPHandler_PostDummyLogin(t *testing.T) {
+	pvzID := openapi_types.UUID(uuid.MustParse("3fa85f64-5717-4562-b3fc-2c963f66afa6"))
 	tests := []struct {
 		name           string
 		requestBody    PostDummyLoginJSONBody
 		mockSetup      func(*MockService)
 		expectedStatus int
 		expectToken    bool
+		expectedPVZ    string
 	}{
 		{
 			name: "successful login with valid role",
@@ -104,6 +402,30 @@ func TestHTTPHandler_PostDummyLogin(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			expectToken:    true,
 		},
+		{
+			name: "employee assigned to pvz",
+			requestBody: PostDummyLoginJSONBody{
+				Role:  "employee",
+				PvzId: &pvzID,
+			},
+			mockSetup: func(ms *MockService) {
+				ms.On("IsValidRole", service.UserRole("employee")).Return(true)
+			},
+			expectedStatus: http.StatusOK,
+			expectToken:    true,
+			expectedPVZ:    pvzID.String(),
+		},
+		{
+			name: "pvz for moderator",
+			requestBody: PostDummyLoginJSONBody{
+				Role:  "moderator",
+				PvzId: &pvzID,
+			},
+			mockSetup: func(ms *MockService) {
+				ms.On("IsValidRole", service.UserRole("moderator")).Return(true)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "invalid role",
 			requestBody: PostDummyLoginJSONBody{
@@ -137,6 +459,15 @@ func TestHTTPHandler_PostDummyLogin(t *testing.T) {
 				err := json.NewDecoder(resp.Body).Decode(&tokenResp)
 				assert.NoError(t, err)
 				assert.NotEmpty(t, tokenResp)
+
+				claims, err := utils.ParseJWT(tokenResp)
+				assert.NoError(t, err)
+				assert.Equal(t, utils.DummyUserID, claims["user_id"])
+				if tt.expectedPVZ == "" {
+					assert.NotContains(t, claims, "pvz_id")
+				} else {
+					assert.Equal(t, tt.expectedPVZ, claims["pvz_id"])
+				}
 			}
 
 			mockService.AssertExpectations(t)
@@ -206,10 +537,10 @@ func TestHTTPHandler_PostLogin(t *testing.T) {
 			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
 
 			if tt.expectToken {
-				var tokenResp Token
-				err := json.NewDecoder(resp.Body).Decode(&tokenResp)
+				var token Token
+				err := json.NewDecoder(resp.Body).Decode(&token)
 				assert.NoError(t, err)
-				assert.NotEmpty(t, tokenResp)
+				assert.NotEmpty(t, token)
 			}
 
 			mockService.AssertExpectations(t)
@@ -217,41 +548,37 @@ func TestHTTPHandler_PostLogin(t *testing.T) {
 	}
 }
 
-func TestHTTPHandler_PostProducts(t *testing.T) {
-	UUID := uuid.New()
+func TestHTTPHandler_PostToken(t *testing.T) {
+	user := &repository.User{
+		ID:    "user123",
+		Email: "test@example.com",
+		Role:  "employee",
+	}
 	tests := []struct {
 		name           string
-		requestBody    Product
+		password       string
 		mockSetup      func(*MockService)
 		expectedStatus int
-		withAuth       bool
 	}{
 		{
-			name: "successful product creation",
-			requestBody: Product{
-				ReceptionId: UUID,
-				Type:        "electronics",
-			},
+			name:     "successful login",
+			password: "password123",
 			mockSetup: func(ms *MockService) {
-				product := &repository.Product{
-					ID:          "product123",
-					ReceptionId: UUID.String(),
-					Type:        "electronics",
-				}
-				ms.On("CreateProduct", mock.Anything, UUID.String(), "electronics").Return(product, nil)
+				ms.On("GetUserByEmail", mock.Anything, "test@example.com").Return(user, nil)
+				ms.On("CheckPassword", mock.Anything, user, "password123").Return(nil)
+				ms.On("IssueTokens", mock.Anything, user).
+					Return(&service.TokenPair{AccessToken: "access", RefreshToken: "refresh"}, nil)
 			},
-			expectedStatus: http.StatusCreated,
-			withAuth:       true,
+			expectedStatus: http.StatusOK,
 		},
 		{
-			name: "unauthorized access",
-			requestBody: Product{
-				ReceptionId: UUID,
-				Type:        "electronics",
+			name:     "invalid password",
+			password: "wrong",
+			mockSetup: func(ms *MockService) {
+				ms.On("GetUserByEmail", mock.Anything, "test@example.com").Return(user, nil)
+				ms.On("CheckPassword", mock.Anything, user, "wrong").Return(errors.New("invalid password"))
 			},
-			mockSetup:      func(ms *MockService) {},
 			expectedStatus: http.StatusUnauthorized,
-			withAuth:       false,
 		},
 	}
 
@@ -261,26 +588,21 @@ func TestHTTPHandler_PostProducts(t *testing.T) {
 			tt.mockSetup(mockService)
 			handler := NewHTTPHandler(mockService)
 
-			body, _ := json.Marshal(tt.requestBody)
-			req := httptest.NewRequest("POST", "/products", bytes.NewBuffer(body))
+			body, _ := json.Marshal(PostTokenJSONBody{Email: "test@example.com", Password: tt.password})
+			req := httptest.NewRequest("POST", "/token", bytes.NewBuffer(body))
 			w := httptest.NewRecorder()
 
-			if tt.withAuth {
-				claims := jwt.MapClaims{"role": "employee"}
-				ctx := context.WithValue(req.Context(), "user", claims)
-				req = req.WithContext(ctx)
-			}
-
-			handler.PostProducts(w, req)
+			handler.PostToken(w, req)
 
 			resp := w.Result()
 			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
 
-			if tt.expectedStatus == http.StatusCreated {
-				var productResp Product
-				err := json.NewDecoder(resp.Body).Decode(&productResp)
+			if tt.expectedStatus == http.StatusOK {
+				var tokenResp TokenPair
+				err := json.NewDecoder(resp.Body).Decode(&tokenResp)
 				assert.NoError(t, err)
-				assert.Equal(t, tt.requestBody.Type, productResp.Type)
+				assert.Equal(t, "access", tokenResp.Token)
+				assert.Equal(t, "refresh", tokenResp.RefreshToken)
 			}
 
 			mockService.AssertExpectations(t)
@@ -288,48 +610,36 @@ func TestHTTPHandler_PostProducts(t *testing.T) {
 	}
 }
 
-func TestHTTPHandler_GetPvz(t *testing.T) {
-	page_1 := 1
-	limit_10 := 10
-
-	page_0 := 0
+func TestHTTPHandler_PostTokenRefresh(t *testing.T) {
 	tests := []struct {
 		name           string
-		params         GetPvzParams
+		requestBody    PostTokenRefreshJSONBody
 		mockSetup      func(*MockService)
 		expectedStatus int
-		withAuth       bool
 	}{
 		{
-			name: "successful list with pagination",
-			params: GetPvzParams{
-				Page:  &page_1,
-				Limit: &limit_10,
-			},
+			name:        "successful refresh",
+			requestBody: PostTokenRefreshJSONBody{RefreshToken: "refresh"},
 			mockSetup: func(ms *MockService) {
-				pvzs := []*repository.PVZWithReceptions{
-					{
-						PVZ: &repository.PVZ{
-							ID:   "pvz1",
-							City: "Moscow",
-						},
-						Receptions: []*repository.ReceptionWithProducts{},
-					},
-				}
-				ms.On("ListPVZ", mock.Anything, (*time.Time)(nil), (*time.Time)(nil), 1, 10).Return(pvzs, nil)
+				ms.On("RefreshTokens", mock.Anything, "refresh").
+					Return(&service.TokenPair{AccessToken: "access", RefreshToken: "refresh2"}, nil)
 			},
 			expectedStatus: http.StatusOK,
-			withAuth:       true,
 		},
 		{
-			name: "invalid page parameter",
-			params: GetPvzParams{
-				Page:  &page_0,
-				Limit: &limit_10,
+			name:        "invalid refresh token",
+			requestBody: PostTokenRefreshJSONBody{RefreshToken: "refresh"},
+			mockSetup: func(ms *MockService) {
+				ms.On("RefreshTokens", mock.Anything, "refresh").
+					Return((*service.TokenPair)(nil), service.ErrInvalidRefreshToken)
 			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "missing refresh token",
+			requestBody:    PostTokenRefreshJSONBody{},
 			mockSetup:      func(ms *MockService) {},
 			expectedStatus: http.StatusBadRequest,
-			withAuth:       true,
 		},
 	}
 
@@ -339,35 +649,20 @@ func TestHTTPHandler_GetPvz(t *testing.T) {
 			tt.mockSetup(mockService)
 			handler := NewHTTPHandler(mockService)
 
-			req := httptest.NewRequest("GET", "/pvz", nil)
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/token/refresh", bytes.NewBuffer(body))
 			w := httptest.NewRecorder()
 
-			// Add query parameters
-			q := req.URL.Query()
-			if tt.params.Page != nil {
-				q.Add("page", fmt.Sprintf("%d", *tt.params.Page))
-			}
-			if tt.params.Limit != nil {
-				q.Add("limit", fmt.Sprintf("%d", *tt.params.Limit))
-			}
-			req.URL.RawQuery = q.Encode()
-
-			if tt.withAuth {
-				claims := jwt.MapClaims{"role": "employee"}
-				ctx := context.WithValue(req.Context(), "user", claims)
-				req = req.WithContext(ctx)
-			}
-
-			handler.GetPvz(w, req, tt.params)
+			handler.PostTokenRefresh(w, req)
 
 			resp := w.Result()
 			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
 
 			if tt.expectedStatus == http.StatusOK {
-				var pvzs []*PVZWithReceptions
-				err := json.NewDecoder(resp.Body).Decode(&pvzs)
+				var tokenResp TokenPair
+				err := json.NewDecoder(resp.Body).Decode(&tokenResp)
 				assert.NoError(t, err)
-				assert.NotEmpty(t, pvzs)
+				assert.Equal(t, "refresh2", tokenResp.RefreshToken)
 			}
 
 			mockService.AssertExpectations(t)
@@ -375,38 +670,40 @@ func TestHTTPHandler_GetPvz(t *testing.T) {
 	}
 }
 
-func TestHTTPHandler_PostPvz(t *testing.T) {
+func TestHTTPHandler_PostLogout(t *testing.T) {
+	expiresAt := time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+	refreshToken := "refresh"
+
 	tests := []struct {
 		name           string
-		requestBody    PVZ
+		requestBody    *PostLogoutJSONBody
 		mockSetup      func(*MockService)
 		expectedStatus int
 		withAuth       bool
 	}{
 		{
-			name: "successful PVZ creation",
-			requestBody: PVZ{
-				City: "Moscow",
-			},
+			name:        "logout with refresh token",
+			requestBody: &PostLogoutJSONBody{RefreshToken: &refreshToken},
 			mockSetup: func(ms *MockService) {
-				pvz := &repository.PVZ{
-					ID:   "pvz123",
-					City: "Moscow",
-				}
-				ms.On("CreatePVZ", mock.Anything, "Moscow").Return(pvz, nil)
+				ms.On("Logout", mock.Anything, "user123", "jti123", expiresAt, "refresh").Return(nil)
 			},
-			expectedStatus: http.StatusCreated,
+			expectedStatus: http.StatusNoContent,
 			withAuth:       true,
 		},
 		{
-			name: "forbidden for non-moderator",
-			requestBody: PVZ{
-				City: "Moscow",
+			name: "logout without body",
+			mockSetup: func(ms *MockService) {
+				ms.On("Logout", mock.Anything, "user123", "jti123", expiresAt, "").Return(nil)
 			},
-			mockSetup:      func(ms *MockService) {},
-			expectedStatus: http.StatusForbidden,
+			expectedStatus: http.StatusNoContent,
 			withAuth:       true,
 		},
+		{
+			name:           "unauthorized access",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusUnauthorized,
+			withAuth:       false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -415,60 +712,96 @@ func TestHTTPHandler_PostPvz(t *testing.T) {
 			tt.mockSetup(mockService)
 			handler := NewHTTPHandler(mockService)
 
-			body, _ := json.Marshal(tt.requestBody)
-			req := httptest.NewRequest("POST", "/pvz", bytes.NewBuffer(body))
+			var body []byte
+			if tt.requestBody != nil {
+				body, _ = json.Marshal(tt.requestBody)
+			}
+			req := httptest.NewRequest("POST", "/logout", bytes.NewBuffer(body))
 			w := httptest.NewRecorder()
 
 			if tt.withAuth {
-				role := "employee"
-				if tt.expectedStatus == http.StatusCreated {
-					role = "moderator"
-				}
-				claims := jwt.MapClaims{"role": role}
+				claims := jwt.MapClaims{"role": "employee", "user_id": "user123", "jti": "jti123", "exp": float64(expiresAt.Unix())}
 				ctx := context.WithValue(req.Context(), "user", claims)
 				req = req.WithContext(ctx)
 			}
 
-			handler.PostPvz(w, req)
+			handler.PostLogout(w, req)
 
 			resp := w.Result()
 			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
 
-			if tt.expectedStatus == http.StatusCreated {
-				var pvzResp PVZ
-				err := json.NewDecoder(resp.Body).Decode(&pvzResp)
-				assert.NoError(t, err)
-				assert.Equal(t, tt.requestBody.City, pvzResp.City)
-			}
-
 			mockService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestHTTPHandler_PostRegister(t *testing.T) {
+func TestHTTPHandler_PostProducts(t *testing.T) {
+	UUID := uuid.New()
+	barcode := "4601234567890"
 	tests := []struct {
 		name           string
-		requestBody    PostRegisterJSONBody
+		requestBody    PostProductsJSONBody
 		mockSetup      func(*MockService)
 		expectedStatus int
+		withAuth       bool
 	}{
 		{
-			name: "successful registration",
-			requestBody: PostRegisterJSONBody{
-				Email:    "new@example.com",
-				Password: "password123",
-				Role:     "employee",
+			name: "successful product creation",
+			requestBody: PostProductsJSONBody{
+				PvzId: UUID,
+				Type:  "electronics",
 			},
 			mockSetup: func(ms *MockService) {
-				user := &repository.User{
-					ID:    "user123",
-					Email: "new@example.com",
-					Role:  "employee",
+				product := &repository.Product{
+					ID:          "product123",
+					ReceptionId: uuid.New().String(),
+					Type:        "electronics",
 				}
-				ms.On("RegisterUser", mock.Anything, "new@example.com", "password123", "employee").Return(user, nil)
+				ms.On("CreateProduct", mock.Anything, UUID.String(), repository.NewProduct{Type: "electronics"}, "user123").Return(product, nil)
 			},
 			expectedStatus: http.StatusCreated,
+			withAuth:       true,
+		},
+		{
+			name: "employee not assigned to pvz",
+			requestBody: PostProductsJSONBody{
+				PvzId: UUID,
+				Type:  "electronics",
+			},
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProduct", mock.Anything, UUID.String(), repository.NewProduct{Type: "electronics"}, "user123").
+					Return(nil, fmt.Errorf("%w: %s", service.ErrEmployeeNotAssigned, UUID))
+			},
+			expectedStatus: http.StatusForbidden,
+			withAuth:       true,
+		},
+		{
+			name: "barcode already scanned",
+			requestBody: PostProductsJSONBody{
+				PvzId:   UUID,
+				Type:    "electronics",
+				Barcode: &barcode,
+			},
+			mockSetup: func(ms *MockService) {
+				product := repository.NewProduct{
+					Type:           "electronics",
+					ProductDetails: repository.ProductDetails{Barcode: &barcode},
+				}
+				ms.On("CreateProduct", mock.Anything, UUID.String(), product, "user123").
+					Return(nil, fmt.Errorf("%w: %s", service.ErrDuplicateBarcode, barcode))
+			},
+			expectedStatus: http.StatusConflict,
+			withAuth:       true,
+		},
+		{
+			name: "unauthorized access",
+			requestBody: PostProductsJSONBody{
+				PvzId: UUID,
+				Type:  "electronics",
+			},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusUnauthorized,
+			withAuth:       false,
 		},
 	}
 
@@ -479,69 +812,2186 @@ func TestHTTPHandler_PostRegister(t *testing.T) {
 			handler := NewHTTPHandler(mockService)
 
 			body, _ := json.Marshal(tt.requestBody)
-			req := httptest.NewRequest("POST", "/register", bytes.NewBuffer(body))
+			req := httptest.NewRequest("POST", "/products", bytes.NewBuffer(body))
 			w := httptest.NewRecorder()
 
-			handler.PostRegister(w, req)
+			if tt.withAuth {
+				claims := jwt.MapClaims{"role": "employee", "user_id": "user123"}
+				ctx := context.WithValue(req.Context(), "user", claims)
+				req = req.WithContext(ctx)
+			}
+
+			handler.PostProducts(w, req)
 
 			resp := w.Result()
 			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
 
-			var userResp User
-			err := json.NewDecoder(resp.Body).Decode(&userResp)
-			assert.NoError(t, err)
-			assert.Equal(t, tt.requestBody.Email, userResp.Email)
+			if tt.expectedStatus == http.StatusCreated {
+				var productResp Product
+				err := json.NewDecoder(resp.Body).Decode(&productResp)
+				assert.NoError(t, err)
+				assert.Equal(t, string(tt.requestBody.Type), string(productResp.Type))
+			}
 
 			mockService.AssertExpectations(t)
 		})
 	}
 }
 
-func TestValidateRole(t *testing.T) {
+func TestHTTPHandler_GetProducts(t *testing.T) {
+	barcode := "4601234567890"
+	weight := 1200
 	tests := []struct {
 		name           string
-		ctx            context.Context
-		allowedRoles   []string
-		expectedResult bool
+		role           string
+		mockSetup      func(*MockService)
 		expectedStatus int
 	}{
 		{
-			name: "valid role",
-			ctx: context.WithValue(context.Background(), "user", jwt.MapClaims{
-				"role": "employee",
-			}),
-			allowedRoles:   []string{"employee", "moderator"},
-			expectedResult: true,
-			expectedStatus: 0,
+			name: "products found",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				products := []*repository.Product{{
+					ID:          uuid.New().String(),
+					ReceptionId: uuid.New().String(),
+					Type:        "обувь",
+					ProductDetails: repository.ProductDetails{
+						Barcode:     &barcode,
+						WeightGrams: &weight,
+						Attributes:  repository.ProductAttributes{"order": "A-15"},
+					},
+				}}
+				ms.On("FindProductsByBarcode", mock.Anything, barcode).Return(products, nil)
+			},
+			expectedStatus: http.StatusOK,
 		},
 		{
-			name: "invalid role",
-			ctx: context.WithValue(context.Background(), "user", jwt.MapClaims{
-				"role": "guest",
-			}),
-			allowedRoles:   []string{"employee", "moderator"},
-			expectedResult: false,
+			name: "repository error",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("FindProductsByBarcode", mock.Anything, barcode).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+		{
+			name:           "unknown role",
+			role:           "client",
+			mockSetup:      func(ms *MockService) {},
 			expectedStatus: http.StatusForbidden,
 		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/products?barcode="+barcode, nil)
+			claims := jwt.MapClaims{"role": tt.role, "user_id": "user123"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetProducts(w, req, GetProductsParams{Barcode: barcode})
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response []Product
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				assert.Len(t, response, 1)
+				assert.Equal(t, barcode, *response[0].Barcode)
+				assert.Equal(t, weight, *response[0].WeightGrams)
+				assert.Equal(t, map[string]string{"order": "A-15"}, *response[0].Attributes)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostProductsBatch(t *testing.T) {
+	UUID := uuid.New()
+	body := `{"pvzId":"` + UUID.String() + `","products":[{"type":"обувь"},{"type":"еда"}]}`
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
 		{
-			name:           "no user in context",
-			ctx:            context.Background(),
-			allowedRoles:   []string{"employee"},
-			expectedResult: false,
-			expectedStatus: http.StatusUnauthorized,
+			name: "partially added batch",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				results := []*service.ProductBatchResult{
+					{Product: &repository.Product{ID: uuid.New().String(), ReceptionId: uuid.New().String(), Type: "обувь"}},
+					{Err: errors.New("invalid product type: еда")},
+				}
+				ms.On("CreateProducts", mock.Anything, UUID.String(), []repository.NewProduct{{Type: "обувь"}, {Type: "еда"}}, "user123").Return(results, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "no open reception",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProducts", mock.Anything, UUID.String(), []repository.NewProduct{{Type: "обувь"}, {Type: "еда"}}, "user123").
+					Return(nil, errors.New("no receptions found"))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "employee not assigned to pvz",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateProducts", mock.Anything, UUID.String(), []repository.NewProduct{{Type: "обувь"}, {Type: "еда"}}, "user123").
+					Return(nil, fmt.Errorf("%w: %s", service.ErrEmployeeNotAssigned, UUID))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "moderator is forbidden",
+			role:           "moderator",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("POST", "/products/batch", bytes.NewBufferString(body))
+			claims := jwt.MapClaims{"role": tt.role, "user_id": "user123"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
 			w := httptest.NewRecorder()
-			result := validateRole(tt.ctx, w, tt.allowedRoles)
-			assert.Equal(t, tt.expectedResult, result)
 
-			if tt.expectedStatus != 0 {
-				resp := w.Result()
-				assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			handler.PostProductsBatch(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var response []ProductBatchResult
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&response))
+				assert.Len(t, response, 2)
+				assert.Equal(t, "обувь", response[0].Product.Type)
+				assert.Nil(t, response[0].Error)
+				assert.Equal(t, 1, response[1].Index)
+				assert.Equal(t, "invalid product type: еда", *response[1].Error)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostProductsProductIdIssue(t *testing.T) {
+	productID := uuid.New()
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful issue",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				product := &repository.Product{
+					ID:          productID.String(),
+					ReceptionId: uuid.New().String(),
+					Type:        "обувь",
+					Status:      "issued",
+				}
+				ms.On("IssueProduct", mock.Anything, productID.String(), "user123").Return(product, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "product not stored",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("IssueProduct", mock.Anything, productID.String(), "user123").
+					Return((*repository.Product)(nil), fmt.Errorf("%w: accepted -> issued", service.ErrInvalidProductStatusTransition))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "product not found",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("IssueProduct", mock.Anything, productID.String(), "user123").
+					Return((*repository.Product)(nil), service.ErrProductNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "moderator cannot issue",
+			role:           "moderator",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("POST", "/products/"+productID.String()+"/issue", nil)
+			claims := jwt.MapClaims{"role": tt.role, "user_id": "user123"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PostProductsProductIdIssue(w, req, productID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var productResp Product
+				err := json.NewDecoder(resp.Body).Decode(&productResp)
+				assert.NoError(t, err)
+				assert.Equal(t, Issued, *productResp.Status)
 			}
+
+			mockService.AssertExpectations(t)
 		})
 	}
 }
+
+func TestHTTPHandler_PostProductsProductIdReturn(t *testing.T) {
+	productID := uuid.New()
+	mockService := new(MockService)
+	mockService.On("ReturnProduct", mock.Anything, productID.String(), "user123").
+		Return(&repository.Product{ID: productID.String(), Status: "returned"}, nil)
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("POST", "/products/"+productID.String()+"/return", nil)
+	claims := jwt.MapClaims{"role": "employee", "user_id": "user123"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.PostProductsProductIdReturn(w, req, productID)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var productResp Product
+	err := json.NewDecoder(resp.Body).Decode(&productResp)
+	assert.NoError(t, err)
+	assert.Equal(t, Returned, *productResp.Status)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_GetProductsProductIdHistory(t *testing.T) {
+	productID := uuid.New()
+	changedBy := "user123"
+	fromStatus := "stored"
+	history := []*repository.ProductStatusChange{
+		{ID: uuid.New().String(), ProductID: productID.String(), ToStatus: "stored", ChangedAt: time.Now()},
+		{ID: uuid.New().String(), ProductID: productID.String(), FromStatus: &fromStatus, ToStatus: "issued", ChangedBy: &changedBy, ChangedAt: time.Now()},
+	}
+
+	mockService := new(MockService)
+	mockService.On("GetProductHistory", mock.Anything, productID.String()).Return(history, nil)
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("GET", "/products/"+productID.String()+"/history", nil)
+	claims := jwt.MapClaims{"role": "moderator"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.GetProductsProductIdHistory(w, req, productID)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var historyResp []ProductStatusChange
+	err := json.NewDecoder(resp.Body).Decode(&historyResp)
+	assert.NoError(t, err)
+	assert.Len(t, historyResp, 2)
+	assert.Nil(t, historyResp[0].FromStatus)
+	assert.Equal(t, Stored, *historyResp[1].FromStatus)
+	assert.Equal(t, Issued, historyResp[1].ToStatus)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_GetPvz(t *testing.T) {
+	page_1 := 1
+	limit_10 := 10
+
+	page_0 := 0
+	limit_101 := 101
+	cursor := "cursor1"
+	firstCursor := ""
+	city := "Moscow"
+	receptionStatus := GetPvzParamsReceptionStatusClose
+	includeEmpty := true
+	pvzs := []*repository.PVZWithReceptions{
+		{
+			PVZ:        &repository.PVZ{ID: "pvz1", City: "Moscow"},
+			Receptions: []*repository.ReceptionWithProducts{},
+		},
+	}
+	tests := []struct {
+		name               string
+		params             GetPvzParams
+		mockSetup          func(*MockService)
+		expectedStatus     int
+		expectedNextCursor string
+		withAuth           bool
+	}{
+		{
+			name: "successful list with pagination",
+			params: GetPvzParams{
+				Page:  &page_1,
+				Limit: &limit_10,
+			},
+			mockSetup: func(ms *MockService) {
+				pvzs := []*repository.PVZWithReceptions{
+					{
+						PVZ: &repository.PVZ{
+							ID:   "pvz1",
+							City: "Moscow",
+						},
+						Receptions: []*repository.ReceptionWithProducts{},
+					},
+				}
+				ms.On("ListPVZ", mock.Anything, repository.PVZFilter{}, 1, 10).Return(pvzs, nil)
+			},
+			expectedStatus: http.StatusOK,
+			withAuth:       true,
+		},
+		{
+			name: "invalid page parameter",
+			params: GetPvzParams{
+				Page:  &page_0,
+				Limit: &limit_10,
+			},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusBadRequest,
+			withAuth:       true,
+		},
+		{
+			name:   "default list without params",
+			params: GetPvzParams{},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZ", mock.Anything, repository.PVZFilter{}, 1, 10).Return(pvzs, nil)
+			},
+			expectedStatus: http.StatusOK,
+			withAuth:       true,
+		},
+		{
+			name:   "first page by cursor",
+			params: GetPvzParams{Cursor: &firstCursor, Limit: &limit_10},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZByCursor", mock.Anything, repository.PVZFilter{}, "", 10).Return(pvzs, "next1", nil)
+			},
+			expectedStatus:     http.StatusOK,
+			expectedNextCursor: "next1",
+			withAuth:           true,
+		},
+		{
+			name:   "last page by cursor",
+			params: GetPvzParams{Cursor: &cursor},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZByCursor", mock.Anything, repository.PVZFilter{}, cursor, 10).Return(pvzs, "", nil)
+			},
+			expectedStatus: http.StatusOK,
+			withAuth:       true,
+		},
+		{
+			name:   "invalid cursor",
+			params: GetPvzParams{Cursor: &cursor},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZByCursor", mock.Anything, repository.PVZFilter{}, cursor, 10).Return(nil, "", service.ErrInvalidCursor)
+			},
+			expectedStatus: http.StatusBadRequest,
+			withAuth:       true,
+		},
+		{
+			name: "filters passed through",
+			params: GetPvzParams{
+				City:            &city,
+				ReceptionStatus: &receptionStatus,
+				IncludeEmpty:    &includeEmpty,
+			},
+			mockSetup: func(ms *MockService) {
+				status := "close"
+				ms.On("ListPVZ", mock.Anything, repository.PVZFilter{
+					City:            &city,
+					ReceptionStatus: &status,
+					IncludeEmpty:    true,
+				}, 1, 10).Return(pvzs, nil)
+			},
+			expectedStatus: http.StatusOK,
+			withAuth:       true,
+		},
+		{
+			name:           "page with cursor",
+			params:         GetPvzParams{Page: &page_1, Cursor: &cursor},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusBadRequest,
+			withAuth:       true,
+		},
+		{
+			name:           "limit too large",
+			params:         GetPvzParams{Limit: &limit_101},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusBadRequest,
+			withAuth:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/pvz", nil)
+			w := httptest.NewRecorder()
+
+			// Add query parameters
+			q := req.URL.Query()
+			if tt.params.Page != nil {
+				q.Add("page", fmt.Sprintf("%d", *tt.params.Page))
+			}
+			if tt.params.Limit != nil {
+				q.Add("limit", fmt.Sprintf("%d", *tt.params.Limit))
+			}
+			req.URL.RawQuery = q.Encode()
+
+			if tt.withAuth {
+				claims := jwt.MapClaims{"role": "employee"}
+				ctx := context.WithValue(req.Context(), "user", claims)
+				req = req.WithContext(ctx)
+			}
+
+			handler.GetPvz(w, req, tt.params)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var pvzs []*PVZWithReceptions
+				err := json.NewDecoder(resp.Body).Decode(&pvzs)
+				assert.NoError(t, err)
+				assert.NotEmpty(t, pvzs)
+				assert.Equal(t, tt.expectedNextCursor, resp.Header.Get("X-Next-Cursor"))
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetPvz_QueryBinding(t *testing.T) {
+	pvzs := []*repository.PVZWithReceptions{
+		{PVZ: &repository.PVZ{ID: "pvz1", City: "Moscow"}},
+	}
+	tests := []struct {
+		name               string
+		query              string
+		mockSetup          func(*MockService)
+		expectedNextCursor string
+	}{
+		{
+			name:  "no params keeps page response",
+			query: "",
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZ", mock.Anything, repository.PVZFilter{}, 1, 10).Return(pvzs, nil)
+			},
+		},
+		{
+			name:  "empty cursor starts cursor pagination",
+			query: "?cursor=&limit=1",
+			mockSetup: func(ms *MockService) {
+				ms.On("ListPVZByCursor", mock.Anything, repository.PVZFilter{}, "", 1).Return(pvzs, "next1", nil)
+			},
+			expectedNextCursor: "next1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			router := Handler(NewHTTPHandler(mockService))
+
+			req := httptest.NewRequest("GET", "/pvz"+tt.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), "user", jwt.MapClaims{"role": "employee"}))
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			var response []PVZWithReceptions
+			assert.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+			assert.Len(t, response, 1)
+			assert.Equal(t, tt.expectedNextCursor, resp.Header.Get("X-Next-Cursor"))
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostPvz(t *testing.T) {
+	lat, lon := 55.7558, 37.6173
+	tests := []struct {
+		name           string
+		requestBody    PVZ
+		mockSetup      func(*MockService)
+		expectedStatus int
+		withAuth       bool
+	}{
+		{
+			name: "successful PVZ creation",
+			requestBody: PVZ{
+				City:      "Moscow",
+				Latitude:  &lat,
+				Longitude: &lon,
+			},
+			mockSetup: func(ms *MockService) {
+				location := repository.PVZLocation{Latitude: &lat, Longitude: &lon}
+				pvz := &repository.PVZ{
+					ID:          "pvz123",
+					City:        "Moscow",
+					PVZLocation: location,
+				}
+				ms.On("CreatePVZ", mock.Anything, "Moscow", location).Return(pvz, nil)
+			},
+			expectedStatus: http.StatusCreated,
+			withAuth:       true,
+		},
+		{
+			name: "forbidden for non-moderator",
+			requestBody: PVZ{
+				City: "Moscow",
+			},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+			withAuth:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/pvz", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+
+			if tt.withAuth {
+				role := "employee"
+				if tt.expectedStatus == http.StatusCreated {
+					role = "moderator"
+				}
+				claims := jwt.MapClaims{"role": role}
+				ctx := context.WithValue(req.Context(), "user", claims)
+				req = req.WithContext(ctx)
+			}
+
+			handler.PostPvz(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var pvzResp PVZ
+				err := json.NewDecoder(resp.Body).Decode(&pvzResp)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.requestBody.City, pvzResp.City)
+				assert.Equal(t, tt.requestBody.Latitude, pvzResp.Latitude)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetPvzNearby(t *testing.T) {
+	limit := 50
+	tests := []struct {
+		name           string
+		params         GetPvzNearbyParams
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name:   "successful search",
+			params: GetPvzNearbyParams{Lat: 55.75, Lon: 37.61, Radius: 1000},
+			mockSetup: func(ms *MockService) {
+				nearby := []*repository.NearbyPVZ{
+					{PVZ: repository.PVZ{ID: uuid.New().String(), City: "Москва", Status: "active"}, Distance: 120.5},
+				}
+				ms.On("ListNearbyPVZ", mock.Anything, 55.75, 37.61, 1000.0, 10).Return(nearby, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "invalid radius",
+			params: GetPvzNearbyParams{Lat: 55.75, Lon: 37.61, Radius: 100000},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListNearbyPVZ", mock.Anything, 55.75, 37.61, 100000.0, 10).
+					Return(nil, fmt.Errorf("%w: radius too large", service.ErrInvalidLocation))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit",
+			params:         GetPvzNearbyParams{Lat: 55.75, Lon: 37.61, Radius: 1000, Limit: &limit},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "internal error",
+			params: GetPvzNearbyParams{Lat: 55.75, Lon: 37.61, Radius: 1000},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListNearbyPVZ", mock.Anything, 55.75, 37.61, 1000.0, 10).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/pvz/nearby", nil)
+			claims := jwt.MapClaims{"role": "employee"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetPvzNearby(w, req, tt.params)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var nearbyResp []NearbyPVZ
+				err := json.NewDecoder(resp.Body).Decode(&nearbyResp)
+				assert.NoError(t, err)
+				assert.Len(t, nearbyResp, 1)
+				assert.Equal(t, 120.5, nearbyResp[0].Distance)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetPvzPvzId(t *testing.T) {
+	pvzID := uuid.New()
+	tests := []struct {
+		name           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful get",
+			mockSetup: func(ms *MockService) {
+				pvz := &repository.PVZWithReceptions{
+					PVZ: &repository.PVZ{ID: pvzID.String(), City: "Москва", Status: "active"},
+					Receptions: []*repository.ReceptionWithProducts{
+						{Reception: &repository.Reception{ID: uuid.New().String(), PVZID: pvzID.String(), Status: "close"}},
+					},
+				}
+				ms.On("GetPVZ", mock.Anything, pvzID.String()).Return(pvz, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "pvz not found",
+			mockSetup: func(ms *MockService) {
+				ms.On("GetPVZ", mock.Anything, pvzID.String()).Return(nil, service.ErrPVZNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/pvz/"+pvzID.String(), nil)
+			claims := jwt.MapClaims{"role": "employee"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetPvzPvzId(w, req, pvzID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var pvzResp PVZWithReceptions
+				err := json.NewDecoder(resp.Body).Decode(&pvzResp)
+				assert.NoError(t, err)
+				assert.Equal(t, Active, *pvzResp.PVZ.Status)
+				assert.Len(t, pvzResp.Receptions, 1)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PatchPvzPvzId(t *testing.T) {
+	pvzID := uuid.New()
+	city, street := "Казань", "Баумана"
+	update := repository.PVZUpdate{City: &city, PVZLocation: repository.PVZLocation{Street: &street}}
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful update",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("UpdatePVZ", mock.Anything, pvzID.String(), update).
+					Return(&repository.PVZ{ID: pvzID.String(), City: "Казань", Status: "active"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "inactive pvz",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("UpdatePVZ", mock.Anything, pvzID.String(), update).
+					Return(nil, fmt.Errorf("%w: %s", service.ErrPVZInactive, pvzID))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "forbidden for employee",
+			role:           "employee",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("PATCH", "/pvz/"+pvzID.String(), bytes.NewBufferString(`{"city":"Казань","street":"Баумана"}`))
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PatchPvzPvzId(w, req, pvzID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var pvzResp PVZ
+				err := json.NewDecoder(resp.Body).Decode(&pvzResp)
+				assert.NoError(t, err)
+				assert.Equal(t, "Казань", pvzResp.City)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostPvzPvzIdDeactivate(t *testing.T) {
+	pvzID := uuid.New()
+	tests := []struct {
+		name           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful deactivation",
+			mockSetup: func(ms *MockService) {
+				deactivatedAt := time.Now()
+				ms.On("DeactivatePVZ", mock.Anything, pvzID.String()).
+					Return(&repository.PVZ{ID: pvzID.String(), City: "Москва", Status: "inactive", DeactivatedAt: &deactivatedAt}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "reception in progress",
+			mockSetup: func(ms *MockService) {
+				ms.On("DeactivatePVZ", mock.Anything, pvzID.String()).
+					Return(nil, fmt.Errorf("%w: %s", service.ErrReceptionInProgress, pvzID))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "pvz not found",
+			mockSetup: func(ms *MockService) {
+				ms.On("DeactivatePVZ", mock.Anything, pvzID.String()).Return(nil, service.ErrPVZNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("POST", "/pvz/"+pvzID.String()+"/deactivate", nil)
+			claims := jwt.MapClaims{"role": "moderator"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PostPvzPvzIdDeactivate(w, req, pvzID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var pvzResp PVZ
+				err := json.NewDecoder(resp.Body).Decode(&pvzResp)
+				assert.NoError(t, err)
+				assert.Equal(t, Inactive, *pvzResp.Status)
+				assert.NotNil(t, pvzResp.DeactivatedAt)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostRegister(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    PostRegisterJSONBody
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful registration",
+			requestBody: PostRegisterJSONBody{
+				Email:    "new@example.com",
+				Password: "password123",
+				Role:     "employee",
+			},
+			mockSetup: func(ms *MockService) {
+				user := &repository.User{
+					ID:    "user123",
+					Email: "new@example.com",
+					Role:  "employee",
+				}
+				ms.On("RegisterUser", mock.Anything, "new@example.com", "password123", "employee").Return(user, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			body, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/register", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+
+			handler.PostRegister(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			var userResp User
+			err := json.NewDecoder(resp.Body).Decode(&userResp)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.requestBody.Email, userResp.Email)
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostWebhooks(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		requestBody    string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name:        "successful subscription",
+			role:        "moderator",
+			requestBody: `{"url":"http://example.com/hook","eventTypes":["PVZCreated"]}`,
+			mockSetup: func(ms *MockService) {
+				subscription := &repository.WebhookSubscription{
+					ID:         uuid.New().String(),
+					URL:        "http://example.com/hook",
+					Secret:     "secret",
+					EventTypes: []string{"PVZCreated"},
+				}
+				ms.On("CreateWebhookSubscription", mock.Anything, "http://example.com/hook", []string{"PVZCreated"}).Return(subscription, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:        "invalid url",
+			role:        "moderator",
+			requestBody: `{"url":"not a url"}`,
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateWebhookSubscription", mock.Anything, "not a url", []string(nil)).
+					Return(nil, fmt.Errorf("%w: invalid url not a url", service.ErrInvalidWebhookSubscription))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "forbidden for employee",
+			role:           "employee",
+			requestBody:    `{"url":"http://example.com/hook"}`,
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("POST", "/webhooks", bytes.NewBufferString(tt.requestBody))
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PostWebhooks(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var subscriptionResp WebhookSubscription
+				err := json.NewDecoder(resp.Body).Decode(&subscriptionResp)
+				assert.NoError(t, err)
+				assert.Equal(t, "secret", *subscriptionResp.Secret)
+				assert.Equal(t, []WebhookEventType{PVZCreated}, subscriptionResp.EventTypes)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetWebhooks(t *testing.T) {
+	subscriptions := []*repository.WebhookSubscription{
+		{ID: uuid.New().String(), URL: "http://example.com/hook", Secret: "secret", EventTypes: []string{}},
+	}
+
+	mockService := new(MockService)
+	mockService.On("ListWebhookSubscriptions", mock.Anything).Return(subscriptions, nil)
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("GET", "/webhooks", nil)
+	claims := jwt.MapClaims{"role": "moderator"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.GetWebhooks(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var subscriptionsResp []WebhookSubscription
+	err := json.NewDecoder(resp.Body).Decode(&subscriptionsResp)
+	assert.NoError(t, err)
+	assert.Len(t, subscriptionsResp, 1)
+	assert.Nil(t, subscriptionsResp[0].Secret)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_DeleteWebhooksWebhookId(t *testing.T) {
+	webhookID := uuid.New()
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{
+			name:           "successful delete",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "subscription not found",
+			mockErr:        service.ErrWebhookSubscriptionNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			mockService.On("DeleteWebhookSubscription", mock.Anything, webhookID.String()).Return(tt.mockErr)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("DELETE", "/webhooks/"+webhookID.String(), nil)
+			claims := jwt.MapClaims{"role": "moderator"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.DeleteWebhooksWebhookId(w, req, webhookID)
+
+			assert.Equal(t, tt.expectedStatus, w.Result().StatusCode)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostCities(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful creation",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateCity", mock.Anything, "Новосибирск").
+					Return(&repository.DictionaryEntry{Name: "Новосибирск", CreatedAt: time.Now()}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "city already exists",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("CreateCity", mock.Anything, "Новосибирск").
+					Return(nil, fmt.Errorf("%w: Новосибирск", service.ErrDictionaryEntryExists))
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "forbidden for employee",
+			role:           "employee",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("POST", "/cities", bytes.NewBufferString(`{"name":"Новосибирск"}`))
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PostCities(w, req)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var entryResp DictionaryEntry
+				err := json.NewDecoder(resp.Body).Decode(&entryResp)
+				assert.NoError(t, err)
+				assert.Equal(t, "Новосибирск", entryResp.Name)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetProductTypes(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("ListProductTypes", mock.Anything).Return([]*repository.DictionaryEntry{
+		{Name: "обувь"},
+		{Name: "одежда"},
+	}, nil)
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("GET", "/product_types", nil)
+	claims := jwt.MapClaims{"role": "employee"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.GetProductTypes(w, req)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var entriesResp []DictionaryEntry
+	err := json.NewDecoder(resp.Body).Decode(&entriesResp)
+	assert.NoError(t, err)
+	assert.Len(t, entriesResp, 2)
+	assert.Equal(t, "обувь", entriesResp[0].Name)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_DeleteProductTypesName(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{
+			name:           "successful delete",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "product type not found",
+			mockErr:        fmt.Errorf("%w: книги", service.ErrDictionaryEntryNotFound),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			mockService.On("DeleteProductType", mock.Anything, "книги").Return(tt.mockErr)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("DELETE", "/product_types/книги", nil)
+			claims := jwt.MapClaims{"role": "moderator"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.DeleteProductTypesName(w, req, "книги")
+
+			assert.Equal(t, tt.expectedStatus, w.Result().StatusCode)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestValidateRole(t *testing.T) {
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		allowedRoles   []string
+		expectedResult bool
+		expectedStatus int
+	}{
+		{
+			name: "valid role",
+			ctx: context.WithValue(context.Background(), "user", jwt.MapClaims{
+				"role": "employee",
+			}),
+			allowedRoles:   []string{"employee", "moderator"},
+			expectedResult: true,
+			expectedStatus: 0,
+		},
+		{
+			name: "invalid role",
+			ctx: context.WithValue(context.Background(), "user", jwt.MapClaims{
+				"role": "guest",
+			}),
+			allowedRoles:   []string{"employee", "moderator"},
+			expectedResult: false,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "no user in context",
+			ctx:            context.Background(),
+			allowedRoles:   []string{"employee"},
+			expectedResult: false,
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			result := validateRole(tt.ctx, w, tt.allowedRoles)
+			assert.Equal(t, tt.expectedResult, result)
+
+			if tt.expectedStatus != 0 {
+				resp := w.Result()
+				assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestHTTPHandler_PostReceptions_NotAssigned(t *testing.T) {
+	pvzID := uuid.New()
+	mockService := new(MockService)
+	mockService.On("CreateReception", mock.Anything, pvzID.String(), "user123").
+		Return(nil, fmt.Errorf("%w: %s", service.ErrEmployeeNotAssigned, pvzID))
+	handler := NewHTTPHandler(mockService)
+
+	body, _ := json.Marshal(map[string]string{"pvzId": pvzID.String()})
+	req := httptest.NewRequest("POST", "/receptions", bytes.NewBuffer(body))
+	claims := jwt.MapClaims{"role": "employee", "user_id": "user123"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.PostReceptions(w, req)
+
+	assert.Equal(t, http.StatusForbidden, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_PostReceptions_InProgress(t *testing.T) {
+	pvzID := uuid.New()
+	mockService := new(MockService)
+	mockService.On("CreateReception", mock.Anything, pvzID.String(), "user123").
+		Return(nil, fmt.Errorf("%w: %s", service.ErrReceptionInProgress, pvzID))
+	handler := NewHTTPHandler(mockService)
+
+	body, _ := json.Marshal(map[string]string{"pvzId": pvzID.String()})
+	req := httptest.NewRequest("POST", "/receptions", bytes.NewBuffer(body))
+	claims := jwt.MapClaims{"role": "employee", "user_id": "user123"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.PostReceptions(w, req)
+
+	assert.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_PostPvzPvzIdEmployees(t *testing.T) {
+	pvzID := uuid.New()
+	userID := uuid.New()
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful assignment",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, pvzID.String(), userID.String()).
+					Return(&repository.PVZEmployee{PVZID: pvzID.String(), UserID: userID.String(), Email: "employee@example.com"}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name: "user not found",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, pvzID.String(), userID.String()).Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "user is not employee",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, pvzID.String(), userID.String()).
+					Return(nil, fmt.Errorf("%w: %s", service.ErrUserNotEmployee, userID))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "already assigned",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("AssignEmployee", mock.Anything, pvzID.String(), userID.String()).Return(nil, service.ErrAssignmentExists)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:           "forbidden for employee",
+			role:           "employee",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			body, _ := json.Marshal(PostPvzPvzIdEmployeesJSONBody{UserId: userID})
+			req := httptest.NewRequest("POST", "/pvz/"+pvzID.String()+"/employees", bytes.NewBuffer(body))
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PostPvzPvzIdEmployees(w, req, pvzID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var employeeResp PVZEmployee
+				err := json.NewDecoder(resp.Body).Decode(&employeeResp)
+				assert.NoError(t, err)
+				assert.Equal(t, userID, employeeResp.UserId)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_DeletePvzPvzIdEmployeesUserId(t *testing.T) {
+	pvzID := uuid.New()
+	userID := uuid.New()
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{
+			name:           "successful unassignment",
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name:           "not assigned",
+			mockErr:        service.ErrAssignmentNotFound,
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			mockService.On("UnassignEmployee", mock.Anything, pvzID.String(), userID.String()).Return(tt.mockErr)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("DELETE", "/pvz/"+pvzID.String()+"/employees/"+userID.String(), nil)
+			claims := jwt.MapClaims{"role": "moderator"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.DeletePvzPvzIdEmployeesUserId(w, req, pvzID, userID)
+
+			assert.Equal(t, tt.expectedStatus, w.Result().StatusCode)
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetAudit(t *testing.T) {
+	entityType := "pvz"
+	actorID := "moderator1"
+	from := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	to := from.Add(-time.Hour)
+	page := 0
+
+	tests := []struct {
+		name           string
+		role           string
+		params         GetAuditParams
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name:   "successful listing",
+			role:   "moderator",
+			params: GetAuditParams{EntityType: &entityType},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListAuditRecords", mock.Anything, repository.AuditFilter{EntityType: &entityType, Page: 1, Limit: 20}).
+					Return([]*repository.AuditRecord{{
+						ID:         uuid.New().String(),
+						ActorID:    &actorID,
+						Action:     "update",
+						EntityType: "pvz",
+						EntityID:   "pvz1",
+						Before:     []byte(`{"city":"Москва"}`),
+						After:      []byte(`{"city":"Казань"}`),
+						CreatedAt:  from,
+					}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "employee is forbidden",
+			role:           "employee",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "invalid page",
+			role:           "moderator",
+			params:         GetAuditParams{Page: &page},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "from after to",
+			role:           "moderator",
+			params:         GetAuditParams{From: &from, To: &to},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/audit", nil)
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetAudit(w, req, tt.params)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedStatus == http.StatusOK {
+				var records []AuditRecord
+				err := json.NewDecoder(resp.Body).Decode(&records)
+				assert.NoError(t, err)
+				assert.Len(t, records, 1)
+				assert.Equal(t, "Казань", (*records[0].After)["city"])
+				assert.Nil(t, records[0].RequestId)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostPvzPvzIdCloseLastReception_UnacknowledgedDiscrepancies(t *testing.T) {
+	pvzID := uuid.New()
+	mockService := new(MockService)
+	mockService.On("CloseReception", mock.Anything, pvzID.String(), "user123").
+		Return((*repository.Reception)(nil), fmt.Errorf("%w: rc1", service.ErrUnacknowledgedDiscrepancies))
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("POST", "/pvz/"+pvzID.String()+"/close_last_reception", nil)
+	claims := jwt.MapClaims{"role": "employee", "user_id": "user123"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.PostPvzPvzIdCloseLastReception(w, req, pvzID)
+
+	assert.Equal(t, http.StatusConflict, w.Result().StatusCode)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_PutReceptionsReceptionIdManifest(t *testing.T) {
+	receptionID := uuid.New()
+	productType := "обувь"
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name: "successful upload",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("SetReceptionManifest", mock.Anything, receptionID.String(), mock.Anything, true, "user123").
+					Return(&repository.ReceptionManifest{
+						ReceptionID:          receptionID.String(),
+						BlockOnDiscrepancies: true,
+						Items:                []*repository.ManifestItem{{Type: &productType, Quantity: 2}},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "invalid manifest",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("SetReceptionManifest", mock.Anything, receptionID.String(), mock.Anything, true, "user123").
+					Return(nil, fmt.Errorf("%w: item 0: quantity must be positive", service.ErrInvalidManifest))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "reception not found",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("SetReceptionManifest", mock.Anything, receptionID.String(), mock.Anything, true, "user123").
+					Return(nil, service.ErrReceptionNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name: "not assigned",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("SetReceptionManifest", mock.Anything, receptionID.String(), mock.Anything, true, "user123").
+					Return(nil, fmt.Errorf("%w: pvz1", service.ErrEmployeeNotAssigned))
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "forbidden for moderator",
+			role:           "moderator",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			block := true
+			body, _ := json.Marshal(PutReceptionsReceptionIdManifestJSONBody{
+				Items:                []ManifestItem{{Type: &productType, Quantity: 2}},
+				BlockOnDiscrepancies: &block,
+			})
+			req := httptest.NewRequest("PUT", "/receptions/"+receptionID.String()+"/manifest", bytes.NewBuffer(body))
+			claims := jwt.MapClaims{"role": tt.role, "user_id": "user123"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PutReceptionsReceptionIdManifest(w, req, receptionID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var manifestResp ReceptionManifest
+				err := json.NewDecoder(resp.Body).Decode(&manifestResp)
+				assert.NoError(t, err)
+				assert.Equal(t, receptionID, manifestResp.ReceptionId)
+				assert.True(t, manifestResp.BlockOnDiscrepancies)
+				assert.Len(t, manifestResp.Items, 1)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetPvzPvzIdReceptions(t *testing.T) {
+	pvzID := uuid.New()
+	status := GetPvzPvzIdReceptionsParamsStatusClose
+	page, limit := 2, 10
+	startDate := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, -1)
+	tests := []struct {
+		name           string
+		role           string
+		params         GetPvzPvzIdReceptionsParams
+		mockSetup      func(*MockService)
+		expectedStatus int
+		expectedLen    int
+	}{
+		{
+			name:   "successful list with filters",
+			role:   "employee",
+			params: GetPvzPvzIdReceptionsParams{Status: &status, Page: &page, Limit: &limit},
+			mockSetup: func(ms *MockService) {
+				closeStatus := "close"
+				ms.On("ListReceptions", mock.Anything, pvzID.String(), repository.ReceptionFilter{
+					Status: &closeStatus,
+					Page:   2,
+					Limit:  10,
+				}).Return([]*repository.Reception{
+					{ID: uuid.New().String(), PVZID: pvzID.String(), Status: "close"},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedLen:    1,
+		},
+		{
+			name:   "default pagination",
+			role:   "moderator",
+			params: GetPvzPvzIdReceptionsParams{},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListReceptions", mock.Anything, pvzID.String(), repository.ReceptionFilter{Page: 1, Limit: 20}).
+					Return([]*repository.Reception{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid limit",
+			role:           "employee",
+			params:         GetPvzPvzIdReceptionsParams{Limit: func() *int { l := 101; return &l }()},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "invalid filter",
+			role:   "employee",
+			params: GetPvzPvzIdReceptionsParams{StartDate: &startDate, EndDate: &endDate},
+			mockSetup: func(ms *MockService) {
+				ms.On("ListReceptions", mock.Anything, pvzID.String(), mock.Anything).
+					Return(nil, service.ErrInvalidReceptionFilter)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "forbidden role",
+			role:           "client",
+			params:         GetPvzPvzIdReceptionsParams{},
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/pvz/"+pvzID.String()+"/receptions", nil)
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetPvzPvzIdReceptions(w, req, pvzID, tt.params)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var receptions []Reception
+				err := json.NewDecoder(resp.Body).Decode(&receptions)
+				assert.NoError(t, err)
+				assert.Len(t, receptions, tt.expectedLen)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetReceptionsReceptionId(t *testing.T) {
+	receptionID := uuid.New()
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{name: "success", expectedStatus: http.StatusOK},
+		{name: "reception not found", mockErr: service.ErrReceptionNotFound, expectedStatus: http.StatusNotFound},
+		{name: "internal error", mockErr: errors.New("db error"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.mockErr != nil {
+				mockService.On("GetReception", mock.Anything, receptionID.String()).Return(nil, tt.mockErr)
+			} else {
+				mockService.On("GetReception", mock.Anything, receptionID.String()).Return(&repository.ReceptionWithProducts{
+					Reception: &repository.Reception{ID: receptionID.String(), PVZID: uuid.New().String(), Status: "close"},
+					Products: []*repository.Product{
+						{ID: uuid.New().String(), ReceptionId: receptionID.String(), Type: "обувь"},
+					},
+				}, nil)
+			}
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/receptions/"+receptionID.String(), nil)
+			claims := jwt.MapClaims{"role": "employee"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetReceptionsReceptionId(w, req, receptionID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var receptionResp ReceptionWithProducts
+				err := json.NewDecoder(resp.Body).Decode(&receptionResp)
+				assert.NoError(t, err)
+				assert.Equal(t, receptionID, *receptionResp.Reception.Id)
+				assert.Len(t, receptionResp.Products, 1)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetReceptionsReceptionIdReconciliation(t *testing.T) {
+	receptionID := uuid.New()
+	productType := "обувь"
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{name: "success", expectedStatus: http.StatusOK},
+		{name: "manifest not found", mockErr: service.ErrManifestNotFound, expectedStatus: http.StatusNotFound},
+		{name: "internal error", mockErr: errors.New("db error"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.mockErr != nil {
+				mockService.On("GetReconciliation", mock.Anything, receptionID.String()).Return(nil, tt.mockErr)
+			} else {
+				mockService.On("GetReconciliation", mock.Anything, receptionID.String()).Return(&repository.Reconciliation{
+					ReceptionID: receptionID.String(),
+					Report: repository.ReconciliationReport{
+						Matched: []*repository.ManifestItem{},
+						Missing: []*repository.ManifestItem{{Type: &productType, Quantity: 1}},
+						Extra:   []*repository.ManifestItem{},
+					},
+					HasDiscrepancies: true,
+				}, nil)
+			}
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/receptions/"+receptionID.String()+"/reconciliation", nil)
+			claims := jwt.MapClaims{"role": "moderator"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetReceptionsReceptionIdReconciliation(w, req, receptionID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var reconciliationResp Reconciliation
+				err := json.NewDecoder(resp.Body).Decode(&reconciliationResp)
+				assert.NoError(t, err)
+				assert.True(t, reconciliationResp.HasDiscrepancies)
+				assert.Equal(t, []ManifestItem{{Type: &productType, Quantity: 1}}, reconciliationResp.Missing)
+				assert.NotNil(t, reconciliationResp.Matched)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostReceptionsReceptionIdReconciliationAcknowledge(t *testing.T) {
+	receptionID := uuid.New()
+	userID := "user123"
+	acknowledgedAt := time.Now()
+	mockService := new(MockService)
+	mockService.On("AcknowledgeReconciliation", mock.Anything, receptionID.String(), userID).Return(&repository.Reconciliation{
+		ReceptionID:      receptionID.String(),
+		HasDiscrepancies: true,
+		AcknowledgedBy:   &userID,
+		AcknowledgedAt:   &acknowledgedAt,
+	}, nil)
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("POST", "/receptions/"+receptionID.String()+"/reconciliation/acknowledge", nil)
+	claims := jwt.MapClaims{"role": "employee", "user_id": userID}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.PostReceptionsReceptionIdReconciliationAcknowledge(w, req, receptionID)
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var reconciliationResp Reconciliation
+	err := json.NewDecoder(resp.Body).Decode(&reconciliationResp)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, *reconciliationResp.AcknowledgedBy)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_PostReceptionsReceptionIdReopen(t *testing.T) {
+	receptionID := uuid.New()
+	userID := "user123"
+	tests := []struct {
+		name           string
+		role           string
+		body           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{name: "success", role: "moderator", body: `{"reason":"забыли отсканировать коробку"}`, expectedStatus: http.StatusOK},
+		{name: "employee forbidden", role: "employee", body: `{"reason":"ошибка"}`, expectedStatus: http.StatusForbidden},
+		{name: "invalid body", role: "moderator", body: `{`, expectedStatus: http.StatusBadRequest},
+		{name: "empty reason", role: "moderator", body: `{"reason":""}`, mockErr: service.ErrInvalidCorrection, expectedStatus: http.StatusBadRequest},
+		{name: "reception not found", role: "moderator", body: `{"reason":"ошибка"}`, mockErr: service.ErrReceptionNotFound, expectedStatus: http.StatusNotFound},
+		{name: "reception not closed", role: "moderator", body: `{"reason":"ошибка"}`, mockErr: service.ErrReceptionNotClosed, expectedStatus: http.StatusConflict},
+		{name: "newer reception exists", role: "moderator", body: `{"reason":"ошибка"}`, mockErr: service.ErrNewerReceptionExists, expectedStatus: http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.expectedStatus == http.StatusOK {
+				mockService.On("ReopenReception", mock.Anything, receptionID.String(), "забыли отсканировать коробку", userID).Return(&repository.Reception{
+					ID:     receptionID.String(),
+					PVZID:  uuid.New().String(),
+					Status: "reopened",
+				}, nil)
+			} else if tt.mockErr != nil {
+				mockService.On("ReopenReception", mock.Anything, receptionID.String(), mock.Anything, userID).Return(nil, tt.mockErr)
+			}
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("POST", "/receptions/"+receptionID.String()+"/reopen", bytes.NewBufferString(tt.body))
+			claims := jwt.MapClaims{"role": tt.role, "user_id": userID}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PostReceptionsReceptionIdReopen(w, req, receptionID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var receptionResp Reception
+				err := json.NewDecoder(resp.Body).Decode(&receptionResp)
+				assert.NoError(t, err)
+				assert.Equal(t, Reopened, receptionResp.Status)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_PostReceptionsReceptionIdCorrections(t *testing.T) {
+	receptionID := uuid.New()
+	removedID := uuid.New()
+	userID := "user123"
+	body := `{"reason":"пересорт","addProducts":[{"type":"обувь","barcode":"4601234567890"}],"removeProductIds":["` + removedID.String() + `"]}`
+	barcode := "4601234567890"
+	amendment := repository.ReceptionAmendment{
+		AddProducts:      []repository.NewProduct{{Type: "обувь", ProductDetails: repository.ProductDetails{Barcode: &barcode}}},
+		RemoveProductIDs: []string{removedID.String()},
+		Reason:           "пересорт",
+	}
+	tests := []struct {
+		name           string
+		role           string
+		body           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{name: "success", role: "moderator", body: body, expectedStatus: http.StatusCreated},
+		{name: "employee forbidden", role: "employee", body: body, expectedStatus: http.StatusForbidden},
+		{name: "invalid body", role: "moderator", body: `{`, expectedStatus: http.StatusBadRequest},
+		{name: "nothing to change", role: "moderator", body: body, mockErr: service.ErrInvalidCorrection, expectedStatus: http.StatusBadRequest},
+		{name: "product not amendable", role: "moderator", body: body, mockErr: service.ErrProductNotAmendable, expectedStatus: http.StatusConflict},
+		{name: "duplicate barcode", role: "moderator", body: body, mockErr: service.ErrDuplicateBarcode, expectedStatus: http.StatusConflict},
+		{name: "reception not found", role: "moderator", body: body, mockErr: service.ErrReceptionNotFound, expectedStatus: http.StatusNotFound},
+		{name: "internal error", role: "moderator", body: body, mockErr: errors.New("db error"), expectedStatus: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.expectedStatus == http.StatusCreated {
+				mockService.On("AmendReception", mock.Anything, receptionID.String(), amendment, userID).Return(&repository.ReceptionCorrection{
+					ID:          uuid.New().String(),
+					ReceptionID: receptionID.String(),
+					Action:      repository.CorrectionActionAmend,
+					Reason:      "пересорт",
+					ChangedBy:   &userID,
+					Changes: repository.ReceptionChanges{
+						Added:   []*repository.Product{{ID: uuid.New().String(), ReceptionId: receptionID.String(), Type: "обувь", Status: "stored"}},
+						Removed: []*repository.Product{{ID: removedID.String(), ReceptionId: receptionID.String(), Type: "одежда", Status: "stored"}},
+					},
+					CreatedAt: time.Now(),
+				}, nil)
+			} else if tt.mockErr != nil {
+				mockService.On("AmendReception", mock.Anything, receptionID.String(), amendment, userID).Return(nil, tt.mockErr)
+			}
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("POST", "/receptions/"+receptionID.String()+"/corrections", bytes.NewBufferString(tt.body))
+			claims := jwt.MapClaims{"role": tt.role, "user_id": userID}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.PostReceptionsReceptionIdCorrections(w, req, receptionID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var correctionResp ReceptionCorrection
+				err := json.NewDecoder(resp.Body).Decode(&correctionResp)
+				assert.NoError(t, err)
+				assert.Equal(t, Amend, correctionResp.Action)
+				assert.Len(t, correctionResp.Added, 1)
+				assert.Len(t, correctionResp.Removed, 1)
+				assert.Equal(t, removedID, *correctionResp.Removed[0].Id)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetReceptionsReceptionIdCorrections(t *testing.T) {
+	receptionID := uuid.New()
+	tests := []struct {
+		name           string
+		mockErr        error
+		expectedStatus int
+	}{
+		{name: "success", expectedStatus: http.StatusOK},
+		{name: "reception not found", mockErr: service.ErrReceptionNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			if tt.mockErr != nil {
+				mockService.On("ListReceptionCorrections", mock.Anything, receptionID.String()).Return(nil, tt.mockErr)
+			} else {
+				mockService.On("ListReceptionCorrections", mock.Anything, receptionID.String()).Return([]*repository.ReceptionCorrection{
+					{
+						ID:          uuid.New().String(),
+						ReceptionID: receptionID.String(),
+						Action:      repository.CorrectionActionReopen,
+						Reason:      "забыли отсканировать коробку",
+						Changes:     repository.ReceptionChanges{Added: []*repository.Product{}, Removed: []*repository.Product{}},
+						CreatedAt:   time.Now(),
+					},
+				}, nil)
+			}
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/receptions/"+receptionID.String()+"/corrections", nil)
+			claims := jwt.MapClaims{"role": "employee"}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetReceptionsReceptionIdCorrections(w, req, receptionID)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+
+			if tt.expectedStatus == http.StatusOK {
+				var correctionsResp []ReceptionCorrection
+				err := json.NewDecoder(resp.Body).Decode(&correctionsResp)
+				assert.NoError(t, err)
+				assert.Len(t, correctionsResp, 1)
+				assert.Equal(t, Reopen, correctionsResp[0].Action)
+				assert.NotNil(t, correctionsResp[0].Added)
+			}
+
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetReportsReceptions(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	pvzID := uuid.New()
+	pvzIDString := pvzID.String()
+	period := Week
+	groupBy := []ReportDimension{Pvz}
+
+	tests := []struct {
+		name           string
+		role           string
+		params         GetReportsReceptionsParams
+		mockSetup      func(*MockService)
+		expectedStatus int
+	}{
+		{
+			name:   "successful report",
+			role:   "moderator",
+			params: GetReportsReceptionsParams{StartDate: from, EndDate: to, Period: &period, GroupBy: &groupBy, PvzId: &pvzID},
+			mockSetup: func(ms *MockService) {
+				ms.On("ReceptionsReport", mock.Anything, repository.ReportFilter{
+					From:    from,
+					To:      to,
+					Period:  "week",
+					GroupBy: []string{"pvz"},
+					PVZID:   &pvzIDString,
+				}).Return([]*repository.ReceptionReportRow{{
+					Period:     from,
+					PVZID:      &pvzIDString,
+					Receptions: 3,
+					Closed:     2,
+					Products:   10,
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "employee is forbidden",
+			role:           "employee",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:   "invalid filter",
+			role:   "moderator",
+			params: GetReportsReceptionsParams{StartDate: to, EndDate: from},
+			mockSetup: func(ms *MockService) {
+				ms.On("ReceptionsReport", mock.Anything, repository.ReportFilter{From: to, To: from}).
+					Return(nil, fmt.Errorf("%w: startDate must be before endDate", service.ErrInvalidReportFilter))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/reports/receptions", nil)
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetReportsReceptions(w, req, tt.params)
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedStatus == http.StatusOK {
+				var rows []ReceptionReportRow
+				err := json.NewDecoder(resp.Body).Decode(&rows)
+				assert.NoError(t, err)
+				assert.Len(t, rows, 1)
+				assert.Equal(t, pvzID, *rows[0].PvzId)
+				assert.Nil(t, rows[0].City)
+				assert.Equal(t, 2, rows[0].Closed)
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetReportsProducts(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	productType := "обувь"
+	mockService := new(MockService)
+	mockService.On("ProductsReport", mock.Anything, repository.ReportFilter{From: from, To: to, Type: &productType}).
+		Return([]*repository.ProductReportRow{{Period: from, Products: 7}}, nil)
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("GET", "/reports/products", nil)
+	claims := jwt.MapClaims{"role": "moderator"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.GetReportsProducts(w, req, GetReportsProductsParams{StartDate: from, EndDate: to, Type: &productType})
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	var rows []ProductReportRow
+	err := json.NewDecoder(resp.Body).Decode(&rows)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, 7, rows[0].Products)
+	assert.Nil(t, rows[0].PvzId)
+	mockService.AssertExpectations(t)
+}
+
+func TestHTTPHandler_GetExportReceptionsCsv(t *testing.T) {
+	startDate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	productID, productType := "pr1", "обувь"
+	rows := []*repository.ReceptionExportRow{
+		{
+			PVZID:           "pvz1",
+			City:            "Москва",
+			ReceptionID:     "rc1",
+			ReceptionDate:   startDate,
+			ReceptionStatus: "close",
+			ProductID:       &productID,
+			ProductType:     &productType,
+			ProductDate:     &startDate,
+		},
+	}
+
+	tests := []struct {
+		name           string
+		role           string
+		mockSetup      func(*MockService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "successful export",
+			role: "employee",
+			mockSetup: func(ms *MockService) {
+				ms.On("ExportReceptions", mock.Anything, &startDate, (*time.Time)(nil)).Return(rows, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "\uFEFFID ПВЗ;Город;Улица;Дом;ID приемки;Дата приемки;Статус приемки;" +
+				"ID товара;Тип товара;Статус товара;Штрихкод;Дата приема товара\r\n" +
+				"pvz1;Москва;;;rc1;01.01.2026 00:00:00;close;pr1;обувь;;;01.01.2026 00:00:00\r\n",
+		},
+		{
+			name:           "unauthorized role",
+			role:           "guest",
+			mockSetup:      func(ms *MockService) {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "database error before first row",
+			role: "moderator",
+			mockSetup: func(ms *MockService) {
+				ms.On("ExportReceptions", mock.Anything, &startDate, (*time.Time)(nil)).Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := new(MockService)
+			tt.mockSetup(mockService)
+			handler := NewHTTPHandler(mockService)
+
+			req := httptest.NewRequest("GET", "/export/receptions.csv", nil)
+			claims := jwt.MapClaims{"role": tt.role}
+			req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+			w := httptest.NewRecorder()
+
+			handler.GetExportReceptionsCsv(w, req, GetExportReceptionsCsvParams{StartDate: &startDate})
+
+			resp := w.Result()
+			assert.Equal(t, tt.expectedStatus, resp.StatusCode)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Equal(t, "text/csv; charset=utf-8", resp.Header.Get("Content-Type"))
+				assert.Equal(t, `attachment; filename="receptions.csv"`, resp.Header.Get("Content-Disposition"))
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+			}
+			mockService.AssertExpectations(t)
+		})
+	}
+}
+
+func TestHTTPHandler_GetExportReceptionsXlsx(t *testing.T) {
+	mockService := new(MockService)
+	mockService.On("ExportReceptions", mock.Anything, (*time.Time)(nil), (*time.Time)(nil)).Return(nil, nil)
+	handler := NewHTTPHandler(mockService)
+
+	req := httptest.NewRequest("GET", "/export/receptions.xlsx", nil)
+	claims := jwt.MapClaims{"role": "moderator"}
+	req = req.WithContext(context.WithValue(req.Context(), "user", claims))
+	w := httptest.NewRecorder()
+
+	handler.GetExportReceptionsXlsx(w, req, GetExportReceptionsXlsxParams{})
+
+	resp := w.Result()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, xlsxContentType, resp.Header.Get("Content-Type"))
+	_, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+	assert.NoError(t, err)
+	mockService.AssertExpectations(t)
+}
@@ -1,7 +1,11 @@
 package handler
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/service"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -9,19 +13,97 @@ import (
 func productRepositoryToHTTP(product *repository.Product) *Product {
 	id, _ := uuid.Parse(product.ID)
 	receptionId, _ := uuid.Parse(product.ReceptionId)
-	return &Product{
+	response := &Product{
 		DateTime:    &product.ReceptionDate,
 		Id:          &id,
 		ReceptionId: receptionId,
-		Type:        ProductType(product.Type),
+		Type:        product.Type,
+	}
+	if product.Status != "" {
+		status := ProductStatus(product.Status)
+		response.Status = &status
+	}
+	response.Barcode = product.Barcode
+	response.WeightGrams = product.WeightGrams
+	response.LengthMm = product.LengthMm
+	response.WidthMm = product.WidthMm
+	response.HeightMm = product.HeightMm
+	if len(product.Attributes) > 0 {
+		attributes := map[string]string(product.Attributes)
+		response.Attributes = &attributes
+	}
+	return response
+}
+
+func productDetailsHTTPToRepository(barcode *string, weightGrams, lengthMm, widthMm, heightMm *int, attributes *map[string]string) repository.ProductDetails {
+	details := repository.ProductDetails{
+		Barcode:     barcode,
+		WeightGrams: weightGrams,
+		LengthMm:    lengthMm,
+		WidthMm:     widthMm,
+		HeightMm:    heightMm,
+	}
+	if attributes != nil {
+		details.Attributes = repository.ProductAttributes(*attributes)
+	}
+	return details
+}
+
+func productBatchResultsToHTTP(results []*service.ProductBatchResult) []*ProductBatchResult {
+	response := make([]*ProductBatchResult, len(results))
+	for i, result := range results {
+		response[i] = &ProductBatchResult{Index: i}
+		if result.Err != nil {
+			message := result.Err.Error()
+			response[i].Error = &message
+			continue
+		}
+		response[i].Product = productRepositoryToHTTP(result.Product)
+	}
+	return response
+}
+
+func productStatusChangeRepositoryToHTTP(change *repository.ProductStatusChange) *ProductStatusChange {
+	id, _ := uuid.Parse(change.ID)
+	productId, _ := uuid.Parse(change.ProductID)
+	response := &ProductStatusChange{
+		Id:        &id,
+		ProductId: productId,
+		ToStatus:  ProductStatus(change.ToStatus),
+		ChangedBy: change.ChangedBy,
+		DateTime:  change.ChangedAt,
+	}
+	if change.FromStatus != nil {
+		fromStatus := ProductStatus(*change.FromStatus)
+		response.FromStatus = &fromStatus
 	}
+	return response
 }
 
 func pvzRepositoryToHTTP(pvz *repository.PVZ) *PVZ {
 	id, _ := uuid.Parse(pvz.ID)
-	return &PVZ{
-		Id:   &id,
-		City: PVZCity(pvz.City),
+	response := &PVZ{
+		Id:            &id,
+		City:          pvz.City,
+		DeactivatedAt: pvz.DeactivatedAt,
+		Street:        pvz.Street,
+		House:         pvz.House,
+		PostalCode:    pvz.PostalCode,
+		Latitude:      pvz.Latitude,
+		Longitude:     pvz.Longitude,
+		OpeningHours:  pvz.OpeningHours,
+	}
+	if pvz.Status != "" {
+		status := PVZStatus(pvz.Status)
+		response.Status = &status
+	}
+	return response
+}
+
+func nearbyPVZRepositoryToHTTP(nearby *repository.NearbyPVZ) *NearbyPVZ {
+	return &NearbyPVZ{
+		Pvz:      *pvzRepositoryToHTTP(&nearby.PVZ),
+		Distance: nearby.Distance,
 	}
 }
 
@@ -36,16 +118,170 @@ func receptionRepositoryToHTTP(reception *repository.Reception) *Reception {
 	}
 }
 
+func manifestItemsRepositoryToHTTP(items []*repository.ManifestItem) []ManifestItem {
+	response := make([]ManifestItem, len(items))
+	for i, item := range items {
+		response[i] = ManifestItem{
+			Barcode:  item.Barcode,
+			Type:     item.Type,
+			Quantity: item.Quantity,
+		}
+	}
+	return response
+}
+
+func manifestItemsHTTPToRepository(items []ManifestItem) []*repository.ManifestItem {
+	result := make([]*repository.ManifestItem, len(items))
+	for i, item := range items {
+		result[i] = &repository.ManifestItem{
+			Barcode:  item.Barcode,
+			Type:     item.Type,
+			Quantity: item.Quantity,
+		}
+	}
+	return result
+}
+
+func receptionManifestRepositoryToHTTP(manifest *repository.ReceptionManifest) *ReceptionManifest {
+	receptionId, _ := uuid.Parse(manifest.ReceptionID)
+	return &ReceptionManifest{
+		ReceptionId:          receptionId,
+		BlockOnDiscrepancies: manifest.BlockOnDiscrepancies,
+		UploadedBy:           manifest.UploadedBy,
+		UploadedAt:           manifest.UploadedAt,
+		Items:                manifestItemsRepositoryToHTTP(manifest.Items),
+	}
+}
+
+func reconciliationRepositoryToHTTP(reconciliation *repository.Reconciliation) *Reconciliation {
+	receptionId, _ := uuid.Parse(reconciliation.ReceptionID)
+	return &Reconciliation{
+		ReceptionId:      receptionId,
+		Matched:          manifestItemsRepositoryToHTTP(reconciliation.Report.Matched),
+		Missing:          manifestItemsRepositoryToHTTP(reconciliation.Report.Missing),
+		Extra:            manifestItemsRepositoryToHTTP(reconciliation.Report.Extra),
+		HasDiscrepancies: reconciliation.HasDiscrepancies,
+		AcknowledgedBy:   reconciliation.AcknowledgedBy,
+		AcknowledgedAt:   reconciliation.AcknowledgedAt,
+		CreatedAt:        reconciliation.CreatedAt,
+	}
+}
+
+func reportFilterHTTPToRepository(from, to time.Time, period *ReportPeriod, groupBy *[]ReportDimension, pvzId *openapi_types.UUID, city *string) repository.ReportFilter {
+	filter := repository.ReportFilter{
+		From: from,
+		To:   to,
+		City: city,
+	}
+	if period != nil {
+		filter.Period = string(*period)
+	}
+	if groupBy != nil {
+		for _, dimension := range *groupBy {
+			filter.GroupBy = append(filter.GroupBy, string(dimension))
+		}
+	}
+	if pvzId != nil {
+		id := pvzId.String()
+		filter.PVZID = &id
+	}
+	return filter
+}
+
+func receptionReportRowRepositoryToHTTP(row *repository.ReceptionReportRow) *ReceptionReportRow {
+	return &ReceptionReportRow{
+		Period:     row.Period,
+		PvzId:      optionalUUIDToHTTP(row.PVZID),
+		City:       row.City,
+		Receptions: row.Receptions,
+		Closed:     row.Closed,
+		Products:   row.Products,
+	}
+}
+
+func productReportRowRepositoryToHTTP(row *repository.ProductReportRow) *ProductReportRow {
+	return &ProductReportRow{
+		Period:   row.Period,
+		PvzId:    optionalUUIDToHTTP(row.PVZID),
+		City:     row.City,
+		Type:     row.Type,
+		Products: row.Products,
+	}
+}
+
+func optionalUUIDToHTTP(id *string) *openapi_types.UUID {
+	if id == nil {
+		return nil
+	}
+	parsed, err := uuid.Parse(*id)
+	if err != nil {
+		return nil
+	}
+	return &parsed
+}
+
+const (
+	xlsxContentType  = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	exportTimeLayout = "02.01.2006 15:04:05"
+)
+
+var receptionExportHeader = []string{
+	"ID ПВЗ", "Город", "Улица", "Дом",
+	"ID приемки", "Дата приемки", "Статус приемки",
+	"ID товара", "Тип товара", "Статус товара", "Штрихкод", "Дата приема товара",
+}
+
+func receptionExportRowToRecord(row *repository.ReceptionExportRow) []string {
+	return []string{
+		row.PVZID,
+		row.City,
+		stringOrEmpty(row.Street),
+		stringOrEmpty(row.House),
+		row.ReceptionID,
+		row.ReceptionDate.Format(exportTimeLayout),
+		row.ReceptionStatus,
+		stringOrEmpty(row.ProductID),
+		stringOrEmpty(row.ProductType),
+		stringOrEmpty(row.ProductStatus),
+		stringOrEmpty(row.Barcode),
+		timeOrEmpty(row.ProductDate),
+	}
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func timeOrEmpty(value *time.Time) string {
+	if value == nil {
+		return ""
+	}
+	return value.Format(exportTimeLayout)
+}
+
+func pvzFilterHTTPToRepository(params GetPvzParams) repository.PVZFilter {
+	filter := repository.PVZFilter{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+		City:      params.City,
+	}
+	if params.ReceptionStatus != nil {
+		status := string(*params.ReceptionStatus)
+		filter.ReceptionStatus = &status
+	}
+	if params.IncludeEmpty != nil {
+		filter.IncludeEmpty = *params.IncludeEmpty
+	}
+	return filter
+}
+
 func pvzWithReceptionsRepositoryToHTTP(pvz *repository.PVZWithReceptions) *PVZWithReceptions {
 	receptions := make([]*ReceptionWithProducts, len(pvz.Receptions))
 	for i, reception := range pvz.Receptions {
-		receptions[i] = &ReceptionWithProducts{
-			Reception: receptionRepositoryToHTTP(reception.Reception),
-			Products:  make([]*Product, len(reception.Products)),
-		}
-		for j, product := range reception.Products {
-			receptions[i].Products[j] = productRepositoryToHTTP(product)
-		}
+		receptions[i] = receptionWithProductsRepositoryToHTTP(reception)
 	}
 	return &PVZWithReceptions{
 		PVZ:        pvzRepositoryToHTTP(pvz.PVZ),
@@ -53,6 +289,37 @@ func pvzWithReceptionsRepositoryToHTTP(pvz *repository.PVZWithReceptions) *PVZWi
 	}
 }
 
+func receptionWithProductsRepositoryToHTTP(reception *repository.ReceptionWithProducts) *ReceptionWithProducts {
+	products := make([]*Product, len(reception.Products))
+	for i, product := range reception.Products {
+		products[i] = productRepositoryToHTTP(product)
+	}
+	return &ReceptionWithProducts{
+		Reception: receptionRepositoryToHTTP(reception.Reception),
+		Products:  products,
+	}
+}
+
+func receptionFilterHTTPToRepository(params GetPvzPvzIdReceptionsParams) repository.ReceptionFilter {
+	filter := repository.ReceptionFilter{
+		From:  params.StartDate,
+		To:    params.EndDate,
+		Page:  1,
+		Limit: 20,
+	}
+	if params.Status != nil {
+		status := string(*params.Status)
+		filter.Status = &status
+	}
+	if params.Page != nil {
+		filter.Page = *params.Page
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	return filter
+}
+
 func userRepositoryToHTTP(user *repository.User) *User {
 	id, _ := uuid.Parse(user.ID)
 	return &User{
@@ -61,3 +328,122 @@ func userRepositoryToHTTP(user *repository.User) *User {
 		Role:  UserRole(user.Role),
 	}
 }
+
+func pvzEmployeeRepositoryToHTTP(employee *repository.PVZEmployee) *PVZEmployee {
+	pvzId, _ := uuid.Parse(employee.PVZID)
+	userId, _ := uuid.Parse(employee.UserID)
+	email := openapi_types.Email(employee.Email)
+	return &PVZEmployee{
+		PvzId:      pvzId,
+		UserId:     userId,
+		Email:      &email,
+		AssignedAt: &employee.AssignedAt,
+	}
+}
+
+func tokenPairServiceToHTTP(tokens *service.TokenPair) *TokenPair {
+	return &TokenPair{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
+	}
+}
+
+func webhookSubscriptionRepositoryToHTTP(subscription *repository.WebhookSubscription) *WebhookSubscription {
+	id, _ := uuid.Parse(subscription.ID)
+	eventTypes := make([]WebhookEventType, len(subscription.EventTypes))
+	for i, eventType := range subscription.EventTypes {
+		eventTypes[i] = WebhookEventType(eventType)
+	}
+	return &WebhookSubscription{
+		Id:         &id,
+		Url:        subscription.URL,
+		EventTypes: eventTypes,
+		CreatedAt:  &subscription.CreatedAt,
+	}
+}
+
+func dictionaryEntriesRepositoryToHTTP(entries []*repository.DictionaryEntry) []*DictionaryEntry {
+	response := make([]*DictionaryEntry, len(entries))
+	for i, entry := range entries {
+		response[i] = dictionaryEntryRepositoryToHTTP(entry)
+	}
+	return response
+}
+
+func dictionaryEntryRepositoryToHTTP(entry *repository.DictionaryEntry) *DictionaryEntry {
+	return &DictionaryEntry{
+		Name:      entry.Name,
+		CreatedAt: &entry.CreatedAt,
+	}
+}
+
+func auditRecordRepositoryToHTTP(record *repository.AuditRecord) *AuditRecord {
+	id, _ := uuid.Parse(record.ID)
+	return &AuditRecord{
+		Id:         id,
+		ActorId:    record.ActorID,
+		ActorRole:  record.ActorRole,
+		Action:     record.Action,
+		EntityType: record.EntityType,
+		EntityId:   record.EntityID,
+		Before:     auditPayloadToHTTP(record.Before),
+		After:      auditPayloadToHTTP(record.After),
+		RequestId:  record.RequestID,
+		CreatedAt:  record.CreatedAt,
+	}
+}
+
+func auditPayloadToHTTP(payload []byte) *map[string]interface{} {
+	if payload == nil {
+		return nil
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(payload, &result); err != nil {
+		return nil
+	}
+	return &result
+}
+
+func amendmentHTTPToRepository(request PostReceptionsReceptionIdCorrectionsJSONBody) repository.ReceptionAmendment {
+	amendment := repository.ReceptionAmendment{Reason: request.Reason}
+	if request.AddProducts != nil {
+		amendment.AddProducts = make([]repository.NewProduct, len(*request.AddProducts))
+		for i, product := range *request.AddProducts {
+			amendment.AddProducts[i] = repository.NewProduct{
+				Type: product.Type,
+				ProductDetails: productDetailsHTTPToRepository(
+					product.Barcode, product.WeightGrams, product.LengthMm, product.WidthMm, product.HeightMm, product.Attributes,
+				),
+			}
+		}
+	}
+	if request.RemoveProductIds != nil {
+		amendment.RemoveProductIDs = make([]string, len(*request.RemoveProductIds))
+		for i, id := range *request.RemoveProductIds {
+			amendment.RemoveProductIDs[i] = id.String()
+		}
+	}
+	return amendment
+}
+
+func receptionCorrectionRepositoryToHTTP(correction *repository.ReceptionCorrection) *ReceptionCorrection {
+	id, _ := uuid.Parse(correction.ID)
+	receptionID, _ := uuid.Parse(correction.ReceptionID)
+	response := &ReceptionCorrection{
+		Id:          id,
+		ReceptionId: receptionID,
+		Action:      ReceptionCorrectionAction(correction.Action),
+		Reason:      correction.Reason,
+		ChangedBy:   correction.ChangedBy,
+		Added:       make([]Product, len(correction.Changes.Added)),
+		Removed:     make([]Product, len(correction.Changes.Removed)),
+		CreatedAt:   correction.CreatedAt,
+	}
+	for i, product := range correction.Changes.Added {
+		response.Added[i] = *productRepositoryToHTTP(product)
+	}
+	for i, product := range correction.Changes.Removed {
+		response.Removed[i] = *productRepositoryToHTTP(product)
+	}
+	return response
+}
@@ -28,7 +28,7 @@ func TestProductRepositoryToHTTP(t *testing.T) {
 			expected: &Product{
 				Id:          func() *uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440000"); return &u }(),
 				ReceptionId: func() uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440001"); return u }(),
-				Type:        ProductType("Electronics"),
+				Type:        "Electronics",
 				DateTime:    &now,
 			},
 		},
@@ -43,6 +43,7 @@ func TestProductRepositoryToHTTP(t *testing.T) {
 }
 
 func TestPVZRepositoryToHTTP(t *testing.T) {
+	street, lat, lon := "Тверская", 55.7558, 37.6173
 	testCases := []struct {
 		name     string
 		input    *repository.PVZ
@@ -56,7 +57,26 @@ func TestPVZRepositoryToHTTP(t *testing.T) {
 			},
 			expected: &PVZ{
 				Id:   func() *uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440000"); return &u }(),
-				City: PVZCity("Moscow"),
+				City: "Moscow",
+			},
+		},
+		{
+			name: "with location",
+			input: &repository.PVZ{
+				ID:   "550e8400-e29b-41d4-a716-446655440000",
+				City: "Moscow",
+				PVZLocation: repository.PVZLocation{
+					Street:    &street,
+					Latitude:  &lat,
+					Longitude: &lon,
+				},
+			},
+			expected: &PVZ{
+				Id:        func() *uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440000"); return &u }(),
+				City:      "Moscow",
+				Street:    &street,
+				Latitude:  &lat,
+				Longitude: &lon,
 			},
 		},
 	}
@@ -120,7 +140,7 @@ func TestPVZWithReceptionsRepositoryToHTTP(t *testing.T) {
 			expected: &PVZWithReceptions{
 				PVZ: &PVZ{
 					Id:   func() *uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440000"); return &u }(),
-					City: PVZCity("Moscow"),
+					City: "Moscow",
 				},
 				Receptions: []*ReceptionWithProducts{},
 			},
@@ -154,7 +174,7 @@ func TestPVZWithReceptionsRepositoryToHTTP(t *testing.T) {
 			expected: &PVZWithReceptions{
 				PVZ: &PVZ{
 					Id:   func() *uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440000"); return &u }(),
-					City: PVZCity("Moscow"),
+					City: "Moscow",
 				},
 				Receptions: []*ReceptionWithProducts{
 					{
@@ -168,7 +188,7 @@ func TestPVZWithReceptionsRepositoryToHTTP(t *testing.T) {
 							{
 								Id:          func() *uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440002"); return &u }(),
 								ReceptionId: func() uuid.UUID { u, _ := uuid.Parse("550e8400-e29b-41d4-a716-446655440001"); return u }(),
-								Type:        ProductType("Clothing"),
+								Type:        "Clothing",
 								DateTime:    &now,
 							},
 						},
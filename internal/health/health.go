@@ -0,0 +1,152 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/DarRo9/pvz_service/config"
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	StatusOK          = "ok"
+	StatusUnavailable = "unavailable"
+)
+
+const (
+	checkDatabase   = "database"
+	checkMigrations = "migrations"
+)
+
+const (
+	defaultCheckTimeout       = 2 * time.Second
+	defaultGRPCStatusInterval = 5 * time.Second
+)
+
+type Dependencies interface {
+	Ping(ctx context.Context) error
+	GetSchemaVersion(ctx context.Context) (*repository.SchemaVersion, error)
+}
+
+// Report - результат проверки готовности. В Checks для каждой зависимости лежит ok или текст ошибки
+type Report struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+type Checker struct {
+	deps          Dependencies
+	schemaVersion int64
+	cfg           config.HealthConfig
+}
+
+// schemaVersion - версия последней миграции, встроенной в бинарник. Сервис не готов,
+// пока база не мигрирована хотя бы до нее
+func NewChecker(deps Dependencies, schemaVersion int64, cfg config.HealthConfig) *Checker {
+	if cfg.CheckTimeout <= 0 {
+		cfg.CheckTimeout = defaultCheckTimeout
+	}
+	if cfg.GRPCStatusInterval <= 0 {
+		cfg.GRPCStatusInterval = defaultGRPCStatusInterval
+	}
+	return &Checker{
+		deps:          deps,
+		schemaVersion: schemaVersion,
+		cfg:           cfg,
+	}
+}
+
+// Check проверяет доступность базы и то, что миграции применены до нужной версии
+func (c *Checker) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.CheckTimeout)
+	defer cancel()
+
+	report := Report{
+		Status: StatusOK,
+		Checks: map[string]string{
+			checkDatabase:   StatusOK,
+			checkMigrations: StatusOK,
+		},
+	}
+
+	if err := c.deps.Ping(ctx); err != nil {
+		report.Status = StatusUnavailable
+		report.Checks[checkDatabase] = err.Error()
+		report.Checks[checkMigrations] = "database is unavailable"
+		return report
+	}
+
+	if err := c.checkSchemaVersion(ctx); err != nil {
+		report.Status = StatusUnavailable
+		report.Checks[checkMigrations] = err.Error()
+	}
+
+	return report
+}
+
+func (c *Checker) checkSchemaVersion(ctx context.Context) error {
+	version, err := c.deps.GetSchemaVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version.Dirty {
+		return fmt.Errorf("migration %d is dirty", version.Version)
+	}
+	if version.Version < c.schemaVersion {
+		return fmt.Errorf("schema version %d is lower than required %d", version.Version, c.schemaVersion)
+	}
+	return nil
+}
+
+// LivenessHandler отвечает 200, пока процесс жив, и не проверяет зависимости,
+// чтобы недоступность базы не приводила к перезапуску сервиса
+func LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, http.StatusOK, Report{Status: StatusOK})
+}
+
+// ReadinessHandler отвечает 503, если хотя бы одна зависимость недоступна
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+	if report.Status != StatusOK {
+		slog.WarnContext(r.Context(), "Readiness check failed", "checks", report.Checks)
+		writeReport(w, http.StatusServiceUnavailable, report)
+		return
+	}
+	writeReport(w, http.StatusOK, report)
+}
+
+// UpdateGRPCStatus периодически выставляет статус grpc.health.v1 для всего сервера
+// и перечисленных сервисов по результатам проверки готовности
+func (c *Checker) UpdateGRPCStatus(ctx context.Context, server *health.Server, services ...string) {
+	ticker := time.NewTicker(c.cfg.GRPCStatusInterval)
+	defer ticker.Stop()
+
+	for {
+		status := grpc_health_v1.HealthCheckResponse_SERVING
+		if c.Check(ctx).Status != StatusOK {
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+		}
+		server.SetServingStatus("", status)
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func writeReport(w http.ResponseWriter, code int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(report)
+}
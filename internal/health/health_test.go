@@ -0,0 +1,118 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/DarRo9/pvz_service/config"
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type fakeDependencies struct {
+	pingErr    error
+	version    *repository.SchemaVersion
+	versionErr error
+}
+
+func (f *fakeDependencies) Ping(ctx context.Context) error {
+	return f.pingErr
+}
+
+func (f *fakeDependencies) GetSchemaVersion(ctx context.Context) (*repository.SchemaVersion, error) {
+	return f.version, f.versionErr
+}
+
+func TestChecker_ReadinessHandler(t *testing.T) {
+	tests := []struct {
+		name           string
+		deps           *fakeDependencies
+		expectedStatus int
+		expectedChecks map[string]string
+	}{
+		{
+			name:           "ready",
+			deps:           &fakeDependencies{version: &repository.SchemaVersion{Version: 13}},
+			expectedStatus: http.StatusOK,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "ok"},
+		},
+		{
+			name:           "newer schema",
+			deps:           &fakeDependencies{version: &repository.SchemaVersion{Version: 14}},
+			expectedStatus: http.StatusOK,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "ok"},
+		},
+		{
+			name:           "database unavailable",
+			deps:           &fakeDependencies{pingErr: errors.New("connection refused")},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "connection refused", "migrations": "database is unavailable"},
+		},
+		{
+			name:           "old schema",
+			deps:           &fakeDependencies{version: &repository.SchemaVersion{Version: 12}},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "schema version 12 is lower than required 13"},
+		},
+		{
+			name:           "dirty schema",
+			deps:           &fakeDependencies{version: &repository.SchemaVersion{Version: 13, Dirty: true}},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "migration 13 is dirty"},
+		},
+		{
+			name:           "migrations not applied",
+			deps:           &fakeDependencies{versionErr: repository.ErrSchemaVersionNotFound},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedChecks: map[string]string{"database": "ok", "migrations": "schema version not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := NewChecker(tt.deps, 13, config.HealthConfig{})
+
+			req := httptest.NewRequest("GET", "/readyz", nil)
+			w := httptest.NewRecorder()
+
+			checker.ReadinessHandler(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			var report Report
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+			assert.Equal(t, tt.expectedChecks, report.Checks)
+		})
+	}
+}
+
+func TestLivenessHandler(t *testing.T) {
+	req := httptest.NewRequest("GET", "/healthz", nil)
+	w := httptest.NewRecorder()
+
+	LivenessHandler(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"status":"ok"}`, w.Body.String())
+}
+
+func TestChecker_UpdateGRPCStatus(t *testing.T) {
+	checker := NewChecker(&fakeDependencies{pingErr: errors.New("connection refused")}, 13, config.HealthConfig{})
+	server := health.NewServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	checker.UpdateGRPCStatus(ctx, server, "pvz.v1.PVZService")
+
+	for _, service := range []string{"", "pvz.v1.PVZService"} {
+		resp, err := server.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, resp.Status)
+	}
+}
@@ -0,0 +1,57 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNew_AddsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(&buf, slog.LevelInfo)
+
+	ctx := WithRequestID(context.Background(), "req-1")
+	log.With("component", "test").InfoContext(ctx, "PVZ created", "pvz_id", "pvz1")
+	log.DebugContext(ctx, "skipped")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "PVZ created", entry["msg"])
+	assert.Equal(t, "INFO", entry["level"])
+	assert.Equal(t, "req-1", entry["request_id"])
+	assert.Equal(t, "test", entry["component"])
+	assert.Equal(t, "pvz1", entry["pvz_id"])
+}
+
+func TestNew_AddsTraceID(t *testing.T) {
+	var buf bytes.Buffer
+	log := New(&buf, slog.LevelInfo)
+
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	require.NoError(t, err)
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	require.NoError(t, err)
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  spanID,
+	}))
+	log.InfoContext(ctx, "PVZ created")
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", entry["trace_id"])
+	assert.NotContains(t, entry, "request_id")
+}
+
+func TestParseLevel(t *testing.T) {
+	assert.Equal(t, slog.LevelDebug, ParseLevel("debug"))
+	assert.Equal(t, slog.LevelWarn, ParseLevel("WARN"))
+	assert.Equal(t, slog.LevelInfo, ParseLevel(""))
+	assert.Equal(t, slog.LevelInfo, ParseLevel("verbose"))
+}
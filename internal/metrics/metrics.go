@@ -45,4 +45,26 @@ var (
 			Help: "Total number of products added",
 		},
 	)
+
+	ProductsIssuedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "products_issued_total",
+			Help: "Total number of products issued to customers",
+		},
+	)
+
+	ProductsReturnedTotal = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "products_returned_total",
+			Help: "Total number of products returned to sender",
+		},
+	)
+
+	WebhookDeliveriesTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "webhook_deliveries_total",
+			Help: "Total number of webhook delivery attempts by result",
+		},
+		[]string{"result"},
+	)
 )
@@ -9,22 +9,44 @@ import (
 	"github.com/DarRo9/pvz_service/internal/utils"
 )
 
-func AuthMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		tokenString := r.Header.Get("Authorization")
-		if tokenString == "" {
-			http_handler.WriteError(w, http.StatusUnauthorized, "Unauthorized")
-			return
-		}
-		tokenString = strings.TrimPrefix(tokenString, "Bearer ")
-
-		claims, err := utils.ParseJWT(tokenString)
-		if err != nil {
-			http_handler.WriteError(w, http.StatusUnauthorized, "Unauthorized")
-			return
-		}
-
-		ctx := context.WithValue(r.Context(), "user", claims)
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+type TokenRevocationChecker interface {
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+func AuthMiddleware(checker TokenRevocationChecker) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokenString := r.Header.Get("Authorization")
+			if tokenString == "" {
+				http_handler.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+				return
+			}
+			tokenString = strings.TrimPrefix(tokenString, "Bearer ")
+
+			claims, err := utils.ParseJWT(tokenString)
+			if err != nil {
+				http_handler.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+				return
+			}
+
+			jti, ok := claims["jti"].(string)
+			if !ok {
+				http_handler.WriteError(w, http.StatusUnauthorized, "Unauthorized")
+				return
+			}
+
+			revoked, err := checker.IsTokenRevoked(r.Context(), jti)
+			if err != nil {
+				http_handler.WriteError(w, http.StatusInternalServerError, "Failed to check token")
+				return
+			}
+			if revoked {
+				http_handler.WriteError(w, http.StatusUnauthorized, "Token revoked")
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), "user", claims)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
 }
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	http_handler "github.com/DarRo9/pvz_service/internal/handler"
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/service"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	IdempotencyKeyHeader      = "Idempotency-Key"
+	IdempotencyReplayedHeader = "Idempotent-Replayed"
+)
+
+const maxIdempotencyKeyLength = 255
+
+type IdempotencyStore interface {
+	BeginIdempotentRequest(ctx context.Context, userID, key, requestHash string) (*repository.IdempotencyRecord, error)
+	CompleteIdempotentRequest(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error
+	ReleaseIdempotentRequest(ctx context.Context, userID, key string) error
+}
+
+// IdempotencyMiddleware сохраняет первый ответ на запрос с заголовком Idempotency-Key и отдает его
+// на повторы того же пользователя с тем же ключом. Ответы 5xx не сохраняются, чтобы запрос можно было повторить.
+// Должен стоять после AuthMiddleware, так как ключи разделены по пользователям
+func IdempotencyMiddleware(store IdempotencyStore) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				http_handler.WriteError(w, http.StatusBadRequest, "Idempotency-Key is too long")
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				http_handler.WriteError(w, http.StatusBadRequest, "Invalid request")
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			ctx := r.Context()
+			userID := idempotencyUserID(ctx)
+			record, err := store.BeginIdempotentRequest(ctx, userID, key, requestHash(r, body))
+			if err != nil {
+				switch {
+				case errors.Is(err, service.ErrIdempotencyKeyMismatch):
+					http_handler.WriteError(w, http.StatusUnprocessableEntity, err.Error())
+				case errors.Is(err, service.ErrIdempotencyKeyInProgress):
+					http_handler.WriteError(w, http.StatusConflict, err.Error())
+				default:
+					slog.ErrorContext(ctx, "Error checking idempotency key", "error", err)
+					http_handler.WriteError(w, http.StatusInternalServerError, "Failed to check idempotency key")
+				}
+				return
+			}
+			if record != nil {
+				slog.InfoContext(ctx, "Idempotent request replayed")
+				replayResponse(w, record)
+				return
+			}
+
+			rw := newRecordingResponseWriter(w)
+			next.ServeHTTP(rw, r)
+
+			// Клиент мог отключиться, но ответ все равно нужно сохранить для повтора
+			ctx = context.WithoutCancel(ctx)
+			if rw.statusCode >= http.StatusInternalServerError {
+				if err := store.ReleaseIdempotentRequest(ctx, userID, key); err != nil {
+					slog.ErrorContext(ctx, "Error releasing idempotency key", "error", err)
+				}
+				return
+			}
+			if err := store.CompleteIdempotentRequest(ctx, userID, key, rw.statusCode, rw.Header().Get("Content-Type"), rw.body.Bytes()); err != nil {
+				slog.ErrorContext(ctx, "Error saving idempotent response", "error", err)
+			}
+		})
+	}
+}
+
+func idempotencyUserID(ctx context.Context) string {
+	user, _ := ctx.Value("user").(jwt.MapClaims)
+	userID, _ := user["user_id"].(string)
+	return userID
+}
+
+// requestHash позволяет отличить повтор запроса от другого запроса с тем же ключом
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func replayResponse(w http.ResponseWriter, record *repository.IdempotencyRecord) {
+	if record.ContentType != nil && *record.ContentType != "" {
+		w.Header().Set("Content-Type", *record.ContentType)
+	}
+	w.Header().Set(IdempotencyReplayedHeader, "true")
+	w.WriteHeader(*record.StatusCode)
+	w.Write(record.ResponseBody)
+}
+
+// recordingResponseWriter передает ответ клиенту и запоминает его для сохранения
+type recordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+func newRecordingResponseWriter(w http.ResponseWriter) *recordingResponseWriter {
+	return &recordingResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+}
+
+func (rw *recordingResponseWriter) WriteHeader(code int) {
+	rw.statusCode = code
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *recordingResponseWriter) Write(b []byte) (int, error) {
+	rw.body.Write(b)
+	return rw.ResponseWriter.Write(b)
+}
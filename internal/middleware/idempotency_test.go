@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/service"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryIdempotencyStore повторяет поведение сервиса в памяти
+type memoryIdempotencyStore struct {
+	records map[string]*repository.IdempotencyRecord
+}
+
+func newMemoryIdempotencyStore() *memoryIdempotencyStore {
+	return &memoryIdempotencyStore{records: make(map[string]*repository.IdempotencyRecord)}
+}
+
+func (s *memoryIdempotencyStore) BeginIdempotentRequest(ctx context.Context, userID, key, requestHash string) (*repository.IdempotencyRecord, error) {
+	record, ok := s.records[userID+key]
+	if !ok {
+		s.records[userID+key] = &repository.IdempotencyRecord{UserID: userID, Key: key, RequestHash: requestHash}
+		return nil, nil
+	}
+	if record.RequestHash != requestHash {
+		return nil, service.ErrIdempotencyKeyMismatch
+	}
+	if record.StatusCode == nil {
+		return nil, service.ErrIdempotencyKeyInProgress
+	}
+	return record, nil
+}
+
+func (s *memoryIdempotencyStore) CompleteIdempotentRequest(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error {
+	record := s.records[userID+key]
+	record.StatusCode = &statusCode
+	record.ContentType = &contentType
+	record.ResponseBody = body
+	return nil
+}
+
+func (s *memoryIdempotencyStore) ReleaseIdempotentRequest(ctx context.Context, userID, key string) error {
+	delete(s.records, userID+key)
+	return nil
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	store := newMemoryIdempotencyStore()
+	calls := 0
+	status := http.StatusCreated
+	handler := IdempotencyMiddleware(store)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		w.Write(body)
+	}))
+
+	send := func(userID, key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest("POST", "/products", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		ctx := context.WithValue(req.Context(), "user", jwt.MapClaims{"user_id": userID})
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, req.WithContext(ctx))
+		return w
+	}
+
+	t.Run("first request is executed", func(t *testing.T) {
+		w := send("user1", "key1", `{"type":"обувь"}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 1, calls)
+		assert.Empty(t, w.Header().Get(IdempotencyReplayedHeader))
+	})
+
+	t.Run("retry is replayed", func(t *testing.T) {
+		w := send("user1", "key1", `{"type":"обувь"}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, `{"type":"обувь"}`, w.Body.String())
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+		assert.Equal(t, "true", w.Header().Get(IdempotencyReplayedHeader))
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("different payload with same key", func(t *testing.T) {
+		w := send("user1", "key1", `{"type":"одежда"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("same key of another user", func(t *testing.T) {
+		w := send("user2", "key1", `{"type":"одежда"}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("without key", func(t *testing.T) {
+		send("user1", "", `{"type":"обувь"}`)
+		send("user1", "", `{"type":"обувь"}`)
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("server error is not stored", func(t *testing.T) {
+		status = http.StatusInternalServerError
+		send("user1", "key2", `{}`)
+		status = http.StatusCreated
+		w := send("user1", "key2", `{}`)
+		assert.Equal(t, http.StatusCreated, w.Code)
+		assert.Equal(t, 6, calls)
+	})
+
+	t.Run("request in progress", func(t *testing.T) {
+		store.records["user1key3"] = &repository.IdempotencyRecord{RequestHash: requestHash(httptest.NewRequest("POST", "/products", nil), []byte(`{}`))}
+		w := send("user1", "key3", `{}`)
+		assert.Equal(t, http.StatusConflict, w.Code)
+		assert.Equal(t, 6, calls)
+	})
+}
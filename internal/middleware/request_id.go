@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/DarRo9/pvz_service/internal/logger"
+	"github.com/google/uuid"
+)
+
+// maxRequestIDLength ограничивает длину идентификатора, присланного клиентом
+const maxRequestIDLength = 128
+
+// RequestIDMiddleware берет идентификатор запроса из заголовка X-Request-ID или создает новый,
+// кладет его в контекст и возвращает клиенту в том же заголовке
+func RequestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(logger.RequestIDHeader)
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.New().String()
+		}
+
+		w.Header().Set(logger.RequestIDHeader, requestID)
+		ctx := logger.WithRequestID(r.Context(), requestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
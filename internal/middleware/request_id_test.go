@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/DarRo9/pvz_service/internal/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+		keep      bool
+	}{
+		{name: "id from header", requestID: "req-1", keep: true},
+		{name: "generated id", requestID: ""},
+		{name: "too long id is replaced", requestID: strings.Repeat("a", maxRequestIDLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ctxRequestID string
+			handler := RequestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				ctxRequestID = logger.RequestIDFromContext(r.Context())
+			}))
+
+			req := httptest.NewRequest("GET", "/pvz", nil)
+			if tt.requestID != "" {
+				req.Header.Set(logger.RequestIDHeader, tt.requestID)
+			}
+			w := httptest.NewRecorder()
+
+			handler.ServeHTTP(w, req)
+
+			assert.NotEmpty(t, ctxRequestID)
+			assert.Equal(t, ctxRequestID, w.Header().Get(logger.RequestIDHeader))
+			if tt.keep {
+				assert.Equal(t, tt.requestID, ctxRequestID)
+			} else {
+				assert.NotEqual(t, tt.requestID, ctxRequestID)
+			}
+		})
+	}
+}
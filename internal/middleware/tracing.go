@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracingMiddleware создает span на каждый HTTP запрос, продолжая trace из заголовка traceparent.
+// Имя span'а содержит шаблон маршрута chi, а не сам путь, чтобы id в пути не плодили разные имена
+func TracingMiddleware(next http.Handler) http.Handler {
+	return otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next.ServeHTTP(w, r)
+
+		routeCtx := chi.RouteContext(r.Context())
+		if routeCtx == nil {
+			return
+		}
+		if pattern := routeCtx.RoutePattern(); pattern != "" {
+			span := trace.SpanFromContext(r.Context())
+			span.SetName(r.Method + " " + pattern)
+			span.SetAttributes(semconv.HTTPRoute(pattern))
+		}
+	}), "HTTP request")
+}
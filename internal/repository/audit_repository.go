@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreateAuditRecord добавляет запись в журнал аудита. Журнал только дополняется,
+// изменение и удаление записей запрещено триггером в БД
+func (pr *PostgresRepository) CreateAuditRecord(ctx context.Context, record *AuditRecord) error {
+	record.ID = uuid.New().String()
+	record.CreatedAt = time.Now()
+
+	_, err := pr.conn(ctx).ExecContext(ctx,
+		`INSERT INTO audit_log (id, actor_id, actor_role, action, entity_type, entity_id, before, after, request_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		record.ID, record.ActorID, record.ActorRole, record.Action, record.EntityType, record.EntityID,
+		record.Before, record.After, record.RequestID, record.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error inserting audit record: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) ListAuditRecords(ctx context.Context, filter AuditFilter) ([]*AuditRecord, error) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.EntityType != nil {
+		addCondition("entity_type = $%d", *filter.EntityType)
+	}
+	if filter.EntityID != nil {
+		addCondition("entity_id = $%d", *filter.EntityID)
+	}
+	if filter.ActorID != nil {
+		addCondition("actor_id = $%d", *filter.ActorID)
+	}
+	if filter.From != nil {
+		addCondition("created_at >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("created_at <= $%d", *filter.To)
+	}
+
+	query := `SELECT id, actor_id, actor_role, action, entity_type, entity_id, before, after, request_id, created_at
+		FROM audit_log`
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id OFFSET $%d LIMIT $%d", len(args)+1, len(args)+2)
+	args = append(args, (filter.Page-1)*filter.Limit, filter.Limit)
+
+	records := make([]*AuditRecord, 0)
+	if err := pr.conn(ctx).SelectContext(ctx, &records, query, args...); err != nil {
+		return nil, fmt.Errorf("error listing audit records: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAuditRecord(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		actorID := "user1"
+		record := &AuditRecord{
+			ActorID:    &actorID,
+			Action:     "delete",
+			EntityType: "product",
+			EntityID:   "product1",
+			Before:     []byte(`{"id":"product1"}`),
+		}
+
+		mock.ExpectExec(
+			`INSERT INTO audit_log (id, actor_id, actor_role, action, entity_type, entity_id, before, after, request_id, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		).WithArgs(
+			sqlmock.AnyArg(), &actorID, (*string)(nil), "delete", "product", "product1",
+			[]byte(`{"id":"product1"}`), []byte(nil), (*string)(nil), sqlmock.AnyArg(),
+		).WillReturnResult(sqlmock.NewResult(1, 1))
+
+		err := r.CreateAuditRecord(context.Background(), record)
+		require.NoError(t, err)
+		require.NotEmpty(t, record.ID)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
+
+func TestListAuditRecords(t *testing.T) {
+	columns := []string{"id", "actor_id", "actor_role", "action", "entity_type", "entity_id", "before", "after", "request_id", "created_at"}
+
+	testCases := []struct {
+		name   string
+		filter func() AuditFilter
+		query  string
+		args   func() []driver.Value
+	}{
+		{
+			name: "No filters",
+			filter: func() AuditFilter {
+				return AuditFilter{Page: 1, Limit: 10}
+			},
+			query: `SELECT id, actor_id, actor_role, action, entity_type, entity_id, before, after, request_id, created_at
+				FROM audit_log ORDER BY created_at DESC, id OFFSET $1 LIMIT $2`,
+			args: func() []driver.Value {
+				return []driver.Value{0, 10}
+			},
+		},
+		{
+			name: "All filters",
+			filter: func() AuditFilter {
+				entityType, entityID, actorID := "pvz", "pvz1", "user1"
+				return AuditFilter{
+					EntityType: &entityType,
+					EntityID:   &entityID,
+					ActorID:    &actorID,
+					From:       &dummyDate,
+					To:         &dummyDate,
+					Page:       2,
+					Limit:      5,
+				}
+			},
+			query: `SELECT id, actor_id, actor_role, action, entity_type, entity_id, before, after, request_id, created_at
+				FROM audit_log
+				WHERE entity_type = $1 AND entity_id = $2 AND actor_id = $3 AND created_at >= $4 AND created_at <= $5
+				ORDER BY created_at DESC, id OFFSET $6 LIMIT $7`,
+			args: func() []driver.Value {
+				return []driver.Value{"pvz", "pvz1", "user1", dummyDate, dummyDate, 5, 5}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(tc.query).WithArgs(tc.args()...).WillReturnRows(
+					sqlmock.NewRows(columns).
+						AddRow("audit1", "user1", "moderator", "update", "pvz", "pvz1", []byte(`{"city":"Москва"}`), []byte(`{"city":"Казань"}`), nil, dummyDate),
+				)
+
+				records, err := r.ListAuditRecords(context.Background(), tc.filter())
+				require.NoError(t, err)
+				require.Len(t, records, 1)
+				require.Equal(t, "pvz1", records[0].EntityID)
+				require.Equal(t, []byte(`{"city":"Казань"}`), records[0].After)
+				require.Nil(t, records[0].RequestID)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			})
+		})
+	}
+}
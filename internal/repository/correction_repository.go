@@ -0,0 +1,240 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const (
+	CorrectionActionReopen = "reopen"
+	CorrectionActionAmend  = "amend"
+)
+
+var (
+	ErrReceptionNotClosed   = errors.New("reception is not closed")
+	ErrNewerReceptionExists = errors.New("pvz has a newer reception")
+	ErrProductNotAmendable  = errors.New("product is not stored in the reception")
+)
+
+const receptionCorrectionColumns = `id, reception_id, action, reason, changed_by, changes, created_at`
+
+// ReopenReception возвращает закрытую приемку в работу. Это возможно, только пока она последняя в ПВЗ
+func (pr *PostgresRepository) ReopenReception(ctx context.Context, receptionID, reason, reopenedBy string) (*Reception, error) {
+	var rc *Reception
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			var err error
+			rc, err = lockClosedReception(ctx, tx, receptionID)
+			if err != nil {
+				return err
+			}
+
+			pvzStatus, err := selectPVZStatus(ctx, tx, rc.PVZID, "FOR SHARE")
+			if err != nil {
+				return err
+			}
+			if pvzStatus == inactivePVZStatus {
+				return ErrPVZInactive
+			}
+
+			// Новая приемка блокирует последнюю приемку ПВЗ, поэтому параллельно создать ее не успеют
+			var hasNewerReception bool
+			err = tx.GetContext(ctx, &hasNewerReception,
+				`SELECT EXISTS (SELECT 1 FROM reception WHERE pvz_id = $1 AND execution_date > $2)`,
+				rc.PVZID, rc.ExecutionDate,
+			)
+			if err != nil {
+				return fmt.Errorf("error checking newer receptions: %w", err)
+			}
+			if hasNewerReception {
+				return ErrNewerReceptionExists
+			}
+
+			_, err = tx.ExecContext(ctx,
+				`UPDATE reception SET status = $2 WHERE id = $1`,
+				rc.ID, reopenedReceptionStatus,
+			)
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+				return ErrReceptionInProgress
+			}
+			if err != nil {
+				return fmt.Errorf("error updating reception status: %w", err)
+			}
+			rc.Status = reopenedReceptionStatus
+
+			correction := newReceptionCorrection(rc.ID, CorrectionActionReopen, reason, reopenedBy)
+			if err := insertReceptionCorrection(ctx, tx, correction); err != nil {
+				return err
+			}
+			return insertOutboxEvent(ctx, tx, EventReceptionReopened, rc.ID, rc)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error reopening reception: %w", err)
+	}
+
+	return rc, nil
+}
+
+// AmendReception исправляет состав закрытой приемки одной транзакцией и сохраняет исправление.
+// Если к приемке загружен манифест, сверка пересчитывается через reconcile
+func (pr *PostgresRepository) AmendReception(ctx context.Context, receptionID string, amendment ReceptionAmendment, reconcile ReconcileFunc) (*ReceptionCorrection, error) {
+	correction := newReceptionCorrection(receptionID, CorrectionActionAmend, amendment.Reason, amendment.AmendedBy)
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			rc, err := lockClosedReception(ctx, tx, receptionID)
+			if err != nil {
+				return err
+			}
+
+			if len(amendment.RemoveProductIDs) > 0 {
+				err = tx.SelectContext(ctx, &correction.Changes.Removed,
+					`DELETE FROM product
+					WHERE reception_id = $1 AND id = ANY($2) AND status = $3
+					RETURNING `+productColumns,
+					rc.ID, pq.Array(amendment.RemoveProductIDs), storedProductStatus,
+				)
+				if err != nil {
+					return fmt.Errorf("error deleting products: %w", err)
+				}
+				// Выданные, возвращенные и чужие товары удалить нельзя
+				if len(correction.Changes.Removed) != len(amendment.RemoveProductIDs) {
+					return ErrProductNotAmendable
+				}
+			}
+
+			if len(amendment.AddProducts) > 0 {
+				products := newReceptionProducts(rc.ID, amendment.AddProducts)
+				scanned, err := scannedBarcodes(ctx, tx, rc.ID, products)
+				if err != nil {
+					return err
+				}
+				for _, product := range products {
+					if product.Barcode == nil {
+						continue
+					}
+					if scanned[*product.Barcode] {
+						return ErrDuplicateBarcode
+					}
+					scanned[*product.Barcode] = true
+				}
+
+				if err := insertProducts(ctx, tx, products); err != nil {
+					return err
+				}
+				if err := storeReceptionProducts(ctx, tx, rc.ID); err != nil {
+					return err
+				}
+				for _, product := range products {
+					product.Status = storedProductStatus
+				}
+				correction.Changes.Added = products
+			}
+
+			if reconcile != nil {
+				if err := reconcileReception(ctx, tx, rc.ID, reconcile); err != nil {
+					return err
+				}
+			}
+
+			_, err = tx.ExecContext(ctx,
+				`UPDATE reception SET status = $2 WHERE id = $1`,
+				rc.ID, amendedReceptionStatus,
+			)
+			if err != nil {
+				return fmt.Errorf("error updating reception status: %w", err)
+			}
+
+			if err := insertReceptionCorrection(ctx, tx, correction); err != nil {
+				return err
+			}
+			return insertOutboxEvent(ctx, tx, EventReceptionAmended, rc.ID, correction)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error amending reception: %w", err)
+	}
+
+	return correction, nil
+}
+
+func (pr *PostgresRepository) ListReceptionCorrections(ctx context.Context, receptionID string) ([]*ReceptionCorrection, error) {
+	corrections := make([]*ReceptionCorrection, 0)
+	err := pr.conn(ctx).SelectContext(ctx, &corrections,
+		`SELECT `+receptionCorrectionColumns+`
+		FROM reception_correction
+		WHERE reception_id = $1
+		ORDER BY created_at, id`,
+		receptionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing reception corrections: %w", err)
+	}
+
+	return corrections, nil
+}
+
+// lockClosedReception блокирует приемку до конца транзакции и проверяет, что она закрыта
+func lockClosedReception(ctx context.Context, tx *sqlx.Tx, receptionID string) (*Reception, error) {
+	rc := &Reception{}
+	err := tx.GetContext(ctx, rc,
+		`SELECT id, execution_date, pvz_id, status FROM reception WHERE id = $1 FOR UPDATE`,
+		receptionID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReceptionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting reception: %w", err)
+	}
+	if !isClosedReceptionStatus(rc.Status) {
+		return nil, ErrReceptionNotClosed
+	}
+	return rc, nil
+}
+
+func newReceptionCorrection(receptionID, action, reason, changedBy string) *ReceptionCorrection {
+	correction := &ReceptionCorrection{
+		ID:          uuid.New().String(),
+		ReceptionID: receptionID,
+		Action:      action,
+		Reason:      reason,
+		Changes: ReceptionChanges{
+			Added:   make([]*Product, 0),
+			Removed: make([]*Product, 0),
+		},
+		CreatedAt: time.Now(),
+	}
+	if changedBy != "" {
+		correction.ChangedBy = &changedBy
+	}
+	return correction
+}
+
+func insertReceptionCorrection(ctx context.Context, tx *sqlx.Tx, correction *ReceptionCorrection) error {
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO reception_correction (`+receptionCorrectionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		correction.ID,
+		correction.ReceptionID,
+		correction.Action,
+		correction.Reason,
+		correction.ChangedBy,
+		correction.Changes,
+		correction.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving reception correction: %w", err)
+	}
+	return nil
+}
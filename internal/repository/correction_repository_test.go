@@ -0,0 +1,313 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	lockReceptionQuery = `SELECT id, execution_date, pvz_id, status FROM reception WHERE id = $1 FOR UPDATE`
+	correctionQuery    = `INSERT INTO reception_correction (id, reception_id, action, reason, changed_by, changes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`
+)
+
+func expectLockReception(mock sqlmock.Sqlmock, status string) {
+	mock.ExpectQuery(lockReceptionQuery).WithArgs("rc1").WillReturnRows(
+		sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).
+			AddRow("rc1", dummyDate, "pvz1", status),
+	)
+}
+
+func expectReceptionCorrection(mock sqlmock.Sqlmock, action string) {
+	mock.ExpectExec(correctionQuery).WithArgs(
+		sqlmock.AnyArg(), "rc1", action, "ошибка сотрудника", "moderator1", sqlmock.AnyArg(), sqlmock.AnyArg(),
+	).WillReturnResult(sqlmock.NewResult(1, 1))
+}
+
+func TestReopenReception(t *testing.T) {
+	const newerQuery = `SELECT EXISTS (SELECT 1 FROM reception WHERE pvz_id = $1 AND execution_date > $2)`
+	const updateQuery = `UPDATE reception SET status = $2 WHERE id = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, amendedReceptionStatus)
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
+				mock.ExpectQuery(newerQuery).WithArgs("pvz1", dummyDate).WillReturnRows(
+					sqlmock.NewRows([]string{"exists"}).AddRow(false),
+				)
+				mock.ExpectExec(updateQuery).WithArgs("rc1", reopenedReceptionStatus).WillReturnResult(
+					sqlmock.NewResult(0, 1),
+				)
+				expectReceptionCorrection(mock, CorrectionActionReopen)
+				expectOutboxEvent(mock, EventReceptionReopened)
+				mock.ExpectCommit()
+
+				rc, err := r.ReopenReception(context.Background(), "rc1", "ошибка сотрудника", "moderator1")
+				require.NoError(t, err)
+				require.Equal(t, &Reception{
+					ID:            "rc1",
+					PVZID:         "pvz1",
+					Status:        reopenedReceptionStatus,
+					ExecutionDate: dummyDate,
+				}, rc)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Reception in progress",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, inProgressReceptionStatus)
+				mock.ExpectRollback()
+
+				_, err := r.ReopenReception(context.Background(), "rc1", "ошибка сотрудника", "moderator1")
+				require.ErrorIs(t, err, ErrReceptionNotClosed)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Newer reception exists",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, closeReceptionStatus)
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
+				mock.ExpectQuery(newerQuery).WithArgs("pvz1", dummyDate).WillReturnRows(
+					sqlmock.NewRows([]string{"exists"}).AddRow(true),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.ReopenReception(context.Background(), "rc1", "ошибка сотрудника", "moderator1")
+				require.ErrorIs(t, err, ErrNewerReceptionExists)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Inactive pvz",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, closeReceptionStatus)
+				expectPVZStatus(mock, inactivePVZStatus, "FOR SHARE")
+				mock.ExpectRollback()
+
+				_, err := r.ReopenReception(context.Background(), "rc1", "ошибка сотрудника", "moderator1")
+				require.ErrorIs(t, err, ErrPVZInactive)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Reception not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(lockReceptionQuery).WithArgs("rc1").WillReturnRows(
+					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.ReopenReception(context.Background(), "rc1", "ошибка сотрудника", "moderator1")
+				require.ErrorIs(t, err, ErrReceptionNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestAmendReception(t *testing.T) {
+	const deleteQuery = `DELETE FROM product
+		WHERE reception_id = $1 AND id = ANY($2) AND status = $3
+		RETURNING id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes`
+	const barcodesQuery = `SELECT barcode FROM product
+		WHERE reception_id = $1 AND barcode = ANY($2)`
+	const insertQuery = `INSERT INTO product (id, reception_date, reception_id, type, status,
+			barcode, weight_grams, length_mm, width_mm, height_mm, attributes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+	const storeQuery = `WITH stored AS (
+			UPDATE product
+			SET status = $2
+			WHERE reception_id = $1 AND status = $3
+			RETURNING id
+		)
+		INSERT INTO product_status_history (product_id, from_status, to_status, changed_at)
+		SELECT id, $3, $2, $4 FROM stored`
+	const updateQuery = `UPDATE reception SET status = $2 WHERE id = $1`
+
+	barcode := "4601234567890"
+	productRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id", "status", "barcode"})
+	}
+	amendment := ReceptionAmendment{
+		AddProducts:      []NewProduct{{Type: "обувь", ProductDetails: ProductDetails{Barcode: &barcode}}},
+		RemoveProductIDs: []string{"p1"},
+		Reason:           "ошибка сотрудника",
+		AmendedBy:        "moderator1",
+	}
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, closeReceptionStatus)
+				mock.ExpectQuery(deleteQuery).WithArgs("rc1", pq.Array([]string{"p1"}), storedProductStatus).WillReturnRows(
+					productRows().AddRow("p1", "одежда", dummyDate, "rc1", storedProductStatus, barcode),
+				)
+				mock.ExpectQuery(barcodesQuery).WithArgs("rc1", pq.Array([]string{barcode})).WillReturnRows(
+					sqlmock.NewRows([]string{"barcode"}),
+				)
+				mock.ExpectExec(insertQuery).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(storeQuery).WithArgs(
+					"rc1", storedProductStatus, acceptedProductStatus, sqlmock.AnyArg(),
+				).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(updateQuery).WithArgs("rc1", amendedReceptionStatus).WillReturnResult(
+					sqlmock.NewResult(0, 1),
+				)
+				expectReceptionCorrection(mock, CorrectionActionAmend)
+				expectOutboxEvent(mock, EventReceptionAmended)
+				mock.ExpectCommit()
+
+				correction, err := r.AmendReception(context.Background(), "rc1", amendment, nil)
+				require.NoError(t, err)
+				require.Equal(t, CorrectionActionAmend, correction.Action)
+				require.Equal(t, "moderator1", *correction.ChangedBy)
+				require.Len(t, correction.Changes.Removed, 1)
+				require.Equal(t, "p1", correction.Changes.Removed[0].ID)
+				require.Len(t, correction.Changes.Added, 1)
+				require.Equal(t, storedProductStatus, correction.Changes.Added[0].Status)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Product already issued",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, amendedReceptionStatus)
+				mock.ExpectQuery(deleteQuery).WithArgs("rc1", pq.Array([]string{"p1"}), storedProductStatus).WillReturnRows(
+					productRows(),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.AmendReception(context.Background(), "rc1", amendment, nil)
+				require.ErrorIs(t, err, ErrProductNotAmendable)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Duplicate barcode",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, closeReceptionStatus)
+				mock.ExpectQuery(barcodesQuery).WithArgs("rc1", pq.Array([]string{barcode})).WillReturnRows(
+					sqlmock.NewRows([]string{"barcode"}).AddRow(barcode),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.AmendReception(context.Background(), "rc1", ReceptionAmendment{
+					AddProducts: amendment.AddProducts,
+					Reason:      amendment.Reason,
+					AmendedBy:   amendment.AmendedBy,
+				}, nil)
+				require.ErrorIs(t, err, ErrDuplicateBarcode)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Reception reopened",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectLockReception(mock, reopenedReceptionStatus)
+				mock.ExpectRollback()
+
+				_, err := r.AmendReception(context.Background(), "rc1", amendment, nil)
+				require.ErrorIs(t, err, ErrReceptionNotClosed)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestListReceptionCorrections(t *testing.T) {
+	query := `SELECT id, reception_id, action, reason, changed_by, changes, created_at
+		FROM reception_correction
+		WHERE reception_id = $1
+		ORDER BY created_at, id`
+
+	t.Run("Success", func(t *testing.T) {
+		withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(query).WithArgs("rc1").WillReturnRows(
+				sqlmock.NewRows([]string{"id", "reception_id", "action", "reason", "changed_by", "changes", "created_at"}).
+					AddRow("c1", "rc1", CorrectionActionReopen, "ошибка сотрудника", "moderator1", `{"added":[],"removed":[]}`, dummyDate).
+					AddRow("c2", "rc1", CorrectionActionAmend, "пересорт", "moderator1", `{"added":[],"removed":[{"id":"p1","type":"обувь"}]}`, dummyDate),
+			)
+
+			corrections, err := r.ListReceptionCorrections(context.Background(), "rc1")
+			require.NoError(t, err)
+			require.Len(t, corrections, 2)
+			require.Equal(t, CorrectionActionReopen, corrections[0].Action)
+			require.Empty(t, corrections[0].Changes.Added)
+			require.Equal(t, "p1", corrections[1].Changes.Removed[0].ID)
+
+			err = mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(query).WithArgs("rc1").WillReturnError(fmt.Errorf("db error"))
+
+			_, err := r.ListReceptionCorrections(context.Background(), "rc1")
+			require.Error(t, err)
+
+			err = mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	})
+}
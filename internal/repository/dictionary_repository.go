@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	citiesTable       = "cities"
+	productTypesTable = "product_types"
+)
+
+var (
+	ErrDictionaryEntryExists   = errors.New("dictionary entry already exists")
+	ErrDictionaryEntryNotFound = errors.New("dictionary entry not found")
+)
+
+func (pr *PostgresRepository) ListCities(ctx context.Context) ([]*DictionaryEntry, error) {
+	return pr.listDictionary(ctx, citiesTable)
+}
+
+func (pr *PostgresRepository) GetCity(ctx context.Context, name string) (*DictionaryEntry, error) {
+	return pr.getDictionaryEntry(ctx, citiesTable, name)
+}
+
+func (pr *PostgresRepository) CreateCity(ctx context.Context, name string) (*DictionaryEntry, error) {
+	return pr.createDictionaryEntry(ctx, citiesTable, name)
+}
+
+func (pr *PostgresRepository) DeleteCity(ctx context.Context, name string) error {
+	return pr.deleteDictionaryEntry(ctx, citiesTable, name)
+}
+
+func (pr *PostgresRepository) SeedCities(ctx context.Context, names []string) error {
+	return pr.seedDictionary(ctx, citiesTable, names)
+}
+
+func (pr *PostgresRepository) ListProductTypes(ctx context.Context) ([]*DictionaryEntry, error) {
+	return pr.listDictionary(ctx, productTypesTable)
+}
+
+func (pr *PostgresRepository) GetProductType(ctx context.Context, name string) (*DictionaryEntry, error) {
+	return pr.getDictionaryEntry(ctx, productTypesTable, name)
+}
+
+func (pr *PostgresRepository) CreateProductType(ctx context.Context, name string) (*DictionaryEntry, error) {
+	return pr.createDictionaryEntry(ctx, productTypesTable, name)
+}
+
+func (pr *PostgresRepository) DeleteProductType(ctx context.Context, name string) error {
+	return pr.deleteDictionaryEntry(ctx, productTypesTable, name)
+}
+
+func (pr *PostgresRepository) SeedProductTypes(ctx context.Context, names []string) error {
+	return pr.seedDictionary(ctx, productTypesTable, names)
+}
+
+func (pr *PostgresRepository) listDictionary(ctx context.Context, table string) ([]*DictionaryEntry, error) {
+	entries := make([]*DictionaryEntry, 0)
+	err := pr.conn(ctx).SelectContext(ctx, &entries, `SELECT name, created_at FROM `+table+` ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("error listing %s: %w", table, err)
+	}
+
+	return entries, nil
+}
+
+func (pr *PostgresRepository) getDictionaryEntry(ctx context.Context, table, name string) (*DictionaryEntry, error) {
+	entry := &DictionaryEntry{}
+	err := pr.conn(ctx).GetContext(ctx, entry, `SELECT name, created_at FROM `+table+` WHERE name = $1`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDictionaryEntryNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting from %s: %w", table, err)
+	}
+
+	return entry, nil
+}
+
+func (pr *PostgresRepository) createDictionaryEntry(ctx context.Context, table, name string) (*DictionaryEntry, error) {
+	entry := &DictionaryEntry{
+		Name:      name,
+		CreatedAt: time.Now(),
+	}
+	_, err := pr.conn(ctx).ExecContext(ctx,
+		`INSERT INTO `+table+` (name, created_at) VALUES ($1, $2)`,
+		entry.Name, entry.CreatedAt,
+	)
+
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+		return nil, ErrDictionaryEntryExists
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error inserting into %s: %w", table, err)
+	}
+
+	return entry, nil
+}
+
+func (pr *PostgresRepository) deleteDictionaryEntry(ctx context.Context, table, name string) error {
+	result, err := pr.conn(ctx).ExecContext(ctx, `DELETE FROM `+table+` WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("error deleting from %s: %w", table, err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting from %s: %w", table, err)
+	}
+	if affected == 0 {
+		return ErrDictionaryEntryNotFound
+	}
+
+	return nil
+}
+
+// seedDictionary заполняет справочник начальными значениями, только если он еще пуст,
+// чтобы значения, удаленные через API, не возвращались после перезапуска
+func (pr *PostgresRepository) seedDictionary(ctx context.Context, table string, names []string) error {
+	_, err := pr.conn(ctx).ExecContext(ctx,
+		`INSERT INTO `+table+` (name)
+		SELECT unnest($1::text[])
+		WHERE NOT EXISTS (SELECT 1 FROM `+table+`)
+		ON CONFLICT (name) DO NOTHING`,
+		pq.Array(names),
+	)
+	if err != nil {
+		return fmt.Errorf("error seeding %s: %w", table, err)
+	}
+
+	return nil
+}
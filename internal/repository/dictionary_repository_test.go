@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCities(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(
+			`SELECT name, created_at FROM cities ORDER BY name`,
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"name", "created_at"}).
+				AddRow("Казань", dummyDate).
+				AddRow("Москва", dummyDate),
+		)
+
+		cities, err := r.ListCities(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []*DictionaryEntry{
+			{Name: "Казань", CreatedAt: dummyDate},
+			{Name: "Москва", CreatedAt: dummyDate},
+		}, cities)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
+
+func TestCreateProductType(t *testing.T) {
+	query := `INSERT INTO product_types (name, created_at) VALUES ($1, $2)`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					"книги", sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+
+				productType, err := r.CreateProductType(context.Background(), "книги")
+				require.NoError(t, err)
+				require.Equal(t, "книги", productType.Name)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error already exists",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnError(
+					&pq.Error{Code: uniqueViolationCode},
+				)
+
+				_, err := r.CreateProductType(context.Background(), "обувь")
+				require.ErrorIs(t, err, ErrDictionaryEntryExists)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error inserting",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnError(
+					fmt.Errorf("error inserting product type"),
+				)
+
+				_, err := r.CreateProductType(context.Background(), "книги")
+				require.Error(t, err)
+				require.NotErrorIs(t, err, ErrDictionaryEntryExists)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestGetCity(t *testing.T) {
+	query := `SELECT name, created_at FROM cities WHERE name = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"Казань",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"name", "created_at"}).AddRow("Казань", dummyDate),
+				)
+
+				entry, err := r.GetCity(context.Background(), "Казань")
+				require.NoError(t, err)
+				require.Equal(t, &DictionaryEntry{Name: "Казань", CreatedAt: dummyDate}, entry)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error city not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetCity(context.Background(), "Париж")
+				require.ErrorIs(t, err, ErrDictionaryEntryNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestDeleteCity(t *testing.T) {
+	query := `DELETE FROM cities WHERE name = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					"Казань",
+				).WillReturnResult(
+					sqlmock.NewResult(0, 1),
+				)
+
+				err := r.DeleteCity(context.Background(), "Казань")
+				require.NoError(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error city not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 0),
+				)
+
+				err := r.DeleteCity(context.Background(), "Париж")
+				require.ErrorIs(t, err, ErrDictionaryEntryNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestSeedCities(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			`INSERT INTO cities (name)
+			SELECT unnest($1::text[])
+			WHERE NOT EXISTS (SELECT 1 FROM cities)
+			ON CONFLICT (name) DO NOTHING`,
+		).WithArgs(
+			sqlmock.AnyArg(),
+		).WillReturnResult(
+			sqlmock.NewResult(0, 2),
+		)
+
+		err := r.SeedCities(context.Background(), []string{"Москва", "Казань"})
+		require.NoError(t, err)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
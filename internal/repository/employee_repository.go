@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const employeeRole = "employee"
+
+var (
+	ErrUserNotFound       = errors.New("user not found")
+	ErrUserNotEmployee    = errors.New("user is not an employee")
+	ErrAssignmentExists   = errors.New("employee is already assigned to pvz")
+	ErrAssignmentNotFound = errors.New("employee is not assigned to pvz")
+)
+
+func (pr *PostgresRepository) ListPVZEmployees(ctx context.Context, PVZID string) ([]*PVZEmployee, error) {
+	employees := make([]*PVZEmployee, 0)
+	err := pr.conn(ctx).SelectContext(
+		ctx,
+		&employees,
+		`SELECT e.pvz_id, e.user_id, u.email, e.assigned_at
+		FROM pvz_employees e
+		JOIN users u ON u.id = e.user_id
+		WHERE e.pvz_id = $1
+		ORDER BY e.assigned_at`,
+		PVZID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pvz employees: %w", err)
+	}
+
+	return employees, nil
+}
+
+// AssignEmployee закрепляет сотрудника за ПВЗ. Назначить можно только пользователя
+// с ролью employee и только в активный ПВЗ
+func (pr *PostgresRepository) AssignEmployee(ctx context.Context, PVZID, userID string) (*PVZEmployee, error) {
+	employee := &PVZEmployee{
+		PVZID:      PVZID,
+		UserID:     userID,
+		AssignedAt: time.Now(),
+	}
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			var role string
+			err := tx.QueryRowContext(ctx,
+				`SELECT email, role FROM users WHERE id = $1`,
+				userID,
+			).Scan(&employee.Email, &role)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			if err != nil {
+				return fmt.Errorf("error getting user: %w", err)
+			}
+			if role != employeeRole {
+				return ErrUserNotEmployee
+			}
+
+			status, err := selectPVZStatus(ctx, tx, PVZID, "FOR SHARE")
+			if err != nil {
+				return err
+			}
+			if status == inactivePVZStatus {
+				return ErrPVZInactive
+			}
+
+			_, err = tx.ExecContext(ctx,
+				`INSERT INTO pvz_employees (pvz_id, user_id, assigned_at) VALUES ($1, $2, $3)`,
+				employee.PVZID, employee.UserID, employee.AssignedAt,
+			)
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+				return ErrAssignmentExists
+			}
+			if err != nil {
+				return fmt.Errorf("error inserting pvz employee: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error assigning employee: %w", err)
+	}
+
+	return employee, nil
+}
+
+func (pr *PostgresRepository) UnassignEmployee(ctx context.Context, PVZID, userID string) error {
+	result, err := pr.conn(ctx).ExecContext(ctx,
+		`DELETE FROM pvz_employees WHERE pvz_id = $1 AND user_id = $2`,
+		PVZID, userID,
+	)
+	if err != nil {
+		return fmt.Errorf("error deleting pvz employee: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting pvz employee: %w", err)
+	}
+	if affected == 0 {
+		return ErrAssignmentNotFound
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) IsEmployeeAssigned(ctx context.Context, PVZID, userID string) (bool, error) {
+	var assigned bool
+	err := pr.conn(ctx).GetContext(ctx, &assigned,
+		`SELECT EXISTS (SELECT 1 FROM pvz_employees WHERE pvz_id = $1 AND user_id = $2)`,
+		PVZID, userID,
+	)
+	if err != nil {
+		return false, fmt.Errorf("error checking pvz employee: %w", err)
+	}
+
+	return assigned, nil
+}
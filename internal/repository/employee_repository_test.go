@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPVZEmployees(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(
+			`SELECT e.pvz_id, e.user_id, u.email, e.assigned_at
+			FROM pvz_employees e
+			JOIN users u ON u.id = e.user_id
+			WHERE e.pvz_id = $1
+			ORDER BY e.assigned_at`,
+		).WithArgs(
+			"pvz1",
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"pvz_id", "user_id", "email", "assigned_at"}).
+				AddRow("pvz1", "user1", "employee@example.com", dummyDate),
+		)
+
+		employees, err := r.ListPVZEmployees(context.Background(), "pvz1")
+		require.NoError(t, err)
+		require.Equal(t, []*PVZEmployee{
+			{PVZID: "pvz1", UserID: "user1", Email: "employee@example.com", AssignedAt: dummyDate},
+		}, employees)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
+
+func TestAssignEmployee(t *testing.T) {
+	userQuery := `SELECT email, role FROM users WHERE id = $1`
+	insertQuery := `INSERT INTO pvz_employees (pvz_id, user_id, assigned_at) VALUES ($1, $2, $3)`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					userQuery,
+				).WithArgs(
+					"user1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"email", "role"}).AddRow("employee@example.com", employeeRole),
+				)
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
+				mock.ExpectExec(
+					insertQuery,
+				).WithArgs(
+					"pvz1", "user1", sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				mock.ExpectCommit()
+
+				employee, err := r.AssignEmployee(context.Background(), "pvz1", "user1")
+				require.NoError(t, err)
+				require.Equal(t, "employee@example.com", employee.Email)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error user not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					userQuery,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+				mock.ExpectRollback()
+
+				_, err := r.AssignEmployee(context.Background(), "pvz1", "user1")
+				require.ErrorIs(t, err, ErrUserNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error user is moderator",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					userQuery,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"email", "role"}).AddRow("moderator@example.com", "moderator"),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.AssignEmployee(context.Background(), "pvz1", "user1")
+				require.ErrorIs(t, err, ErrUserNotEmployee)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error pvz inactive",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					userQuery,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"email", "role"}).AddRow("employee@example.com", employeeRole),
+				)
+				expectPVZStatus(mock, inactivePVZStatus, "FOR SHARE")
+				mock.ExpectRollback()
+
+				_, err := r.AssignEmployee(context.Background(), "pvz1", "user1")
+				require.ErrorIs(t, err, ErrPVZInactive)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error already assigned",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					userQuery,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"email", "role"}).AddRow("employee@example.com", employeeRole),
+				)
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
+				mock.ExpectExec(
+					insertQuery,
+				).WillReturnError(
+					&pq.Error{Code: uniqueViolationCode},
+				)
+				mock.ExpectRollback()
+
+				_, err := r.AssignEmployee(context.Background(), "pvz1", "user1")
+				require.ErrorIs(t, err, ErrAssignmentExists)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestUnassignEmployee(t *testing.T) {
+	query := `DELETE FROM pvz_employees WHERE pvz_id = $1 AND user_id = $2`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					"pvz1", "user1",
+				).WillReturnResult(
+					sqlmock.NewResult(0, 1),
+				)
+
+				err := r.UnassignEmployee(context.Background(), "pvz1", "user1")
+				require.NoError(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error not assigned",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 0),
+				)
+
+				err := r.UnassignEmployee(context.Background(), "pvz1", "user1")
+				require.ErrorIs(t, err, ErrAssignmentNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestIsEmployeeAssigned(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(
+			`SELECT EXISTS (SELECT 1 FROM pvz_employees WHERE pvz_id = $1 AND user_id = $2)`,
+		).WithArgs(
+			"pvz1", "user1",
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"exists"}).AddRow(true),
+		)
+
+		assigned, err := r.IsEmployeeAssigned(context.Background(), "pvz1", "user1")
+		require.NoError(t, err)
+		require.True(t, assigned)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
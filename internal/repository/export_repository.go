@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// exportFetchSize - сколько строк выгрузки читается из курсора за раз
+const exportFetchSize = 500
+
+// ExportReceptions читает приемки с товарами через серверный курсор и передает строки в fn по одной,
+// не загружая выборку в память. Фильтр по дате приемки такой же, как в ListPVZ
+func (pr *PostgresRepository) ExportReceptions(ctx context.Context, startDate, endDate *time.Time, fn func(*ReceptionExportRow) error) error {
+	args := make([]interface{}, 0)
+	whereClause := ""
+
+	if startDate != nil && endDate != nil {
+		whereClause = "WHERE r.execution_date BETWEEN $1 AND $2"
+		args = append(args, startDate, endDate)
+	} else if startDate != nil {
+		whereClause = "WHERE r.execution_date >= $1"
+		args = append(args, startDate)
+	} else if endDate != nil {
+		whereClause = "WHERE r.execution_date <= $1"
+		args = append(args, endDate)
+	}
+
+	query := `DECLARE export_receptions NO SCROLL CURSOR FOR
+		SELECT p.id AS pvz_id, p.city, p.street, p.house,
+			r.id AS reception_id, r.execution_date AS reception_date, r.status AS reception_status,
+			pr.id AS product_id, pr.type AS product_type, pr.status AS product_status, pr.barcode,
+			pr.reception_date AS product_date
+		FROM reception r
+		JOIN pvz p ON p.id = r.pvz_id
+		LEFT JOIN product pr ON pr.reception_id = r.id
+		` + whereClause + `
+		ORDER BY r.execution_date, r.id, pr.reception_date`
+
+	return pr.ExecTx(ctx, func(tx *sqlx.Tx) error {
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("error declaring export cursor: %w", err)
+		}
+
+		for {
+			fetched, err := fetchExportRows(ctx, tx, fn)
+			if err != nil {
+				return err
+			}
+			if fetched < exportFetchSize {
+				return nil
+			}
+		}
+	})
+}
+
+func fetchExportRows(ctx context.Context, tx *sqlx.Tx, fn func(*ReceptionExportRow) error) (int, error) {
+	rows, err := tx.QueryxContext(ctx, fmt.Sprintf("FETCH %d FROM export_receptions", exportFetchSize))
+	if err != nil {
+		return 0, fmt.Errorf("error fetching export rows: %w", err)
+	}
+	defer rows.Close()
+
+	fetched := 0
+	for rows.Next() {
+		var row ReceptionExportRow
+		if err := rows.StructScan(&row); err != nil {
+			return fetched, fmt.Errorf("error scanning export row: %w", err)
+		}
+		fetched++
+		if err := fn(&row); err != nil {
+			return fetched, err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fetched, fmt.Errorf("error fetching export rows: %w", err)
+	}
+	return fetched, nil
+}
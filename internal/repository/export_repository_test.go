@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+const exportCursorQuery = `DECLARE export_receptions NO SCROLL CURSOR FOR
+	SELECT p.id AS pvz_id, p.city, p.street, p.house,
+		r.id AS reception_id, r.execution_date AS reception_date, r.status AS reception_status,
+		pr.id AS product_id, pr.type AS product_type, pr.status AS product_status, pr.barcode,
+		pr.reception_date AS product_date
+	FROM reception r
+	JOIN pvz p ON p.id = r.pvz_id
+	LEFT JOIN product pr ON pr.reception_id = r.id
+	WHERE r.execution_date BETWEEN $1 AND $2
+	ORDER BY r.execution_date, r.id, pr.reception_date`
+
+var exportColumns = []string{
+	"pvz_id", "city", "street", "house", "reception_id", "reception_date", "reception_status",
+	"product_id", "product_type", "product_status", "barcode", "product_date",
+}
+
+func TestExportReceptions(t *testing.T) {
+	startDate, endDate := dummyDate, dummyDate.Add(time.Hour)
+
+	testCases := []struct {
+		name string
+		test func(t *testing.T, r Repository, mock sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(exportCursorQuery).WithArgs(startDate, endDate).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`FETCH 500 FROM export_receptions`).WillReturnRows(
+					sqlmock.NewRows(exportColumns).
+						AddRow("pvz1", "Москва", "Тверская", "1", "rc1", dummyDate, "close", "pr1", "обувь", "accepted", "4600000000001", dummyDate).
+						AddRow("pvz1", "Москва", nil, nil, "rc2", dummyDate, "in_progress", nil, nil, nil, nil, nil),
+				)
+				mock.ExpectCommit()
+
+				var rows []*ReceptionExportRow
+				err := r.ExportReceptions(context.Background(), &startDate, &endDate, func(row *ReceptionExportRow) error {
+					rows = append(rows, row)
+					return nil
+				})
+				require.NoError(t, err)
+				require.Len(t, rows, 2)
+				require.Equal(t, "обувь", *rows[0].ProductType)
+				require.Nil(t, rows[1].ProductID)
+				require.Nil(t, rows[1].ProductDate)
+			},
+		},
+		{
+			name: "Callback error",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				writeErr := errors.New("client disconnected")
+				mock.ExpectBegin()
+				mock.ExpectExec(exportCursorQuery).WithArgs(startDate, endDate).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(`FETCH 500 FROM export_receptions`).WillReturnRows(
+					sqlmock.NewRows(exportColumns).
+						AddRow("pvz1", "Москва", nil, nil, "rc1", dummyDate, "close", nil, nil, nil, nil, nil),
+				)
+				mock.ExpectRollback()
+
+				err := r.ExportReceptions(context.Background(), &startDate, &endDate, func(row *ReceptionExportRow) error {
+					return writeErr
+				})
+				require.ErrorIs(t, err, writeErr)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+
+				err := mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			})
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+)
+
+var ErrSchemaVersionNotFound = errors.New("schema version not found")
+
+func (pr *PostgresRepository) Ping(ctx context.Context) error {
+	if err := pr.db.PingContext(ctx); err != nil {
+		return fmt.Errorf("error pinging database: %w", err)
+	}
+	return nil
+}
+
+func (pr *PostgresRepository) GetSchemaVersion(ctx context.Context) (*SchemaVersion, error) {
+	var version SchemaVersion
+	err := pr.db.GetContext(ctx, &version, `SELECT version, dirty FROM schema_migrations LIMIT 1`)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrSchemaVersionNotFound
+		}
+		return nil, fmt.Errorf("error getting schema version: %w", err)
+	}
+	return &version, nil
+}
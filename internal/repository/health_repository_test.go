@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSchemaVersion(t *testing.T) {
+	query := `SELECT version, dirty FROM schema_migrations LIMIT 1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"version", "dirty"}).AddRow(13, false),
+				)
+
+				version, err := r.GetSchemaVersion(context.Background())
+				require.NoError(t, err)
+				require.Equal(t, int64(13), version.Version)
+				require.False(t, version.Dirty)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(sql.ErrNoRows)
+
+				_, err := r.GetSchemaVersion(context.Background())
+				require.ErrorIs(t, err, ErrSchemaVersionNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error getting",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(fmt.Errorf("relation does not exist"))
+
+				_, err := r.GetSchemaVersion(context.Background())
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
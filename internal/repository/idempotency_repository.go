@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+// ReserveIdempotencyKey закрепляет ключ за пользователем. Истекший ключ переиспользуется.
+// Возвращает false, если ключ уже занят другим запросом
+func (pr *PostgresRepository) ReserveIdempotencyKey(ctx context.Context, userID, key, requestHash string, createdAt, expiresAt time.Time) (bool, error) {
+	result, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`INSERT INTO idempotency_keys (user_id, key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, status_code = NULL, content_type = NULL, response_body = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`,
+		userID,
+		key,
+		requestHash,
+		createdAt,
+		expiresAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("error reserving idempotency key: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("error reserving idempotency key: %w", err)
+	}
+
+	return affected > 0, nil
+}
+
+func (pr *PostgresRepository) GetIdempotencyKey(ctx context.Context, userID, key string) (*IdempotencyRecord, error) {
+	var record IdempotencyRecord
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		&record,
+		`SELECT user_id, key, request_hash, status_code, content_type, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2`,
+		userID,
+		key,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrIdempotencyKeyNotFound
+		}
+		return nil, fmt.Errorf("error getting idempotency key: %w", err)
+	}
+
+	return &record, nil
+}
+
+func (pr *PostgresRepository) SaveIdempotencyResponse(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`UPDATE idempotency_keys SET status_code = $1, content_type = $2, response_body = $3
+		WHERE user_id = $4 AND key = $5`,
+		statusCode,
+		contentType,
+		body,
+		userID,
+		key,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving idempotency response: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) DeleteIdempotencyKey(ctx context.Context, userID, key string) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`DELETE FROM idempotency_keys WHERE user_id = $1 AND key = $2`,
+		userID,
+		key,
+	)
+	if err != nil {
+		return fmt.Errorf("error deleting idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	result, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`DELETE FROM idempotency_keys WHERE expires_at <= $1`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired idempotency keys: %w", err)
+	}
+
+	return result.RowsAffected()
+}
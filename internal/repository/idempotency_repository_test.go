@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReserveIdempotencyKey(t *testing.T) {
+	query := `INSERT INTO idempotency_keys (user_id, key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash, status_code = NULL, content_type = NULL, response_body = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Reserved",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					"user1", "key1", "hash", dummyDate, dummyDate,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 1),
+				)
+
+				reserved, err := r.ReserveIdempotencyKey(context.Background(), "user1", "key1", "hash", dummyDate, dummyDate)
+				require.NoError(t, err)
+				require.True(t, reserved)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Already taken",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					"user1", "key1", "hash", dummyDate, dummyDate,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 0),
+				)
+
+				reserved, err := r.ReserveIdempotencyKey(context.Background(), "user1", "key1", "hash", dummyDate, dummyDate)
+				require.NoError(t, err)
+				require.False(t, reserved)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error reserving",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnError(fmt.Errorf("error reserving"))
+
+				_, err := r.ReserveIdempotencyKey(context.Background(), "user1", "key1", "hash", dummyDate, dummyDate)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestGetIdempotencyKey(t *testing.T) {
+	query := `SELECT user_id, key, request_hash, status_code, content_type, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"user1", "key1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"user_id", "key", "request_hash", "status_code", "content_type", "response_body", "created_at", "expires_at"}).
+						AddRow("user1", "key1", "hash", 201, "application/json", []byte(`{"id":"1"}`), dummyDate, dummyDate),
+				)
+
+				record, err := r.GetIdempotencyKey(context.Background(), "user1", "key1")
+				require.NoError(t, err)
+				require.Equal(t, "hash", record.RequestHash)
+				require.Equal(t, 201, *record.StatusCode)
+				require.Equal(t, `{"id":"1"}`, string(record.ResponseBody))
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"user1", "key1",
+				).WillReturnError(sql.ErrNoRows)
+
+				_, err := r.GetIdempotencyKey(context.Background(), "user1", "key1")
+				require.ErrorIs(t, err, ErrIdempotencyKeyNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrManifestNotFound       = errors.New("reception manifest not found")
+	ErrReconciliationNotFound = errors.New("reconciliation not found")
+)
+
+// SaveReceptionManifest заменяет манифест открытой приемки вместе со всеми строками
+func (pr *PostgresRepository) SaveReceptionManifest(ctx context.Context, manifest *ReceptionManifest) error {
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			var status string
+			err := tx.QueryRowContext(
+				ctx,
+				`SELECT status FROM reception WHERE id = $1 FOR UPDATE`,
+				manifest.ReceptionID,
+			).Scan(&status)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrReceptionNotFound
+			}
+			if err != nil {
+				return fmt.Errorf("error getting reception status: %w", err)
+			}
+			if isClosedReceptionStatus(status) {
+				return ErrReceptionClosed
+			}
+
+			manifest.UploadedAt = time.Now()
+			_, err = tx.ExecContext(ctx,
+				`INSERT INTO reception_manifest (reception_id, block_on_discrepancies, uploaded_by, uploaded_at)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT (reception_id) DO UPDATE
+				SET block_on_discrepancies = EXCLUDED.block_on_discrepancies,
+					uploaded_by = EXCLUDED.uploaded_by,
+					uploaded_at = EXCLUDED.uploaded_at`,
+				manifest.ReceptionID, manifest.BlockOnDiscrepancies, manifest.UploadedBy, manifest.UploadedAt,
+			)
+			if err != nil {
+				return fmt.Errorf("error saving reception manifest: %w", err)
+			}
+
+			_, err = tx.ExecContext(ctx,
+				`DELETE FROM reception_manifest_item WHERE reception_id = $1`,
+				manifest.ReceptionID,
+			)
+			if err != nil {
+				return fmt.Errorf("error deleting reception manifest items: %w", err)
+			}
+
+			return insertManifestItems(ctx, tx, manifest.ReceptionID, manifest.Items)
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("error saving reception manifest: %w", err)
+	}
+
+	return nil
+}
+
+// insertManifestItems вставляет строки манифеста одним запросом, сохраняя их порядок
+func insertManifestItems(ctx context.Context, tx *sqlx.Tx, receptionID string, items []*ManifestItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	const columnsCount = 5
+	values := make([]string, 0, len(items))
+	args := make([]interface{}, 0, len(items)*columnsCount)
+	for position, item := range items {
+		placeholders := make([]string, columnsCount)
+		for i := range placeholders {
+			placeholders[i] = fmt.Sprintf("$%d", len(args)+i+1)
+		}
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+		args = append(args, receptionID, position, item.Barcode, item.Type, item.Quantity)
+	}
+
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO reception_manifest_item (reception_id, position, barcode, type, quantity)
+		VALUES `+strings.Join(values, ", "),
+		args...,
+	)
+	if err != nil {
+		return fmt.Errorf("error inserting reception manifest items: %w", err)
+	}
+	return nil
+}
+
+func (pr *PostgresRepository) GetReceptionManifest(ctx context.Context, receptionID string) (*ReceptionManifest, error) {
+	manifest, err := getReceptionManifest(ctx, pr.db, receptionID)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		return nil, ErrManifestNotFound
+	}
+
+	return manifest, nil
+}
+
+// getReceptionManifest возвращает манифест приемки или nil, если он не загружен
+func getReceptionManifest(ctx context.Context, q sqlx.QueryerContext, receptionID string) (*ReceptionManifest, error) {
+	manifest := &ReceptionManifest{}
+	err := sqlx.GetContext(ctx, q, manifest,
+		`SELECT reception_id, block_on_discrepancies, uploaded_by, uploaded_at
+		FROM reception_manifest
+		WHERE reception_id = $1`,
+		receptionID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting reception manifest: %w", err)
+	}
+
+	manifest.Items = make([]*ManifestItem, 0)
+	err = sqlx.SelectContext(ctx, q, &manifest.Items,
+		`SELECT barcode, type, quantity
+		FROM reception_manifest_item
+		WHERE reception_id = $1
+		ORDER BY position`,
+		receptionID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing reception manifest items: %w", err)
+	}
+
+	return manifest, nil
+}
+
+func (pr *PostgresRepository) GetReconciliation(ctx context.Context, receptionID string) (*Reconciliation, error) {
+	reconciliation, err := getReconciliation(ctx, pr.db, receptionID)
+	if err != nil {
+		return nil, err
+	}
+	if reconciliation == nil {
+		return nil, ErrReconciliationNotFound
+	}
+
+	return reconciliation, nil
+}
+
+// getReconciliation возвращает сохраненную сверку приемки или nil, если ее нет
+func getReconciliation(ctx context.Context, q sqlx.QueryerContext, receptionID string) (*Reconciliation, error) {
+	reconciliation := &Reconciliation{}
+	err := sqlx.GetContext(ctx, q, reconciliation,
+		`SELECT reception_id, report, has_discrepancies, acknowledged_by, acknowledged_at, created_at
+		FROM reception_reconciliation
+		WHERE reception_id = $1`,
+		receptionID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting reconciliation: %w", err)
+	}
+
+	return reconciliation, nil
+}
+
+func (pr *PostgresRepository) SaveReconciliation(ctx context.Context, reconciliation *Reconciliation) error {
+	return saveReconciliation(ctx, pr.db, reconciliation)
+}
+
+func saveReconciliation(ctx context.Context, e sqlx.ExecerContext, reconciliation *Reconciliation) error {
+	if reconciliation.CreatedAt.IsZero() {
+		reconciliation.CreatedAt = time.Now()
+	}
+	reconciliation.HasDiscrepancies = reconciliation.Report.HasDiscrepancies()
+
+	_, err := e.ExecContext(ctx,
+		`INSERT INTO reception_reconciliation (reception_id, report, has_discrepancies, acknowledged_by, acknowledged_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (reception_id) DO UPDATE
+		SET report = EXCLUDED.report,
+			has_discrepancies = EXCLUDED.has_discrepancies,
+			acknowledged_by = EXCLUDED.acknowledged_by,
+			acknowledged_at = EXCLUDED.acknowledged_at,
+			created_at = EXCLUDED.created_at`,
+		reconciliation.ReceptionID,
+		reconciliation.Report,
+		reconciliation.HasDiscrepancies,
+		reconciliation.AcknowledgedBy,
+		reconciliation.AcknowledgedAt,
+		reconciliation.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error saving reconciliation: %w", err)
+	}
+	return nil
+}
+
+// reconcileReception сверяет закрываемую приемку с манифестом. Приемки без манифеста не сверяются
+func reconcileReception(ctx context.Context, tx *sqlx.Tx, receptionID string, reconcile ReconcileFunc) error {
+	manifest, err := getReceptionManifest(ctx, tx, receptionID)
+	if err != nil || manifest == nil {
+		return err
+	}
+
+	products := make([]*Product, 0)
+	err = tx.SelectContext(ctx, &products,
+		`SELECT `+productColumns+`
+		FROM product
+		WHERE reception_id = $1
+		ORDER BY reception_date`,
+		receptionID,
+	)
+	if err != nil {
+		return fmt.Errorf("error listing reception products: %w", err)
+	}
+
+	previous, err := getReconciliation(ctx, tx, receptionID)
+	if err != nil {
+		return err
+	}
+
+	reconciliation, err := reconcile(manifest, products, previous)
+	if err != nil {
+		return err
+	}
+	reconciliation.ReceptionID = receptionID
+	reconciliation.CreatedAt = time.Now()
+	return saveReconciliation(ctx, tx, reconciliation)
+}
@@ -0,0 +1,526 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	manifestQuery = `SELECT reception_id, block_on_discrepancies, uploaded_by, uploaded_at
+		FROM reception_manifest
+		WHERE reception_id = $1`
+	manifestItemsQuery = `SELECT barcode, type, quantity
+		FROM reception_manifest_item
+		WHERE reception_id = $1
+		ORDER BY position`
+	reconciliationQuery = `SELECT reception_id, report, has_discrepancies, acknowledged_by, acknowledged_at, created_at
+		FROM reception_reconciliation
+		WHERE reception_id = $1`
+	saveReconciliationQuery = `INSERT INTO reception_reconciliation (reception_id, report, has_discrepancies, acknowledged_by, acknowledged_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (reception_id) DO UPDATE
+		SET report = EXCLUDED.report,
+			has_discrepancies = EXCLUDED.has_discrepancies,
+			acknowledged_by = EXCLUDED.acknowledged_by,
+			acknowledged_at = EXCLUDED.acknowledged_at,
+			created_at = EXCLUDED.created_at`
+)
+
+func TestSaveReceptionManifest(t *testing.T) {
+	const lockQuery = `SELECT status FROM reception WHERE id = $1 FOR UPDATE`
+	const upsertQuery = `INSERT INTO reception_manifest (reception_id, block_on_discrepancies, uploaded_by, uploaded_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (reception_id) DO UPDATE
+		SET block_on_discrepancies = EXCLUDED.block_on_discrepancies,
+			uploaded_by = EXCLUDED.uploaded_by,
+			uploaded_at = EXCLUDED.uploaded_at`
+	const deleteQuery = `DELETE FROM reception_manifest_item WHERE reception_id = $1`
+	const insertQuery = `INSERT INTO reception_manifest_item (reception_id, position, barcode, type, quantity)
+		VALUES ($1, $2, $3, $4, $5), ($6, $7, $8, $9, $10)`
+
+	barcode := "4601234567890"
+	productType := "электроника"
+	userID := "user-1"
+	manifest := func() *ReceptionManifest {
+		return &ReceptionManifest{
+			ReceptionID:          "1",
+			BlockOnDiscrepancies: true,
+			UploadedBy:           &userID,
+			Items: []*ManifestItem{
+				{Barcode: &barcode, Quantity: 1},
+				{Type: &productType, Quantity: 3},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					lockQuery,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"status"}).AddRow(inProgressReceptionStatus),
+				)
+				mock.ExpectExec(
+					upsertQuery,
+				).WithArgs(
+					"1", true, &userID, sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				mock.ExpectExec(
+					deleteQuery,
+				).WithArgs(
+					"1",
+				).WillReturnResult(
+					sqlmock.NewResult(0, 2),
+				)
+				mock.ExpectExec(
+					insertQuery,
+				).WithArgs(
+					"1", 0, &barcode, nil, 1,
+					"1", 1, nil, &productType, 3,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 2),
+				)
+				mock.ExpectCommit()
+
+				m := manifest()
+				err := r.SaveReceptionManifest(context.Background(), m)
+				require.NoError(t, err)
+				require.False(t, m.UploadedAt.IsZero())
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error reception not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					lockQuery,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+				mock.ExpectRollback()
+
+				err := r.SaveReceptionManifest(context.Background(), manifest())
+				require.ErrorIs(t, err, ErrReceptionNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error reception closed",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					lockQuery,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"status"}).AddRow(closeReceptionStatus),
+				)
+				mock.ExpectRollback()
+
+				err := r.SaveReceptionManifest(context.Background(), manifest())
+				require.ErrorIs(t, err, ErrReceptionClosed)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error inserting items",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					lockQuery,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"status"}).AddRow(inProgressReceptionStatus),
+				)
+				mock.ExpectExec(
+					upsertQuery,
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				mock.ExpectExec(
+					deleteQuery,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 0),
+				)
+				mock.ExpectExec(
+					insertQuery,
+				).WillReturnError(
+					fmt.Errorf("error inserting items"),
+				)
+				mock.ExpectRollback()
+
+				err := r.SaveReceptionManifest(context.Background(), manifest())
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestGetReceptionManifest(t *testing.T) {
+	barcode := "4601234567890"
+	productType := "обувь"
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					manifestQuery,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"reception_id", "block_on_discrepancies", "uploaded_by", "uploaded_at"}).AddRow(
+						"1", true, nil, dummyDate,
+					),
+				)
+				mock.ExpectQuery(
+					manifestItemsQuery,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"barcode", "type", "quantity"}).
+						AddRow(barcode, nil, 1).
+						AddRow(nil, productType, 2),
+				)
+
+				result, err := r.GetReceptionManifest(context.Background(), "1")
+				require.NoError(t, err)
+				require.Equal(t, &ReceptionManifest{
+					ReceptionID:          "1",
+					BlockOnDiscrepancies: true,
+					UploadedAt:           dummyDate,
+					Items: []*ManifestItem{
+						{Barcode: &barcode, Quantity: 1},
+						{Type: &productType, Quantity: 2},
+					},
+				}, result)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error manifest not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					manifestQuery,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetReceptionManifest(context.Background(), "1")
+				require.ErrorIs(t, err, ErrManifestNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestGetReconciliation(t *testing.T) {
+	productType := "обувь"
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					reconciliationQuery,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"reception_id", "report", "has_discrepancies", "acknowledged_by", "acknowledged_at", "created_at"}).AddRow(
+						"1",
+						[]byte(`{"matched":[],"missing":[{"type":"обувь","quantity":2}],"extra":[]}`),
+						true,
+						nil,
+						nil,
+						dummyDate,
+					),
+				)
+
+				result, err := r.GetReconciliation(context.Background(), "1")
+				require.NoError(t, err)
+				require.Equal(t, &Reconciliation{
+					ReceptionID: "1",
+					Report: ReconciliationReport{
+						Matched: []*ManifestItem{},
+						Missing: []*ManifestItem{{Type: &productType, Quantity: 2}},
+						Extra:   []*ManifestItem{},
+					},
+					HasDiscrepancies: true,
+					CreatedAt:        dummyDate,
+				}, result)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error reconciliation not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					reconciliationQuery,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetReconciliation(context.Background(), "1")
+				require.ErrorIs(t, err, ErrReconciliationNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestSaveReconciliation(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		userID := "user-1"
+		reconciliation := &Reconciliation{
+			ReceptionID: "1",
+			Report: ReconciliationReport{
+				Matched: []*ManifestItem{},
+				Missing: []*ManifestItem{},
+				Extra:   []*ManifestItem{},
+			},
+			AcknowledgedBy: &userID,
+			AcknowledgedAt: &dummyDate,
+		}
+		mock.ExpectExec(
+			saveReconciliationQuery,
+		).WithArgs(
+			"1",
+			[]byte(`{"matched":[],"missing":[],"extra":[]}`),
+			false,
+			&userID,
+			&dummyDate,
+			sqlmock.AnyArg(),
+		).WillReturnResult(
+			sqlmock.NewResult(1, 1),
+		)
+
+		err := r.SaveReconciliation(context.Background(), reconciliation)
+		require.NoError(t, err)
+		require.False(t, reconciliation.CreatedAt.IsZero())
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
+
+func TestCloseReceptionReconciliation(t *testing.T) {
+	const lastReceptionQuery = `SELECT id, execution_date, pvz_id, status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
+		LIMIT 1
+		FOR UPDATE`
+	const productsQuery = `SELECT id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes
+		FROM product
+		WHERE reception_id = $1
+		ORDER BY reception_date`
+	const closeQuery = `UPDATE reception
+		SET status = $1
+		WHERE id = $2`
+	const storeQuery = `WITH stored AS (
+			UPDATE product
+			SET status = $2
+			WHERE reception_id = $1 AND status = $3
+			RETURNING id
+		)
+		INSERT INTO product_status_history (product_id, from_status, to_status, changed_at)
+		SELECT id, $3, $2, $4 FROM stored`
+
+	expectOpenReception := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(
+			lastReceptionQuery,
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+				"1", dummyDate, "1", inProgressReceptionStatus,
+			),
+		)
+	}
+	expectManifest := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(
+			manifestQuery,
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"reception_id", "block_on_discrepancies", "uploaded_by", "uploaded_at"}).AddRow(
+				"1", true, nil, dummyDate,
+			),
+		)
+		mock.ExpectQuery(
+			manifestItemsQuery,
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"barcode", "type", "quantity"}).AddRow(nil, "обувь", 1),
+		)
+		mock.ExpectQuery(
+			productsQuery,
+		).WithArgs(
+			"1",
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id", "status"}).AddRow(
+				"p1", "обувь", dummyDate, "1", acceptedProductStatus,
+			),
+		)
+		mock.ExpectQuery(
+			reconciliationQuery,
+		).WillReturnError(
+			sql.ErrNoRows,
+		)
+	}
+	expectClose := func(mock sqlmock.Sqlmock) {
+		mock.ExpectExec(closeQuery).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(storeQuery).WillReturnResult(sqlmock.NewResult(0, 1))
+		expectOutboxEvent(mock, EventReceptionClosed)
+		mock.ExpectCommit()
+	}
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success with manifest",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				expectOpenReception(mock)
+				expectManifest(mock)
+				mock.ExpectExec(
+					saveReconciliationQuery,
+				).WithArgs(
+					"1", sqlmock.AnyArg(), false, nil, nil, sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				expectClose(mock)
+
+				var gotManifest *ReceptionManifest
+				var gotProducts []*Product
+				reconcile := func(manifest *ReceptionManifest, products []*Product, previous *Reconciliation) (*Reconciliation, error) {
+					gotManifest, gotProducts = manifest, products
+					require.Nil(t, previous)
+					return &Reconciliation{Report: ReconciliationReport{
+						Matched: manifest.Items,
+						Missing: []*ManifestItem{},
+						Extra:   []*ManifestItem{},
+					}}, nil
+				}
+
+				rc, err := r.CloseReception(context.Background(), "1", reconcile)
+				require.NoError(t, err)
+				require.Equal(t, closeReceptionStatus, rc.Status)
+				require.Len(t, gotManifest.Items, 1)
+				require.Len(t, gotProducts, 1)
+				require.Equal(t, "p1", gotProducts[0].ID)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Success without manifest",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				expectOpenReception(mock)
+				mock.ExpectQuery(
+					manifestQuery,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+				expectClose(mock)
+
+				reconcile := func(*ReceptionManifest, []*Product, *Reconciliation) (*Reconciliation, error) {
+					t.Fatal("reconcile must not be called without manifest")
+					return nil, nil
+				}
+
+				_, err := r.CloseReception(context.Background(), "1", reconcile)
+				require.NoError(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error from reconcile aborts closing",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				expectOpenReception(mock)
+				expectManifest(mock)
+				mock.ExpectRollback()
+
+				errBlocked := fmt.Errorf("blocked")
+				reconcile := func(*ReceptionManifest, []*Product, *Reconciliation) (*Reconciliation, error) {
+					return nil, errBlocked
+				}
+
+				_, err := r.CloseReception(context.Background(), "1", reconcile)
+				require.ErrorIs(t, err, errBlocked)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
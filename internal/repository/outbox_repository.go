@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	EventPVZCreated      = "PVZCreated"
+	EventPVZUpdated      = "PVZUpdated"
+	EventPVZDeactivated  = "PVZDeactivated"
+	EventReceptionOpened = "ReceptionOpened"
+	EventReceptionClosed = "ReceptionClosed"
+	EventProductAdded    = "ProductAdded"
+	EventProductDeleted  = "ProductDeleted"
+
+	EventReceptionReopened = "ReceptionReopened"
+	EventReceptionAmended  = "ReceptionAmended"
+)
+
+var EventTypes = []string{
+	EventPVZCreated,
+	EventPVZUpdated,
+	EventPVZDeactivated,
+	EventReceptionOpened,
+	EventReceptionClosed,
+	EventProductAdded,
+	EventProductDeleted,
+	EventReceptionReopened,
+	EventReceptionAmended,
+}
+
+// insertOutboxEvent записывает доменное событие в outbox в рамках транзакции,
+// изменившей агрегат, чтобы событие не терялось и не публиковалось без изменения
+func insertOutboxEvent(ctx context.Context, tx *sqlx.Tx, eventType, aggregateID string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error marshaling %s event: %w", eventType, err)
+	}
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO outbox_events (id, event_type, aggregate_id, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		uuid.New().String(), eventType, aggregateID, data, time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("error inserting %s event: %w", eventType, err)
+	}
+
+	return nil
+}
+
+// FanOutOutboxEvents создает доставки для новых событий outbox по всем подходящим подпискам
+// и помечает события как разосланные. Возвращает количество созданных доставок.
+func (pr *PostgresRepository) FanOutOutboxEvents(ctx context.Context, now time.Time) (int64, error) {
+	result, err := pr.conn(ctx).ExecContext(ctx,
+		`WITH dispatched AS (
+			UPDATE outbox_events
+			SET dispatched_at = $1
+			WHERE id IN (
+				SELECT id FROM outbox_events
+				WHERE dispatched_at IS NULL
+				ORDER BY created_at
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, event_type
+		)
+		INSERT INTO webhook_deliveries (event_id, subscription_id, next_attempt_at)
+		SELECT e.id, s.id, $1
+		FROM dispatched e
+		JOIN webhook_subscriptions s
+			ON cardinality(s.event_types) = 0 OR e.event_type = ANY(s.event_types)
+		ON CONFLICT (event_id, subscription_id) DO NOTHING`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error fanning out outbox events: %w", err)
+	}
+
+	return result.RowsAffected()
+}
@@ -5,15 +5,31 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+const productColumns = `id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes`
+
+const (
+	acceptedProductStatus = "accepted"
+	storedProductStatus   = "stored"
+)
+
+var (
+	ErrProductNotFound       = errors.New("product not found")
+	ErrProductStatusConflict = errors.New("product status has changed")
+	ErrDuplicateBarcode      = errors.New("barcode already scanned in this reception")
 )
 
 func (pr *PostgresRepository) ListProducts(ctx context.Context, receptionID string) ([]*Product, error) {
 	var products []*Product
-	err := pr.db.SelectContext(ctx, &products, `SELECT * FROM product WHERE reception_id = $1`, receptionID)
+	err := pr.conn(ctx).SelectContext(ctx, &products, `SELECT * FROM product WHERE reception_id = $1`, receptionID)
 	if err != nil {
 		return nil, fmt.Errorf("error listing products: %w", err)
 	}
@@ -21,53 +37,31 @@ func (pr *PostgresRepository) ListProducts(ctx context.Context, receptionID stri
 	return products, nil
 }
 
-func (pr *PostgresRepository) CreateProduct(ctx context.Context, receptionID string, productType string) (*Product, error) {
-	product := &Product{}
+func (pr *PostgresRepository) CreateProduct(ctx context.Context, PVZID string, newProduct NewProduct) (*Product, error) {
+	var product *Product
 	err := pr.ExecTx(
 		ctx,
 		func(tx *sqlx.Tx) error {
-			var lastReception Reception
-			err := tx.QueryRowContext(
-				ctx,
-				`SELECT id, execution_date, pvz_id, status FROM reception 
-				ORDER BY execution_date DESC 
-				LIMIT 1
-				FOR UPDATE`,
-			).Scan(
-				&lastReception.ID,
-				&lastReception.ExecutionDate,
-				&lastReception.PVZID,
-				&lastReception.Status,
-			)
-			isNoReceptions := errors.Is(err, sql.ErrNoRows)
-			if err != nil && !isNoReceptions {
-				return fmt.Errorf("error getting last reception: %w", err)
+			lastReception, err := lockOpenReception(ctx, tx, PVZID)
+			if err != nil {
+				return err
 			}
 
-			if isNoReceptions {
-				return fmt.Errorf("no receptions found")
+			products := newReceptionProducts(lastReception.ID, []NewProduct{newProduct})
+			scanned, err := scannedBarcodes(ctx, tx, lastReception.ID, products)
+			if err != nil {
+				return err
 			}
-
-			if lastReception.Status == closeReceptionStatus {
-				return fmt.Errorf("last reception is closed")
+			if products[0].Barcode != nil && scanned[*products[0].Barcode] {
+				return ErrDuplicateBarcode
 			}
 
-			receptionDate := time.Now()
-			newID := uuid.New().String()
-			_, err = tx.ExecContext(ctx,
-				`INSERT INTO product (id, reception_date, reception_id, type)
-				VALUES ($1, $2, $3, $4)`,
-				newID, receptionDate, lastReception.ID, productType,
-			)
-
-			if err != nil {
-				return fmt.Errorf("error inserting reception: %w", err)
+			if err := insertProducts(ctx, tx, products); err != nil {
+				return err
 			}
-			product.ID = newID
-			product.ReceptionDate = receptionDate
-			product.Type = productType
-			product.ReceptionId = lastReception.ID
-			return nil
+
+			product = products[0]
+			return insertOutboxEvent(ctx, tx, EventProductAdded, product.ID, product)
 		},
 	)
 	if err != nil {
@@ -77,55 +71,162 @@ func (pr *PostgresRepository) CreateProduct(ctx context.Context, receptionID str
 	return product, nil
 }
 
-func (pr *PostgresRepository) DeleteProduct(ctx context.Context, PVZID string) (*Product, error) {
-	product := &Product{}
+// CreateProducts добавляет несколько товаров в открытую приемку одной транзакцией и одним INSERT.
+// Результат совпадает по порядку с newProducts, на месте товаров с уже отсканированным штрихкодом nil
+func (pr *PostgresRepository) CreateProducts(ctx context.Context, PVZID string, newProducts []NewProduct) ([]*Product, error) {
+	var result []*Product
 	err := pr.ExecTx(
 		ctx,
 		func(tx *sqlx.Tx) error {
-			var lastReception Reception
-			err := tx.QueryRowContext(
-				ctx,
-				`SELECT id, execution_date, pvz_id, status FROM reception
-				WHERE pvz_id = $1
-				ORDER BY execution_date DESC
-				LIMIT 1
-				FOR UPDATE`,
-				PVZID,
-			).Scan(
-				&lastReception.ID,
-				&lastReception.ExecutionDate,
-				&lastReception.PVZID,
-				&lastReception.Status,
-			)
-			isNoReceptions := errors.Is(err, sql.ErrNoRows)
-			if err != nil && !isNoReceptions {
-				return fmt.Errorf("error getting last reception: %w", err)
+			lastReception, err := lockOpenReception(ctx, tx, PVZID)
+			if err != nil {
+				return err
 			}
 
-			if isNoReceptions {
-				return fmt.Errorf("no receptions found")
+			products := newReceptionProducts(lastReception.ID, newProducts)
+			scanned, err := scannedBarcodes(ctx, tx, lastReception.ID, products)
+			if err != nil {
+				return err
 			}
 
-			if lastReception.Status == closeReceptionStatus {
-				return fmt.Errorf("last reception is closed")
+			result = make([]*Product, len(products))
+			inserted := make([]*Product, 0, len(products))
+			for i, product := range products {
+				if product.Barcode != nil {
+					if scanned[*product.Barcode] {
+						continue
+					}
+					scanned[*product.Barcode] = true
+				}
+				result[i] = product
+				inserted = append(inserted, product)
+			}
+			if len(inserted) == 0 {
+				return nil
 			}
 
-			err = tx.QueryRowContext(ctx,
+			if err := insertProducts(ctx, tx, inserted); err != nil {
+				return err
+			}
+			for _, product := range inserted {
+				if err := insertOutboxEvent(ctx, tx, EventProductAdded, product.ID, product); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating products: %w", err)
+	}
+
+	return result, nil
+}
+
+// newReceptionProducts готовит товары к добавлению в приемку.
+// Время приемки возрастает в порядке списка, чтобы удаление последнего товара работало как раньше
+func newReceptionProducts(receptionID string, newProducts []NewProduct) []*Product {
+	receptionDate := time.Now()
+	products := make([]*Product, len(newProducts))
+	for i, newProduct := range newProducts {
+		products[i] = &Product{
+			ID:             uuid.New().String(),
+			ReceptionDate:  receptionDate.Add(time.Duration(i) * time.Microsecond),
+			ReceptionId:    receptionID,
+			Type:           newProduct.Type,
+			Status:         acceptedProductStatus,
+			ProductDetails: newProduct.ProductDetails,
+		}
+	}
+	return products
+}
+
+// scannedBarcodes возвращает штрихкоды товаров, которые уже есть в приемке.
+// Приемка заблокирована lockOpenReception, поэтому параллельно такой же товар добавить не успеют
+func scannedBarcodes(ctx context.Context, tx *sqlx.Tx, receptionID string, products []*Product) (map[string]bool, error) {
+	barcodes := make([]string, 0, len(products))
+	for _, product := range products {
+		if product.Barcode != nil {
+			barcodes = append(barcodes, *product.Barcode)
+		}
+	}
+
+	scanned := make(map[string]bool)
+	if len(barcodes) == 0 {
+		return scanned, nil
+	}
+
+	var existing []string
+	err := tx.SelectContext(ctx, &existing,
+		`SELECT barcode FROM product
+		WHERE reception_id = $1 AND barcode = ANY($2)`,
+		receptionID, pq.Array(barcodes),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error checking barcodes: %w", err)
+	}
+
+	for _, barcode := range existing {
+		scanned[barcode] = true
+	}
+	return scanned, nil
+}
+
+// insertProducts вставляет товары одним запросом
+func insertProducts(ctx context.Context, tx *sqlx.Tx, products []*Product) error {
+	const columnsCount = 11
+	values := make([]string, 0, len(products))
+	args := make([]interface{}, 0, len(products)*columnsCount)
+	for _, product := range products {
+		placeholders := make([]string, columnsCount)
+		for i := range placeholders {
+			placeholders[i] = fmt.Sprintf("$%d", len(args)+i+1)
+		}
+		values = append(values, "("+strings.Join(placeholders, ", ")+")")
+		args = append(args,
+			product.ID, product.ReceptionDate, product.ReceptionId, product.Type, product.Status,
+			product.Barcode, product.WeightGrams, product.LengthMm, product.WidthMm, product.HeightMm, product.Attributes,
+		)
+	}
+
+	_, err := tx.ExecContext(ctx,
+		`INSERT INTO product (id, reception_date, reception_id, type, status,
+			barcode, weight_grams, length_mm, width_mm, height_mm, attributes)
+		VALUES `+strings.Join(values, ", "),
+		args...,
+	)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+		return ErrDuplicateBarcode
+	}
+	if err != nil {
+		return fmt.Errorf("error inserting products: %w", err)
+	}
+	return nil
+}
+
+func (pr *PostgresRepository) DeleteProduct(ctx context.Context, PVZID string) (*Product, error) {
+	product := &Product{}
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			lastReception, err := lockOpenReception(ctx, tx, PVZID)
+			if err != nil {
+				return err
+			}
+
+			err = tx.GetContext(ctx, product,
 				`DELETE FROM product
 				WHERE id = (
 					SELECT id
 					FROM product
-					WHERE reception_id = $1
+					WHERE reception_id = $1 AND status = $2
 					ORDER BY reception_date DESC
 					LIMIT 1
 				)
-				RETURNING id, type, reception_date, reception_id`,
+				RETURNING `+productColumns,
 				lastReception.ID,
-			).Scan(
-				&product.ID,
-				&product.Type,
-				&product.ReceptionDate,
-				&product.ReceptionId,
+				acceptedProductStatus,
 			)
 
 			isNoProducts := errors.Is(err, sql.ErrNoRows)
@@ -137,7 +238,7 @@ func (pr *PostgresRepository) DeleteProduct(ctx context.Context, PVZID string) (
 				return fmt.Errorf("no products found")
 			}
 
-			return nil
+			return insertOutboxEvent(ctx, tx, EventProductDeleted, product.ID, product)
 		},
 	)
 	if err != nil {
@@ -146,3 +247,131 @@ func (pr *PostgresRepository) DeleteProduct(ctx context.Context, PVZID string) (
 
 	return product, nil
 }
+
+// lockOpenReception блокирует последнюю приемку ПВЗ до конца транзакции и проверяет, что она открыта
+func lockOpenReception(ctx context.Context, tx *sqlx.Tx, PVZID string) (*Reception, error) {
+	var lastReception Reception
+	err := tx.QueryRowContext(
+		ctx,
+		`SELECT id, execution_date, pvz_id, status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
+		LIMIT 1
+		FOR UPDATE`,
+		PVZID,
+	).Scan(
+		&lastReception.ID,
+		&lastReception.ExecutionDate,
+		&lastReception.PVZID,
+		&lastReception.Status,
+	)
+	isNoReceptions := errors.Is(err, sql.ErrNoRows)
+	if err != nil && !isNoReceptions {
+		return nil, fmt.Errorf("error getting last reception: %w", err)
+	}
+
+	if isNoReceptions {
+		return nil, fmt.Errorf("no receptions found")
+	}
+
+	if isClosedReceptionStatus(lastReception.Status) {
+		return nil, fmt.Errorf("last reception is closed")
+	}
+
+	return &lastReception, nil
+}
+
+func (pr *PostgresRepository) GetProduct(ctx context.Context, productID string) (*Product, error) {
+	product := &Product{}
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		product,
+		`SELECT `+productColumns+` FROM product WHERE id = $1`,
+		productID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrProductNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting product: %w", err)
+	}
+
+	return product, nil
+}
+
+// ListProductsByBarcode возвращает товары с указанным штрихкодом во всех приемках, начиная с последнего
+func (pr *PostgresRepository) ListProductsByBarcode(ctx context.Context, barcode string) ([]*Product, error) {
+	products := make([]*Product, 0)
+	err := pr.conn(ctx).SelectContext(
+		ctx,
+		&products,
+		`SELECT `+productColumns+`
+		FROM product
+		WHERE barcode = $1
+		ORDER BY reception_date DESC`,
+		barcode,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing products by barcode: %w", err)
+	}
+
+	return products, nil
+}
+
+// UpdateProductStatus переводит товар из fromStatus в toStatus и записывает переход в историю.
+// Если статус товара успел измениться, возвращается ErrProductStatusConflict.
+func (pr *PostgresRepository) UpdateProductStatus(ctx context.Context, productID, fromStatus, toStatus, changedBy string) (*Product, error) {
+	product := &Product{}
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			err := tx.GetContext(ctx, product,
+				`UPDATE product
+				SET status = $3
+				WHERE id = $1 AND status = $2
+				RETURNING `+productColumns,
+				productID, fromStatus, toStatus,
+			)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrProductStatusConflict
+			}
+			if err != nil {
+				return fmt.Errorf("error updating product status: %w", err)
+			}
+
+			_, err = tx.ExecContext(ctx,
+				`INSERT INTO product_status_history (id, product_id, from_status, to_status, changed_by, changed_at)
+				VALUES ($1, $2, $3, $4, $5, $6)`,
+				uuid.New().String(), productID, fromStatus, toStatus, changedBy, time.Now(),
+			)
+			if err != nil {
+				return fmt.Errorf("error inserting product status history: %w", err)
+			}
+
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error changing product status: %w", err)
+	}
+
+	return product, nil
+}
+
+func (pr *PostgresRepository) ListProductStatusHistory(ctx context.Context, productID string) ([]*ProductStatusChange, error) {
+	history := make([]*ProductStatusChange, 0)
+	err := pr.conn(ctx).SelectContext(
+		ctx,
+		&history,
+		`SELECT id, product_id, from_status, to_status, changed_by, changed_at
+		FROM product_status_history
+		WHERE product_id = $1
+		ORDER BY changed_at`,
+		productID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing product status history: %w", err)
+	}
+
+	return history, nil
+}
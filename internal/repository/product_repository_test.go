@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -83,12 +84,14 @@ func TestListProduct(t *testing.T) {
 }
 
 func TestCreateProduct(t *testing.T) {
-	const query1 = `SELECT id, execution_date, pvz_id, status FROM reception 
-		ORDER BY execution_date DESC 
+	const query1 = `SELECT id, execution_date, pvz_id, status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
 		LIMIT 1
 		FOR UPDATE`
-	const query2 = `INSERT INTO product (id, reception_date, reception_id, type)
-		VALUES ($1, $2, $3, $4)`
+	const query2 = `INSERT INTO product (id, reception_date, reception_id, type, status,
+			barcode, weight_grams, length_mm, width_mm, height_mm, attributes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
 
 	testCases := []struct {
 		name string
@@ -114,11 +117,13 @@ func TestCreateProduct(t *testing.T) {
 				).WillReturnResult(
 					sqlmock.NewResult(1, 1),
 				)
+				expectOutboxEvent(mock, EventProductAdded)
 				mock.ExpectCommit()
 
-				result, err := r.CreateProduct(context.Background(), "1", "product_type")
+				result, err := r.CreateProduct(context.Background(), "1", NewProduct{Type: "product_type"})
 				require.NoError(t, err)
 				require.Equal(t, "product_type", result.Type)
+				require.Equal(t, acceptedProductStatus, result.Status)
 
 				err = mock.ExpectationsWereMet()
 				require.NoError(t, err)
@@ -136,7 +141,7 @@ func TestCreateProduct(t *testing.T) {
 				)
 				mock.ExpectRollback()
 
-				_, err := r.CreateProduct(context.Background(), "1", "product_type")
+				_, err := r.CreateProduct(context.Background(), "1", NewProduct{Type: "product_type"})
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -160,7 +165,7 @@ func TestCreateProduct(t *testing.T) {
 				)
 				mock.ExpectRollback()
 
-				_, err := r.CreateProduct(context.Background(), "1", "product_type")
+				_, err := r.CreateProduct(context.Background(), "1", NewProduct{Type: "product_type"})
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -179,7 +184,7 @@ func TestCreateProduct(t *testing.T) {
 				)
 				mock.ExpectRollback()
 
-				_, err := r.CreateProduct(context.Background(), "1", "product_type")
+				_, err := r.CreateProduct(context.Background(), "1", NewProduct{Type: "product_type"})
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -209,9 +214,90 @@ func TestCreateProduct(t *testing.T) {
 
 				mock.ExpectRollback()
 
-				_, err := r.CreateProduct(context.Background(), "1", "product_type")
+				_, err := r.CreateProduct(context.Background(), "1", NewProduct{Type: "product_type"})
 				require.Error(t, err)
 
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error creating product with already scanned barcode",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+						"10",
+						dummyDate,
+						"1",
+						inProgressReceptionStatus,
+					),
+				)
+				mock.ExpectQuery(
+					`SELECT barcode FROM product
+					WHERE reception_id = $1 AND barcode = ANY($2)`,
+				).WithArgs(
+					"10", pq.Array([]string{"4601234567890"}),
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"barcode"}).AddRow("4601234567890"),
+				)
+				mock.ExpectRollback()
+
+				barcode := "4601234567890"
+				_, err := r.CreateProduct(context.Background(), "1", NewProduct{
+					Type:           "product_type",
+					ProductDetails: ProductDetails{Barcode: &barcode},
+				})
+				require.ErrorIs(t, err, ErrDuplicateBarcode)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Success with products landing in the reception of their pvz",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				for _, pvz := range []struct{ pvzID, receptionID string }{
+					{pvzID: "1", receptionID: "10"},
+					{pvzID: "2", receptionID: "20"},
+				} {
+					mock.ExpectBegin()
+					mock.ExpectQuery(
+						query1,
+					).WithArgs(
+						pvz.pvzID,
+					).WillReturnRows(
+						sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+							pvz.receptionID,
+							dummyDate,
+							pvz.pvzID,
+							inProgressReceptionStatus,
+						),
+					)
+					mock.ExpectExec(
+						query2,
+					).WithArgs(
+						sqlmock.AnyArg(), sqlmock.AnyArg(), pvz.receptionID, "product_type", acceptedProductStatus,
+						nil, nil, nil, nil, nil, []byte("{}"),
+					).WillReturnResult(
+						sqlmock.NewResult(1, 1),
+					)
+					expectOutboxEvent(mock, EventProductAdded)
+					mock.ExpectCommit()
+				}
+
+				first, err := r.CreateProduct(context.Background(), "1", NewProduct{Type: "product_type"})
+				require.NoError(t, err)
+				require.Equal(t, "10", first.ReceptionId)
+
+				second, err := r.CreateProduct(context.Background(), "2", NewProduct{Type: "product_type"})
+				require.NoError(t, err)
+				require.Equal(t, "20", second.ReceptionId)
+
 				err = mock.ExpectationsWereMet()
 				require.NoError(t, err)
 			},
@@ -238,11 +324,12 @@ func TestDeleteProduct(t *testing.T) {
 		WHERE id = (
 			SELECT id
 			FROM product
-			WHERE reception_id = $1
+			WHERE reception_id = $1 AND status = $2
 			ORDER BY reception_date DESC
 			LIMIT 1
 		)
-		RETURNING id, type, reception_date, reception_id`
+		RETURNING id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes`
 
 	testCases := []struct {
 		name string
@@ -264,13 +351,15 @@ func TestDeleteProduct(t *testing.T) {
 				mock.ExpectQuery(
 					query2,
 				).WillReturnRows(
-					sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id"}).AddRow(
+					sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id", "status"}).AddRow(
 						1,
 						"product_type",
 						dummyDate,
 						1,
+						acceptedProductStatus,
 					),
 				)
+				expectOutboxEvent(mock, EventProductDeleted)
 				mock.ExpectCommit()
 
 				result, err := r.DeleteProduct(context.Background(), "1")
@@ -412,3 +501,462 @@ func TestDeleteProduct(t *testing.T) {
 		})
 	}
 }
+
+func TestGetProduct(t *testing.T) {
+	const query = `SELECT id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes FROM product WHERE id = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id", "status"}).AddRow(
+						1,
+						"product_type",
+						dummyDate,
+						1,
+						storedProductStatus,
+					),
+				)
+
+				result, err := r.GetProduct(context.Background(), "1")
+				require.NoError(t, err)
+				require.Equal(t, &Product{
+					ID:            "1",
+					Type:          "product_type",
+					ReceptionDate: dummyDate,
+					ReceptionId:   "1",
+					Status:        storedProductStatus,
+				}, result)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error product not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetProduct(context.Background(), "1")
+				require.ErrorIs(t, err, ErrProductNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestUpdateProductStatus(t *testing.T) {
+	const query1 = `UPDATE product
+		SET status = $3
+		WHERE id = $1 AND status = $2
+		RETURNING id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes`
+	const query2 = `INSERT INTO product_status_history (id, product_id, from_status, to_status, changed_by, changed_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WithArgs(
+					"1", "stored", "issued",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id", "status"}).AddRow(
+						1,
+						"product_type",
+						dummyDate,
+						1,
+						"issued",
+					),
+				)
+				mock.ExpectExec(
+					query2,
+				).WithArgs(
+					sqlmock.AnyArg(), "1", "stored", "issued", "user", sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				mock.ExpectCommit()
+
+				result, err := r.UpdateProductStatus(context.Background(), "1", "stored", "issued", "user")
+				require.NoError(t, err)
+				require.Equal(t, "issued", result.Status)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error status changed concurrently",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+				mock.ExpectRollback()
+
+				_, err := r.UpdateProductStatus(context.Background(), "1", "stored", "issued", "user")
+				require.ErrorIs(t, err, ErrProductStatusConflict)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error inserting history",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id", "status"}).AddRow(
+						1,
+						"product_type",
+						dummyDate,
+						1,
+						"issued",
+					),
+				)
+				mock.ExpectExec(
+					query2,
+				).WillReturnError(
+					fmt.Errorf("error inserting product status history"),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.UpdateProductStatus(context.Background(), "1", "stored", "issued", "user")
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestListProductStatusHistory(t *testing.T) {
+	const query = `SELECT id, product_id, from_status, to_status, changed_by, changed_at
+		FROM product_status_history
+		WHERE product_id = $1
+		ORDER BY changed_at`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "product_id", "from_status", "to_status", "changed_by", "changed_at"}).
+						AddRow("10", "1", "accepted", "stored", nil, dummyDate).
+						AddRow("11", "1", "stored", "issued", "user", dummyDate),
+				)
+
+				result, err := r.ListProductStatusHistory(context.Background(), "1")
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				require.Nil(t, result[0].ChangedBy)
+				require.Equal(t, "issued", result[1].ToStatus)
+				require.Equal(t, "user", *result[1].ChangedBy)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error listing history",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					fmt.Errorf("error listing product status history"),
+				)
+
+				_, err := r.ListProductStatusHistory(context.Background(), "1")
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestCreateProducts(t *testing.T) {
+	const query1 = `SELECT id, execution_date, pvz_id, status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
+		LIMIT 1
+		FOR UPDATE`
+	const query2 = `SELECT barcode FROM product
+		WHERE reception_id = $1 AND barcode = ANY($2)`
+	const query3 = `INSERT INTO product (id, reception_date, reception_id, type, status,
+			barcode, weight_grams, length_mm, width_mm, height_mm, attributes)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11), ($12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22)`
+
+	barcode := func(value string) ProductDetails {
+		return ProductDetails{Barcode: &value}
+	}
+
+	expectOpenReception := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery(
+			query1,
+		).WithArgs(
+			"1",
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+				"10",
+				dummyDate,
+				"1",
+				inProgressReceptionStatus,
+			),
+		)
+	}
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				expectOpenReception(mock)
+				mock.ExpectExec(
+					query3,
+				).WithArgs(
+					sqlmock.AnyArg(), sqlmock.AnyArg(), "10", "обувь", acceptedProductStatus, nil, nil, nil, nil, nil, []byte("{}"),
+					sqlmock.AnyArg(), sqlmock.AnyArg(), "10", "одежда", acceptedProductStatus, nil, nil, nil, nil, nil, []byte("{}"),
+				).WillReturnResult(
+					sqlmock.NewResult(0, 2),
+				)
+				expectOutboxEvent(mock, EventProductAdded)
+				expectOutboxEvent(mock, EventProductAdded)
+				mock.ExpectCommit()
+
+				result, err := r.CreateProducts(context.Background(), "1", []NewProduct{{Type: "обувь"}, {Type: "одежда"}})
+				require.NoError(t, err)
+				require.Len(t, result, 2)
+				require.Equal(t, "обувь", result[0].Type)
+				require.Equal(t, "одежда", result[1].Type)
+				require.True(t, result[1].ReceptionDate.After(result[0].ReceptionDate))
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Success skipping already scanned barcodes",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				expectOpenReception(mock)
+				mock.ExpectQuery(
+					query2,
+				).WithArgs(
+					"10", pq.Array([]string{"111", "222", "111", "333"}),
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"barcode"}).AddRow("222"),
+				)
+				mock.ExpectExec(
+					query3,
+				).WithArgs(
+					sqlmock.AnyArg(), sqlmock.AnyArg(), "10", "обувь", acceptedProductStatus, "111", nil, nil, nil, nil, []byte("{}"),
+					sqlmock.AnyArg(), sqlmock.AnyArg(), "10", "обувь", acceptedProductStatus, "333", nil, nil, nil, nil, []byte("{}"),
+				).WillReturnResult(
+					sqlmock.NewResult(0, 2),
+				)
+				expectOutboxEvent(mock, EventProductAdded)
+				expectOutboxEvent(mock, EventProductAdded)
+				mock.ExpectCommit()
+
+				result, err := r.CreateProducts(context.Background(), "1", []NewProduct{
+					{Type: "обувь", ProductDetails: barcode("111")},
+					{Type: "обувь", ProductDetails: barcode("222")},
+					{Type: "обувь", ProductDetails: barcode("111")},
+					{Type: "обувь", ProductDetails: barcode("333")},
+				})
+				require.NoError(t, err)
+				require.Len(t, result, 4)
+				require.Equal(t, "111", *result[0].Barcode)
+				require.Nil(t, result[1])
+				require.Nil(t, result[2])
+				require.Equal(t, "333", *result[3].Barcode)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error creating products with closed reception",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+						"10",
+						dummyDate,
+						"1",
+						closeReceptionStatus,
+					),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.CreateProducts(context.Background(), "1", []NewProduct{{Type: "обувь"}, {Type: "одежда"}})
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error creating products with insert error",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				expectOpenReception(mock)
+				mock.ExpectExec(
+					query3,
+				).WillReturnError(
+					fmt.Errorf("error inserting products"),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.CreateProducts(context.Background(), "1", []NewProduct{{Type: "обувь"}, {Type: "одежда"}})
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestListProductsByBarcode(t *testing.T) {
+	const query = `SELECT id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes
+		FROM product
+		WHERE barcode = $1
+		ORDER BY reception_date DESC`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"4601234567890",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{
+						"id", "type", "reception_date", "reception_id", "status",
+						"barcode", "weight_grams", "length_mm", "width_mm", "height_mm", "attributes",
+					}).AddRow(
+						"1", "обувь", dummyDate, "10", storedProductStatus,
+						"4601234567890", 1200, 300, 200, 120, []byte(`{"order":"A-15"}`),
+					),
+				)
+
+				result, err := r.ListProductsByBarcode(context.Background(), "4601234567890")
+				require.NoError(t, err)
+				require.Len(t, result, 1)
+				require.Equal(t, "4601234567890", *result[0].Barcode)
+				require.Equal(t, 1200, *result[0].WeightGrams)
+				require.Equal(t, ProductAttributes{"order": "A-15"}, result[0].Attributes)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error listing products",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					fmt.Errorf("error listing products by barcode"),
+				)
+
+				_, err := r.ListProductsByBarcode(context.Background(), "4601234567890")
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
@@ -2,16 +2,37 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 )
 
+const (
+	activePVZStatus   = "active"
+	inactivePVZStatus = "inactive"
+)
+
+const pvzColumns = `id, registration_date, city, status, deactivated_at,
+	street, house, postal_code, latitude, longitude, opening_hours`
+
+// earthRadius - средний радиус Земли в метрах для расчета расстояний
+const earthRadius = 6371000.0
+
+var (
+	ErrPVZNotFound = errors.New("pvz not found")
+	ErrPVZInactive = errors.New("pvz is inactive")
+)
+
 func (pr *PostgresRepository) ListAllPVZ(ctx context.Context) ([]*PVZ, error) {
 	var pvzList []*PVZ
-	err := pr.db.SelectContext(ctx, &pvzList, `SELECT * FROM pvz`)
+	err := pr.conn(ctx).SelectContext(ctx, &pvzList, `SELECT * FROM pvz`)
 	if err != nil {
 		return nil, fmt.Errorf("error listing pvz: %w", err)
 	}
@@ -19,56 +40,114 @@ func (pr *PostgresRepository) ListAllPVZ(ctx context.Context) ([]*PVZ, error) {
 	return pvzList, nil
 }
 
-func (pr *PostgresRepository) ListPVZ(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*PVZWithReceptions, error) {
+func (pr *PostgresRepository) ListPVZ(ctx context.Context, filter PVZFilter, page, limit int) ([]*PVZWithReceptions, error) {
 	offset := (page - 1) * limit
 
-	var pvzList []*PVZ
+	conditions, args := pvzListConditions(filter)
+	query := pvzListQuery(conditions)
 
-	query := `
-        SELECT DISTINCT p.id, p.registration_date, p.city
-        FROM pvz p
-        JOIN reception r ON p.id = r.pvz_id
-    `
+	offsetPos := len(args) + 1
+	limitPos := len(args) + 2
+	query += fmt.Sprintf(" OFFSET $%d LIMIT $%d", offsetPos, limitPos)
+	args = append(args, offset, limit)
 
-	args := make([]interface{}, 0)
-	whereClause := ""
+	return pr.listPVZ(ctx, filter, query, args)
+}
 
-	if startDate != nil && endDate != nil {
-		whereClause = "WHERE r.execution_date BETWEEN $1 AND $2"
-		args = append(args, startDate, endDate)
-	} else if startDate != nil {
-		whereClause = "WHERE r.execution_date >= $1"
-		args = append(args, startDate)
-	} else if endDate != nil {
-		whereClause = "WHERE r.execution_date <= $1"
-		args = append(args, endDate)
+// ListPVZAfter возвращает страницу ПВЗ, следующих в порядке сортировки за after.
+// Без after возвращает первую страницу
+func (pr *PostgresRepository) ListPVZAfter(ctx context.Context, filter PVZFilter, after *PVZCursor, limit int) ([]*PVZWithReceptions, error) {
+	conditions, args := pvzListConditions(filter)
+	if after != nil {
+		args = append(args, after.RegistrationDate, after.ID)
+		conditions = append(conditions, fmt.Sprintf("(p.registration_date, p.id) < ($%d, $%d)", len(args)-1, len(args)))
 	}
+	query := pvzListQuery(conditions)
 
-	query += " " + whereClause + " ORDER BY p.registration_date DESC"
+	args = append(args, limit)
+	query += fmt.Sprintf(" LIMIT $%d", len(args))
 
-	offsetPos := len(args) + 1
-	limitPos := len(args) + 2
-	query += fmt.Sprintf(" OFFSET $%d LIMIT $%d", offsetPos, limitPos)
-	args = append(args, offset, limit)
+	return pr.listPVZ(ctx, filter, query, args)
+}
 
-	err := pr.db.SelectContext(ctx, &pvzList, query, args...)
+func (pr *PostgresRepository) listPVZ(ctx context.Context, filter PVZFilter, query string, args []interface{}) ([]*PVZWithReceptions, error) {
+	var pvzList []*PVZ
+	err := pr.conn(ctx).SelectContext(ctx, &pvzList, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("error listing pvz: %w", err)
 	}
 
+	return pr.attachReceptions(ctx, pvzList, filter)
+}
+
+// pvzListConditions отбирает ПВЗ по городу и, если не задан IncludeEmpty, оставляет только ПВЗ
+// с подходящими под фильтр приемками
+func pvzListConditions(filter PVZFilter) ([]string, []interface{}) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+
+	if filter.City != nil {
+		args = append(args, *filter.City)
+		conditions = append(conditions, fmt.Sprintf("p.city = $%d", len(args)))
+	}
+	if !filter.IncludeEmpty {
+		var receptionConditions []string
+		receptionConditions, args = appendReceptionConditions([]string{"r.pvz_id = p.id"}, args, filter, "r.")
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM reception r WHERE "+strings.Join(receptionConditions, " AND ")+")")
+	}
+
+	return conditions, args
+}
+
+// appendReceptionConditions добавляет фильтры по дате и статусу приемки. Границы дат включаются
+func appendReceptionConditions(conditions []string, args []interface{}, filter PVZFilter, prefix string) ([]string, []interface{}) {
+	if filter.StartDate != nil {
+		args = append(args, filter.StartDate)
+		conditions = append(conditions, fmt.Sprintf("%sexecution_date >= $%d", prefix, len(args)))
+	}
+	if filter.EndDate != nil {
+		args = append(args, filter.EndDate)
+		conditions = append(conditions, fmt.Sprintf("%sexecution_date <= $%d", prefix, len(args)))
+	}
+	if filter.ReceptionStatus != nil {
+		args = append(args, *filter.ReceptionStatus)
+		conditions = append(conditions, fmt.Sprintf("%sstatus = $%d", prefix, len(args)))
+	}
+	return conditions, args
+}
+
+// pvzListQuery сортирует ПВЗ по дате регистрации и id, чтобы порядок был однозначным
+// и по нему можно было продолжать выборку с курсора
+func pvzListQuery(conditions []string) string {
+	query := `
+        SELECT p.id, p.registration_date, p.city, p.status, p.deactivated_at,
+            p.street, p.house, p.postal_code, p.latitude, p.longitude, p.opening_hours
+        FROM pvz p
+    `
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	return query + " ORDER BY p.registration_date DESC, p.id DESC"
+}
+
+// attachReceptions загружает приемки и товары для списка ПВЗ двумя запросами.
+// Приемки отбираются по тем же датам и статусу, что и ПВЗ в списке
+func (pr *PostgresRepository) attachReceptions(ctx context.Context, pvzList []*PVZ, filter PVZFilter) ([]*PVZWithReceptions, error) {
 	pvzIDs := make([]string, len(pvzList))
 	for i := range pvzList {
 		pvzIDs[i] = pvzList[i].ID
 	}
 
+	conditions, args := appendReceptionConditions([]string{"pvz_id = ANY($1)"}, []interface{}{pq.Array(pvzIDs)}, filter, "")
+
 	var rcList []*Reception
-	err = pr.db.SelectContext(
+	err := pr.conn(ctx).SelectContext(
 		ctx,
 		&rcList,
 		`SELECT id, execution_date, pvz_id, status
         FROM reception
-        WHERE pvz_id = ANY($1)`,
-		pq.Array(pvzIDs),
+        WHERE `+strings.Join(conditions, " AND "),
+		args...,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error listing receptions: %w", err)
@@ -85,10 +164,10 @@ func (pr *PostgresRepository) ListPVZ(ctx context.Context, startDate, endDate *t
 	}
 
 	productList := make([]*Product, 0)
-	err = pr.db.SelectContext(
+	err = pr.conn(ctx).SelectContext(
 		ctx,
 		&productList,
-		`SELECT id, type, reception_date, reception_id
+		`SELECT `+productColumns+`
 		FROM product
 		WHERE reception_id = ANY($1)`,
 		pq.Array(rcIDs),
@@ -119,25 +198,227 @@ func (pr *PostgresRepository) ListPVZ(ctx context.Context, startDate, endDate *t
 	return pvzWithReceptions, nil
 }
 
-func (pr *PostgresRepository) CreatePVZ(ctx context.Context, city string) (*PVZ, error) {
-	var registrationDate = time.Now()
-	newID := uuid.New().String()
-	_, err := pr.db.ExecContext(
+func (pr *PostgresRepository) CreatePVZ(ctx context.Context, city string, location PVZLocation) (*PVZ, error) {
+	pvz := &PVZ{
+		ID:               uuid.New().String(),
+		RegistrationDate: time.Now(),
+		City:             city,
+		Status:           activePVZStatus,
+		PVZLocation:      location,
+	}
+	err := pr.ExecTx(
 		ctx,
-		`INSERT INTO pvz (id, city, registration_date) VALUES ($1, $2, $3)`,
-		newID,
-		city,
-		registrationDate,
+		func(tx *sqlx.Tx) error {
+			_, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO pvz (id, city, registration_date, status,
+					street, house, postal_code, latitude, longitude, opening_hours)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+				pvz.ID,
+				pvz.City,
+				pvz.RegistrationDate,
+				pvz.Status,
+				pvz.Street,
+				pvz.House,
+				pvz.PostalCode,
+				pvz.Latitude,
+				pvz.Longitude,
+				pvz.OpeningHours,
+			)
+			if err != nil {
+				return fmt.Errorf("error inserting pvz: %w", err)
+			}
+
+			return insertOutboxEvent(ctx, tx, EventPVZCreated, pvz.ID, pvz)
+		},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error creating pvz: %w", err)
 	}
 
-	pvz := &PVZ{
-		ID:               newID,
-		RegistrationDate: registrationDate,
-		City:             city,
+	return pvz, nil
+}
+
+func (pr *PostgresRepository) GetPVZ(ctx context.Context, PVZID string) (*PVZWithReceptions, error) {
+	var pvz PVZ
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		&pvz,
+		`SELECT `+pvzColumns+` FROM pvz WHERE id = $1`,
+		PVZID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPVZNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting pvz: %w", err)
 	}
 
-	return pvz, nil
+	pvzWithReceptions, err := pr.attachReceptions(ctx, []*PVZ{&pvz}, PVZFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return pvzWithReceptions[0], nil
+}
+
+// UpdatePVZ меняет только переданные поля ПВЗ
+func (pr *PostgresRepository) UpdatePVZ(ctx context.Context, PVZID string, update PVZUpdate) (*PVZ, error) {
+	var pvz PVZ
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			status, err := selectPVZStatus(ctx, tx, PVZID, "FOR UPDATE")
+			if err != nil {
+				return err
+			}
+			if status == inactivePVZStatus {
+				return ErrPVZInactive
+			}
+
+			err = tx.GetContext(ctx, &pvz,
+				`UPDATE pvz SET
+					city = COALESCE($2, city),
+					street = COALESCE($3, street),
+					house = COALESCE($4, house),
+					postal_code = COALESCE($5, postal_code),
+					latitude = COALESCE($6, latitude),
+					longitude = COALESCE($7, longitude),
+					opening_hours = COALESCE($8, opening_hours)
+				WHERE id = $1
+				RETURNING `+pvzColumns,
+				PVZID,
+				update.City,
+				update.Street,
+				update.House,
+				update.PostalCode,
+				update.Latitude,
+				update.Longitude,
+				update.OpeningHours,
+			)
+			if err != nil {
+				return fmt.Errorf("error updating pvz: %w", err)
+			}
+
+			return insertOutboxEvent(ctx, tx, EventPVZUpdated, pvz.ID, pvz)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error updating pvz: %w", err)
+	}
+
+	return &pvz, nil
+}
+
+// DeactivatePVZ выводит ПВЗ из работы. Приемки и товары сохраняются для истории,
+// новые приемки в неактивном ПВЗ открыть нельзя.
+func (pr *PostgresRepository) DeactivatePVZ(ctx context.Context, PVZID string) (*PVZ, error) {
+	var pvz PVZ
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			status, err := selectPVZStatus(ctx, tx, PVZID, "FOR UPDATE")
+			if err != nil {
+				return err
+			}
+			if status == inactivePVZStatus {
+				return ErrPVZInactive
+			}
+
+			var hasReceptionInProgress bool
+			err = tx.GetContext(ctx, &hasReceptionInProgress,
+				`SELECT EXISTS (SELECT 1 FROM reception WHERE pvz_id = $1 AND status IN ($2, $3))`,
+				PVZID, inProgressReceptionStatus, reopenedReceptionStatus,
+			)
+			if err != nil {
+				return fmt.Errorf("error checking reception in progress: %w", err)
+			}
+			if hasReceptionInProgress {
+				return ErrReceptionInProgress
+			}
+
+			err = tx.GetContext(ctx, &pvz,
+				`UPDATE pvz SET status = $2, deactivated_at = $3
+				WHERE id = $1
+				RETURNING `+pvzColumns,
+				PVZID, inactivePVZStatus, time.Now(),
+			)
+			if err != nil {
+				return fmt.Errorf("error deactivating pvz: %w", err)
+			}
+
+			return insertOutboxEvent(ctx, tx, EventPVZDeactivated, pvz.ID, pvz)
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error deactivating pvz: %w", err)
+	}
+
+	return &pvz, nil
+}
+
+// ListNearbyPVZ возвращает активные ПВЗ в радиусе radius метров от точки, ближайшие первыми.
+// Расстояние считается по формуле гаверсинусов, ограничивающий прямоугольник
+// отсекает заведомо далекие ПВЗ по индексу на координатах
+func (pr *PostgresRepository) ListNearbyPVZ(ctx context.Context, lat, lon, radius float64, limit int) ([]*NearbyPVZ, error) {
+	minLat, maxLat, minLon, maxLon := boundingBox(lat, lon, radius)
+
+	nearby := make([]*NearbyPVZ, 0)
+	err := pr.conn(ctx).SelectContext(
+		ctx,
+		&nearby,
+		`SELECT * FROM (
+			SELECT `+pvzColumns+`,
+				2 * $3 * ASIN(LEAST(1, SQRT(
+					POWER(SIN(RADIANS(latitude - $1) / 2), 2) +
+					COS(RADIANS($1)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - $2) / 2), 2)
+				))) AS distance
+			FROM pvz
+			WHERE status = $4
+				AND latitude BETWEEN $5 AND $6
+				AND longitude BETWEEN $7 AND $8
+		) p
+		WHERE distance <= $9
+		ORDER BY distance
+		LIMIT $10`,
+		lat, lon, earthRadius, activePVZStatus, minLat, maxLat, minLon, maxLon, radius, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing nearby pvz: %w", err)
+	}
+
+	return nearby, nil
+}
+
+// boundingBox возвращает границы прямоугольника в градусах, в который вписан круг поиска.
+// Если круг захватывает полюс или линию перемены дат, долгота не ограничивается
+func boundingBox(lat, lon, radius float64) (minLat, maxLat, minLon, maxLon float64) {
+	angular := radius / earthRadius
+	dLat := angular * 180 / math.Pi
+	minLat, maxLat = lat-dLat, lat+dLat
+	minLon, maxLon = -180, 180
+
+	if minLat > -90 && maxLat < 90 {
+		dLon := math.Asin(math.Sin(angular)/math.Cos(lat*math.Pi/180)) * 180 / math.Pi
+		if lon-dLon >= -180 && lon+dLon <= 180 {
+			minLon, maxLon = lon-dLon, lon+dLon
+		}
+	}
+
+	return minLat, maxLat, minLon, maxLon
+}
+
+// selectPVZStatus читает статус ПВЗ с блокировкой строки: FOR SHARE при открытии приемки,
+// FOR UPDATE при изменении ПВЗ, чтобы деактивация и открытие приемки не выполнялись одновременно
+func selectPVZStatus(ctx context.Context, tx *sqlx.Tx, PVZID string, lock string) (string, error) {
+	var status string
+	err := tx.QueryRowContext(ctx, `SELECT status FROM pvz WHERE id = $1 `+lock, PVZID).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrPVZNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting pvz status: %w", err)
+	}
+
+	return status, nil
 }
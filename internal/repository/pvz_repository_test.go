@@ -2,15 +2,22 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestCreatePVZ(t *testing.T) {
-	query := `INSERT INTO pvz (id, city, registration_date) VALUES ($1, $2, $3)`
+	query := `INSERT INTO pvz (id, city, registration_date, status,
+			street, house, postal_code, latitude, longitude, opening_hours)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	lat, lon := 55.7558, 37.6173
+	location := PVZLocation{Latitude: &lat, Longitude: &lon}
 
 	testCases := []struct {
 		name string
@@ -19,14 +26,20 @@ func TestCreatePVZ(t *testing.T) {
 		{
 			name: "Success",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
 				mock.ExpectExec(
 					query,
 				).WillReturnResult(
 					sqlmock.NewResult(1, 1),
 				)
-				pvz, err := r.CreatePVZ(context.Background(), "Moscow")
+				expectOutboxEvent(mock, EventPVZCreated)
+				mock.ExpectCommit()
+
+				pvz, err := r.CreatePVZ(context.Background(), "Moscow", location)
 				require.NoError(t, err)
 				require.Equal(t, pvz.City, "Moscow")
+				require.Equal(t, activePVZStatus, pvz.Status)
+				require.Equal(t, lat, *pvz.Latitude)
 
 				err = mock.ExpectationsWereMet()
 				require.NoError(t, err)
@@ -35,13 +48,39 @@ func TestCreatePVZ(t *testing.T) {
 		{
 			name: "Error inserting",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
 				mock.ExpectExec(
 					query,
 				).WillReturnError(
 					fmt.Errorf("error inserting pvz"),
 				)
+				mock.ExpectRollback()
 
-				_, err := r.CreatePVZ(context.Background(), "Moscow")
+				_, err := r.CreatePVZ(context.Background(), "Moscow", location)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error writing outbox event",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(
+					query,
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				mock.ExpectExec(
+					`INSERT INTO outbox_events (id, event_type, aggregate_id, payload, created_at)
+					VALUES ($1, $2, $3, $4, $5)`,
+				).WillReturnError(
+					fmt.Errorf("error inserting event"),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.CreatePVZ(context.Background(), "Moscow", location)
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -113,10 +152,11 @@ func TestListPVZ(t *testing.T) {
 		},
 	}
 
-	query1 := `SELECT DISTINCT p.id, p.registration_date, p.city
+	query1 := `SELECT p.id, p.registration_date, p.city, p.status, p.deactivated_at,
+            p.street, p.house, p.postal_code, p.latitude, p.longitude, p.opening_hours
         FROM pvz p
-        JOIN reception r ON p.id = r.pvz_id
-		ORDER BY p.registration_date DESC
+		WHERE EXISTS (SELECT 1 FROM reception r WHERE r.pvz_id = p.id)
+		ORDER BY p.registration_date DESC, p.id DESC
 		OFFSET $1 LIMIT $2
 		`
 
@@ -124,7 +164,8 @@ func TestListPVZ(t *testing.T) {
         FROM reception
         WHERE pvz_id = ANY($1)`
 
-	query3 := `SELECT id, type, reception_date, reception_id
+	query3 := `SELECT id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes
 		FROM product
 		WHERE reception_id = ANY($1)`
 
@@ -199,7 +240,7 @@ func TestListPVZ(t *testing.T) {
 					query3,
 				).WillReturnRows(rows3)
 
-				result, err := r.ListPVZ(context.Background(), nil, nil, 1, 10)
+				result, err := r.ListPVZ(context.Background(), PVZFilter{}, 1, 10)
 				require.NoError(t, err)
 				require.Equal(t, expected, result)
 
@@ -218,6 +259,158 @@ func TestListPVZ(t *testing.T) {
 	}
 }
 
+func TestListPVZAfter(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		startDate := dummyDate.Add(-time.Hour)
+		after := &PVZCursor{RegistrationDate: dummyDate, ID: "2"}
+
+		mock.ExpectQuery(
+			`SELECT p.id, p.registration_date, p.city, p.status, p.deactivated_at,
+            p.street, p.house, p.postal_code, p.latitude, p.longitude, p.opening_hours
+			FROM pvz p
+			WHERE EXISTS (SELECT 1 FROM reception r WHERE r.pvz_id = p.id AND r.execution_date >= $1)
+			AND (p.registration_date, p.id) < ($2, $3)
+			ORDER BY p.registration_date DESC, p.id DESC
+			LIMIT $4`,
+		).WithArgs(
+			&startDate, dummyDate, "2", 11,
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "city", "registration_date"}).AddRow("1", "Moscow", dummyDate),
+		)
+		mock.ExpectQuery(
+			`SELECT id, execution_date, pvz_id, status
+			FROM reception
+			WHERE pvz_id = ANY($1) AND execution_date >= $2`,
+		).WithArgs(
+			sqlmock.AnyArg(), &startDate,
+		).WillReturnRows(sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}))
+		mock.ExpectQuery(
+			`SELECT id, type, reception_date, reception_id, status,
+			barcode, weight_grams, length_mm, width_mm, height_mm, attributes
+			FROM product
+			WHERE reception_id = ANY($1)`,
+		).WillReturnRows(sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id"}))
+
+		result, err := r.ListPVZAfter(context.Background(), PVZFilter{StartDate: &startDate}, after, 11)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, "1", result[0].PVZ.ID)
+		require.Empty(t, result[0].Receptions)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
+
+func TestListPVZFilters(t *testing.T) {
+	startDate := dummyDate.Add(-time.Hour)
+	endDate := dummyDate.Add(time.Hour)
+	city := "Moscow"
+	status := closeReceptionStatus
+
+	productsQuery := `SELECT id, type, reception_date, reception_id, status,
+		barcode, weight_grams, length_mm, width_mm, height_mm, attributes
+		FROM product
+		WHERE reception_id = ANY($1)`
+
+	testCases := []struct {
+		name              string
+		filter            PVZFilter
+		pvzQuery          string
+		pvzArgs           []driver.Value
+		receptionsQuery   string
+		receptionArgs     []driver.Value
+		expectedReception bool
+	}{
+		{
+			name: "Only PVZ with matching receptions",
+			filter: PVZFilter{
+				StartDate:       &startDate,
+				EndDate:         &endDate,
+				City:            &city,
+				ReceptionStatus: &status,
+			},
+			pvzQuery: `SELECT p.id, p.registration_date, p.city, p.status, p.deactivated_at,
+				p.street, p.house, p.postal_code, p.latitude, p.longitude, p.opening_hours
+				FROM pvz p
+				WHERE p.city = $1 AND EXISTS (SELECT 1 FROM reception r WHERE r.pvz_id = p.id
+				AND r.execution_date >= $2 AND r.execution_date <= $3 AND r.status = $4)
+				ORDER BY p.registration_date DESC, p.id DESC
+				OFFSET $5 LIMIT $6`,
+			pvzArgs: []driver.Value{city, &startDate, &endDate, status, 0, 10},
+			receptionsQuery: `SELECT id, execution_date, pvz_id, status
+				FROM reception
+				WHERE pvz_id = ANY($1) AND execution_date >= $2 AND execution_date <= $3 AND status = $4`,
+			receptionArgs:     []driver.Value{sqlmock.AnyArg(), &startDate, &endDate, status},
+			expectedReception: true,
+		},
+		{
+			name: "Include PVZ without receptions",
+			filter: PVZFilter{
+				EndDate:      &endDate,
+				City:         &city,
+				IncludeEmpty: true,
+			},
+			pvzQuery: `SELECT p.id, p.registration_date, p.city, p.status, p.deactivated_at,
+				p.street, p.house, p.postal_code, p.latitude, p.longitude, p.opening_hours
+				FROM pvz p
+				WHERE p.city = $1
+				ORDER BY p.registration_date DESC, p.id DESC
+				OFFSET $2 LIMIT $3`,
+			pvzArgs: []driver.Value{city, 0, 10},
+			receptionsQuery: `SELECT id, execution_date, pvz_id, status
+				FROM reception
+				WHERE pvz_id = ANY($1) AND execution_date <= $2`,
+			receptionArgs: []driver.Value{sqlmock.AnyArg(), &endDate},
+		},
+		{
+			name:   "Include PVZ without receptions and without filters",
+			filter: PVZFilter{IncludeEmpty: true},
+			pvzQuery: `SELECT p.id, p.registration_date, p.city, p.status, p.deactivated_at,
+				p.street, p.house, p.postal_code, p.latitude, p.longitude, p.opening_hours
+				FROM pvz p
+				ORDER BY p.registration_date DESC, p.id DESC
+				OFFSET $1 LIMIT $2`,
+			pvzArgs: []driver.Value{0, 10},
+			receptionsQuery: `SELECT id, execution_date, pvz_id, status
+				FROM reception
+				WHERE pvz_id = ANY($1)`,
+			receptionArgs: []driver.Value{sqlmock.AnyArg()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(tc.pvzQuery).WithArgs(tc.pvzArgs...).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "city", "registration_date"}).AddRow("1", city, dummyDate),
+				)
+				receptions := sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"})
+				if tc.expectedReception {
+					receptions.AddRow("rc1", dummyDate, "1", status)
+				}
+				mock.ExpectQuery(tc.receptionsQuery).WithArgs(tc.receptionArgs...).WillReturnRows(receptions)
+				mock.ExpectQuery(productsQuery).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id"}),
+				)
+
+				result, err := r.ListPVZ(context.Background(), tc.filter, 1, 10)
+				require.NoError(t, err)
+				require.Len(t, result, 1)
+				if tc.expectedReception {
+					require.Len(t, result[0].Receptions, 1)
+					require.Equal(t, "rc1", result[0].Receptions[0].Reception.ID)
+				} else {
+					require.Empty(t, result[0].Receptions)
+				}
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			})
+		})
+	}
+}
+
 func TestListAllPVZ(t *testing.T) {
 
 	pvzs := []*PVZ{
@@ -292,3 +485,347 @@ func TestListAllPVZ(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPVZ(t *testing.T) {
+	query := `SELECT ` + pvzColumns + ` FROM pvz WHERE id = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "registration_date", "city", "status", "deactivated_at"}).
+						AddRow("1", dummyDate, "Moscow", activePVZStatus, nil),
+				)
+				mock.ExpectQuery(
+					`SELECT id, execution_date, pvz_id, status
+					FROM reception
+					WHERE pvz_id = ANY($1)`,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).
+						AddRow("2", dummyDate, "1", closeReceptionStatus),
+				)
+				mock.ExpectQuery(
+					`SELECT id, type, reception_date, reception_id, status,
+	barcode, weight_grams, length_mm, width_mm, height_mm, attributes
+					FROM product
+					WHERE reception_id = ANY($1)`,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "type", "reception_date", "reception_id", "status"}).
+						AddRow("3", "обувь", dummyDate, "2", "stored"),
+				)
+
+				pvz, err := r.GetPVZ(context.Background(), "1")
+				require.NoError(t, err)
+				require.Equal(t, "Moscow", pvz.PVZ.City)
+				require.Len(t, pvz.Receptions, 1)
+				require.Len(t, pvz.Receptions[0].Products, 1)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error pvz not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetPVZ(context.Background(), "1")
+				require.ErrorIs(t, err, ErrPVZNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestUpdatePVZ(t *testing.T) {
+	query := `UPDATE pvz SET
+			city = COALESCE($2, city),
+			street = COALESCE($3, street),
+			house = COALESCE($4, house),
+			postal_code = COALESCE($5, postal_code),
+			latitude = COALESCE($6, latitude),
+			longitude = COALESCE($7, longitude),
+			opening_hours = COALESCE($8, opening_hours)
+		WHERE id = $1
+		RETURNING ` + pvzColumns
+	city, street := "Kazan", "Баумана"
+	update := PVZUpdate{City: &city, PVZLocation: PVZLocation{Street: &street}}
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR UPDATE")
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"1", &city, &street, nil, nil, nil, nil, nil,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "registration_date", "city", "status", "deactivated_at", "street"}).
+						AddRow("1", dummyDate, "Kazan", activePVZStatus, nil, street),
+				)
+				expectOutboxEvent(mock, EventPVZUpdated)
+				mock.ExpectCommit()
+
+				pvz, err := r.UpdatePVZ(context.Background(), "1", update)
+				require.NoError(t, err)
+				require.Equal(t, "Kazan", pvz.City)
+				require.Equal(t, street, *pvz.Street)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error pvz inactive",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, inactivePVZStatus, "FOR UPDATE")
+				mock.ExpectRollback()
+
+				_, err := r.UpdatePVZ(context.Background(), "1", update)
+				require.ErrorIs(t, err, ErrPVZInactive)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestDeactivatePVZ(t *testing.T) {
+	query1 := `SELECT EXISTS (SELECT 1 FROM reception WHERE pvz_id = $1 AND status IN ($2, $3))`
+	query2 := `UPDATE pvz SET status = $2, deactivated_at = $3
+		WHERE id = $1
+		RETURNING ` + pvzColumns
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR UPDATE")
+				mock.ExpectQuery(
+					query1,
+				).WithArgs(
+					"1", inProgressReceptionStatus, reopenedReceptionStatus,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"exists"}).AddRow(false),
+				)
+				mock.ExpectQuery(
+					query2,
+				).WithArgs(
+					"1", inactivePVZStatus, sqlmock.AnyArg(),
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "registration_date", "city", "status", "deactivated_at"}).
+						AddRow("1", dummyDate, "Moscow", inactivePVZStatus, dummyDate),
+				)
+				expectOutboxEvent(mock, EventPVZDeactivated)
+				mock.ExpectCommit()
+
+				pvz, err := r.DeactivatePVZ(context.Background(), "1")
+				require.NoError(t, err)
+				require.Equal(t, inactivePVZStatus, pvz.Status)
+				require.NotNil(t, pvz.DeactivatedAt)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error reception in progress",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR UPDATE")
+				mock.ExpectQuery(
+					query1,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"exists"}).AddRow(true),
+				)
+				mock.ExpectRollback()
+
+				_, err := r.DeactivatePVZ(context.Background(), "1")
+				require.ErrorIs(t, err, ErrReceptionInProgress)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error already inactive",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				expectPVZStatus(mock, inactivePVZStatus, "FOR UPDATE")
+				mock.ExpectRollback()
+
+				_, err := r.DeactivatePVZ(context.Background(), "1")
+				require.ErrorIs(t, err, ErrPVZInactive)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestListNearbyPVZ(t *testing.T) {
+	query := `SELECT * FROM (
+			SELECT ` + pvzColumns + `,
+				2 * $3 * ASIN(LEAST(1, SQRT(
+					POWER(SIN(RADIANS(latitude - $1) / 2), 2) +
+					COS(RADIANS($1)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - $2) / 2), 2)
+				))) AS distance
+			FROM pvz
+			WHERE status = $4
+				AND latitude BETWEEN $5 AND $6
+				AND longitude BETWEEN $7 AND $8
+		) p
+		WHERE distance <= $9
+		ORDER BY distance
+		LIMIT $10`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					55.75, 37.61, earthRadius, activePVZStatus,
+					sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+					1000.0, 10,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "registration_date", "city", "status", "latitude", "longitude", "distance"}).
+						AddRow("1", dummyDate, "Москва", activePVZStatus, 55.751, 37.611, 130.5).
+						AddRow("2", dummyDate, "Москва", activePVZStatus, 55.755, 37.615, 620.1),
+				)
+
+				nearby, err := r.ListNearbyPVZ(context.Background(), 55.75, 37.61, 1000, 10)
+				require.NoError(t, err)
+				require.Len(t, nearby, 2)
+				require.Equal(t, "1", nearby[0].ID)
+				require.Equal(t, 130.5, nearby[0].Distance)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error listing",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					fmt.Errorf("error listing"),
+				)
+
+				_, err := r.ListNearbyPVZ(context.Background(), 55.75, 37.61, 1000, 10)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestBoundingBox(t *testing.T) {
+	testCases := []struct {
+		name           string
+		lat, lon       float64
+		radius         float64
+		minLat, maxLat float64
+		minLon, maxLon float64
+	}{
+		{
+			name:   "Equator",
+			lat:    0,
+			lon:    0,
+			radius: 111195,
+			minLat: -1, maxLat: 1,
+			minLon: -1, maxLon: 1,
+		},
+		{
+			name:   "Near pole",
+			lat:    89.5,
+			lon:    10,
+			radius: 111195,
+			minLat: 88.5, maxLat: 90.5,
+			minLon: -180, maxLon: 180,
+		},
+		{
+			name:   "Crossing date line",
+			lat:    0,
+			lon:    179.5,
+			radius: 111195,
+			minLat: -1, maxLat: 1,
+			minLon: -180, maxLon: 180,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minLat, maxLat, minLon, maxLon := boundingBox(tc.lat, tc.lon, tc.radius)
+			require.InDelta(t, tc.minLat, minLat, 0.001)
+			require.InDelta(t, tc.maxLat, maxLat, 0.001)
+			require.InDelta(t, tc.minLon, minLon, 0.001)
+			require.InDelta(t, tc.maxLon, maxLon, 0.001)
+		})
+	}
+}
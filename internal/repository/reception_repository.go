@@ -5,39 +5,127 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 const (
 	closeReceptionStatus      = "close"
 	inProgressReceptionStatus = "in_progress"
+	reopenedReceptionStatus   = "reopened"
+	amendedReceptionStatus    = "amended"
+
+	uniqueViolationCode = "23505"
 )
 
-func (pr *PostgresRepository) ListReception(ctx context.Context, PVZID string) ([]*Reception, error) {
-	var receptions []*Reception
-	err := pr.db.SelectContext(ctx, &receptions, `SELECT * FROM reception WHERE pvz_id = $1`, PVZID)
-	if err != nil {
+var (
+	ErrReceptionInProgress = errors.New("pvz already has a reception in progress")
+	ErrReceptionNotFound   = errors.New("reception not found")
+	ErrReceptionClosed     = errors.New("reception is closed")
+)
+
+// isClosedReceptionStatus - приемка закрыта, в том числе после исправления модератором.
+// Открытая заново приемка принимает товары так же, как новая
+func isClosedReceptionStatus(status string) bool {
+	return status == closeReceptionStatus || status == amendedReceptionStatus
+}
+
+func (pr *PostgresRepository) ListReception(ctx context.Context, PVZID string, filter ReceptionFilter) ([]*Reception, error) {
+	conditions := []string{"pvz_id = $1"}
+	args := []interface{}{PVZID}
+
+	addCondition := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+	if filter.Status != nil {
+		addCondition("status = $%d", *filter.Status)
+	}
+	if filter.From != nil {
+		addCondition("execution_date >= $%d", *filter.From)
+	}
+	if filter.To != nil {
+		addCondition("execution_date <= $%d", *filter.To)
+	}
+
+	query := `SELECT id, execution_date, pvz_id, status FROM reception WHERE ` + strings.Join(conditions, " AND ")
+	query += fmt.Sprintf(" ORDER BY execution_date DESC, id OFFSET $%d LIMIT $%d", len(args)+1, len(args)+2)
+	args = append(args, (filter.Page-1)*filter.Limit, filter.Limit)
+
+	receptions := make([]*Reception, 0)
+	if err := pr.conn(ctx).SelectContext(ctx, &receptions, query, args...); err != nil {
 		return nil, fmt.Errorf("error listing receptions: %w", err)
 	}
 
 	return receptions, nil
 }
 
+func (pr *PostgresRepository) GetReception(ctx context.Context, receptionID string) (*Reception, error) {
+	rc := &Reception{}
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		rc,
+		`SELECT id, execution_date, pvz_id, status FROM reception WHERE id = $1`,
+		receptionID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReceptionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting reception: %w", err)
+	}
+
+	return rc, nil
+}
+
+// GetLastReception возвращает последнюю приемку ПВЗ независимо от ее статуса
+func (pr *PostgresRepository) GetLastReception(ctx context.Context, PVZID string) (*Reception, error) {
+	rc := &Reception{}
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		rc,
+		`SELECT id, execution_date, pvz_id, status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
+		LIMIT 1`,
+		PVZID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReceptionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting last reception: %w", err)
+	}
+
+	return rc, nil
+}
+
 func (pr *PostgresRepository) CreateReception(ctx context.Context, PVZID string) (*Reception, error) {
 	rc := &Reception{}
 	err := pr.ExecTx(
 		ctx,
 		func(tx *sqlx.Tx) error {
+			pvzStatus, err := selectPVZStatus(ctx, tx, PVZID, "FOR SHARE")
+			if err != nil {
+				return err
+			}
+			if pvzStatus == inactivePVZStatus {
+				return ErrPVZInactive
+			}
+
 			var lastReceptionStatus string
-			err := tx.QueryRowContext(
+			err = tx.QueryRowContext(
 				ctx, `
-				SELECT status FROM reception 
-				ORDER BY execution_date DESC 
+				SELECT status FROM reception
+				WHERE pvz_id = $1
+				ORDER BY execution_date DESC
 				LIMIT 1
 				FOR UPDATE`,
+				PVZID,
 			).Scan(&lastReceptionStatus)
 
 			isNoReceptions := errors.Is(err, sql.ErrNoRows)
@@ -45,8 +133,8 @@ func (pr *PostgresRepository) CreateReception(ctx context.Context, PVZID string)
 				return fmt.Errorf("error getting last reception status: %w", err)
 			}
 
-			if !isNoReceptions && lastReceptionStatus != "close" {
-				return fmt.Errorf("last reception is not closed: %s", lastReceptionStatus)
+			if !isNoReceptions && !isClosedReceptionStatus(lastReceptionStatus) {
+				return ErrReceptionInProgress
 			}
 
 			executionDate := time.Now()
@@ -57,6 +145,10 @@ func (pr *PostgresRepository) CreateReception(ctx context.Context, PVZID string)
 				newID, executionDate, PVZID, inProgressReceptionStatus,
 			)
 
+			var pqErr *pq.Error
+			if errors.As(err, &pqErr) && pqErr.Code == uniqueViolationCode {
+				return ErrReceptionInProgress
+			}
 			if err != nil {
 				return fmt.Errorf("error inserting reception: %w", err)
 			}
@@ -64,7 +156,7 @@ func (pr *PostgresRepository) CreateReception(ctx context.Context, PVZID string)
 			rc.ExecutionDate = executionDate
 			rc.PVZID = PVZID
 			rc.Status = inProgressReceptionStatus
-			return nil
+			return insertOutboxEvent(ctx, tx, EventReceptionOpened, rc.ID, rc)
 		},
 	)
 	if err != nil {
@@ -73,44 +165,91 @@ func (pr *PostgresRepository) CreateReception(ctx context.Context, PVZID string)
 	return rc, nil
 }
 
-func (pr *PostgresRepository) CloseReception(ctx context.Context, PVZID string) (*Reception, error) {
+// CloseReception закрывает последнюю приемку ПВЗ. Если к приемке загружен манифест,
+// в той же транзакции вызывается reconcile и сохраняется результат сверки
+func (pr *PostgresRepository) CloseReception(ctx context.Context, PVZID string, reconcile ReconcileFunc) (*Reception, error) {
 	var lastReception Reception
-	err := pr.db.QueryRowContext(
+	err := pr.ExecTx(
 		ctx,
-		`SELECT id, execution_date, pvz_id, status FROM reception 
-		ORDER BY execution_date DESC 
-		LIMIT 1`,
-	).Scan(
-		&lastReception.ID,
-		&lastReception.ExecutionDate,
-		&lastReception.PVZID,
-		&lastReception.Status,
-	)
+		func(tx *sqlx.Tx) error {
+			err := tx.QueryRowContext(
+				ctx,
+				`SELECT id, execution_date, pvz_id, status FROM reception
+				WHERE pvz_id = $1
+				ORDER BY execution_date DESC
+				LIMIT 1
+				FOR UPDATE`,
+				PVZID,
+			).Scan(
+				&lastReception.ID,
+				&lastReception.ExecutionDate,
+				&lastReception.PVZID,
+				&lastReception.Status,
+			)
 
-	isNoReceptions := errors.Is(err, sql.ErrNoRows)
-	if err != nil && !isNoReceptions {
-		return nil, fmt.Errorf("error getting last reception status: %w", err)
-	}
+			isNoReceptions := errors.Is(err, sql.ErrNoRows)
+			if err != nil && !isNoReceptions {
+				return fmt.Errorf("error getting last reception status: %w", err)
+			}
 
-	if isNoReceptions {
-		return nil, fmt.Errorf("no receptions found")
-	}
+			if isNoReceptions {
+				return fmt.Errorf("no receptions found")
+			}
 
-	if lastReception.Status == closeReceptionStatus {
-		return nil, fmt.Errorf("last reception is already closed")
-	}
+			if isClosedReceptionStatus(lastReception.Status) {
+				return fmt.Errorf("last reception is already closed")
+			}
+
+			if reconcile != nil {
+				if err := reconcileReception(ctx, tx, lastReception.ID, reconcile); err != nil {
+					return err
+				}
+			}
+
+			_, err = tx.ExecContext(ctx,
+				`UPDATE reception
+				SET status = $1
+				WHERE id = $2`,
+				closeReceptionStatus,
+				lastReception.ID,
+			)
+			if err != nil {
+				return fmt.Errorf("error updating reception status: %w", err)
+			}
+
+			if err := storeReceptionProducts(ctx, tx, lastReception.ID); err != nil {
+				return err
+			}
 
-	_, err = pr.db.ExecContext(ctx,
-		`UPDATE reception
-		SET status = $1
-		WHERE id = $2`,
-		closeReceptionStatus,
-		lastReception.ID,
+			lastReception.Status = closeReceptionStatus
+			return insertOutboxEvent(ctx, tx, EventReceptionClosed, lastReception.ID, &lastReception)
+		},
 	)
 	if err != nil {
-		return nil, fmt.Errorf("error updating reception status: %w", err)
+		return nil, fmt.Errorf("error closing reception: %w", err)
 	}
 
-	lastReception.Status = closeReceptionStatus
 	return &lastReception, nil
 }
+
+// storeReceptionProducts переводит принятые товары закрытой приемки на хранение
+func storeReceptionProducts(ctx context.Context, tx *sqlx.Tx, receptionID string) error {
+	_, err := tx.ExecContext(ctx,
+		`WITH stored AS (
+			UPDATE product
+			SET status = $2
+			WHERE reception_id = $1 AND status = $3
+			RETURNING id
+		)
+		INSERT INTO product_status_history (product_id, from_status, to_status, changed_at)
+		SELECT id, $3, $2, $4 FROM stored`,
+		receptionID,
+		storedProductStatus,
+		acceptedProductStatus,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("error storing reception products: %w", err)
+	}
+	return nil
+}
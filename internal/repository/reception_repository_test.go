@@ -5,8 +5,10 @@ import (
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,7 +29,8 @@ func TestListReception(t *testing.T) {
 		},
 	}
 
-	query := `SELECT * FROM reception WHERE pvz_id = $1`
+	query := `SELECT id, execution_date, pvz_id, status FROM reception WHERE pvz_id = $1
+		ORDER BY execution_date DESC, id OFFSET $2 LIMIT $3`
 
 	testCases := []struct {
 		name string
@@ -46,9 +49,9 @@ func TestListReception(t *testing.T) {
 
 				mock.ExpectQuery(
 					query,
-				).WillReturnRows(rows)
+				).WithArgs("1", 0, 20).WillReturnRows(rows)
 
-				result, err := r.ListReception(context.Background(), "1")
+				result, err := r.ListReception(context.Background(), "1", ReceptionFilter{Page: 1, Limit: 20})
 				require.NoError(t, err)
 				require.Equal(t, result, receptons)
 
@@ -56,6 +59,35 @@ func TestListReception(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "Filters",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				status := "close"
+				from := dummyDate.Add(-time.Hour)
+				to := dummyDate
+
+				mock.ExpectQuery(
+					`SELECT id, execution_date, pvz_id, status FROM reception
+					WHERE pvz_id = $1 AND status = $2 AND execution_date >= $3 AND execution_date <= $4
+					ORDER BY execution_date DESC, id OFFSET $5 LIMIT $6`,
+				).WithArgs("1", status, from, to, 10, 10).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "pvz_id", "status", "execution_date"}),
+				)
+
+				result, err := r.ListReception(context.Background(), "1", ReceptionFilter{
+					Status: &status,
+					From:   &from,
+					To:     &to,
+					Page:   2,
+					Limit:  10,
+				})
+				require.NoError(t, err)
+				require.Empty(t, result)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
 		{
 			name: "Error listing",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
@@ -64,7 +96,7 @@ func TestListReception(t *testing.T) {
 					query,
 				).WillReturnError(fmt.Errorf("error listing receptions"))
 
-				_, err := r.ListReception(context.Background(), "1")
+				_, err := r.ListReception(context.Background(), "1", ReceptionFilter{Page: 1, Limit: 20})
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -83,8 +115,9 @@ func TestListReception(t *testing.T) {
 }
 
 func TestCreateReception(t *testing.T) {
-	query1 := `SELECT status FROM reception 
-		ORDER BY execution_date DESC 
+	query1 := `SELECT status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
 		LIMIT 1
 		FOR UPDATE`
 	query2 := `INSERT INTO reception (id, execution_date, pvz_id, status)
@@ -99,6 +132,7 @@ func TestCreateReception(t *testing.T) {
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
 
 				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
 				mock.ExpectQuery(
 					query1,
 				).WillReturnError(
@@ -109,6 +143,7 @@ func TestCreateReception(t *testing.T) {
 				).WillReturnResult(
 					sqlmock.NewResult(1, 1),
 				)
+				expectOutboxEvent(mock, EventReceptionOpened)
 				mock.ExpectCommit()
 
 				rc, err := r.CreateReception(context.Background(), "1")
@@ -125,6 +160,7 @@ func TestCreateReception(t *testing.T) {
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
 
 				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
 				mock.ExpectQuery(
 					query1,
 				).WillReturnRows(
@@ -135,6 +171,7 @@ func TestCreateReception(t *testing.T) {
 				).WillReturnResult(
 					sqlmock.NewResult(1, 1),
 				)
+				expectOutboxEvent(mock, EventReceptionOpened)
 				mock.ExpectCommit()
 
 				rc, err := r.CreateReception(context.Background(), "1")
@@ -151,6 +188,7 @@ func TestCreateReception(t *testing.T) {
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
 
 				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
 				mock.ExpectQuery(
 					query1,
 				).WillReturnRows(
@@ -170,6 +208,7 @@ func TestCreateReception(t *testing.T) {
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
 
 				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
 				mock.ExpectQuery(
 					query1,
 				).WillReturnError(
@@ -189,6 +228,7 @@ func TestCreateReception(t *testing.T) {
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
 
 				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
 				mock.ExpectQuery(
 					query1,
 				).WillReturnError(
@@ -204,6 +244,98 @@ func TestCreateReception(t *testing.T) {
 				_, err := r.CreateReception(context.Background(), "1")
 				require.Error(t, err)
 
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Successful create while another pvz has reception in progress",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
+				mock.ExpectQuery(
+					query1,
+				).WithArgs(
+					"2",
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+				mock.ExpectExec(
+					query2,
+				).WithArgs(
+					sqlmock.AnyArg(), sqlmock.AnyArg(), "2", inProgressReceptionStatus,
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				expectOutboxEvent(mock, EventReceptionOpened)
+				mock.ExpectCommit()
+
+				rc, err := r.CreateReception(context.Background(), "2")
+				require.NoError(t, err)
+				require.Equal(t, "2", rc.PVZID)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error creating concurrent reception for the same pvz",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				expectPVZStatus(mock, activePVZStatus, "FOR SHARE")
+				mock.ExpectQuery(
+					query1,
+				).WithArgs(
+					"1",
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+				mock.ExpectExec(
+					query2,
+				).WillReturnError(
+					&pq.Error{Code: uniqueViolationCode},
+				)
+				mock.ExpectRollback()
+
+				_, err := r.CreateReception(context.Background(), "1")
+				require.ErrorIs(t, err, ErrReceptionInProgress)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error creating reception in inactive pvz",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				expectPVZStatus(mock, inactivePVZStatus, "FOR SHARE")
+				mock.ExpectRollback()
+
+				_, err := r.CreateReception(context.Background(), "1")
+				require.ErrorIs(t, err, ErrPVZInactive)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error creating reception in unknown pvz",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					`SELECT status FROM pvz WHERE id = $1 FOR SHARE`,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+				mock.ExpectRollback()
+
+				_, err := r.CreateReception(context.Background(), "1")
+				require.ErrorIs(t, err, ErrPVZNotFound)
+
 				err = mock.ExpectationsWereMet()
 				require.NoError(t, err)
 			},
@@ -221,6 +353,23 @@ func TestCreateReception(t *testing.T) {
 }
 
 func TestCloseReception(t *testing.T) {
+	const query1 = `SELECT id, execution_date, pvz_id, status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
+		LIMIT 1
+		FOR UPDATE`
+	const query2 = `UPDATE reception
+		SET status = $1
+		WHERE id = $2`
+	const query3 = `WITH stored AS (
+			UPDATE product
+			SET status = $2
+			WHERE reception_id = $1 AND status = $3
+			RETURNING id
+		)
+		INSERT INTO product_status_history (product_id, from_status, to_status, changed_at)
+		SELECT id, $3, $2, $4 FROM stored`
+
 	testCases := []struct {
 		name string
 		test func(*testing.T, Repository, sqlmock.Sqlmock)
@@ -228,10 +377,9 @@ func TestCloseReception(t *testing.T) {
 		{
 			name: "Success",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT id, execution_date, pvz_id, status FROM reception 
-					ORDER BY execution_date DESC 
-					LIMIT 1`,
+					query1,
 				).WillReturnRows(
 					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
 						1,
@@ -241,14 +389,21 @@ func TestCloseReception(t *testing.T) {
 					),
 				)
 				mock.ExpectExec(
-					`UPDATE reception
-					SET status = $1
-					WHERE id = $2`,
+					query2,
 				).WillReturnResult(
 					sqlmock.NewResult(1, 1),
 				)
+				mock.ExpectExec(
+					query3,
+				).WithArgs(
+					"1", storedProductStatus, acceptedProductStatus, sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(0, 2),
+				)
+				expectOutboxEvent(mock, EventReceptionClosed)
+				mock.ExpectCommit()
 
-				rc, err := r.CloseReception(context.Background(), "1")
+				rc, err := r.CloseReception(context.Background(), "1", nil)
 				require.NoError(t, err)
 				require.Equal(t, &Reception{
 					ID:            "1",
@@ -264,15 +419,15 @@ func TestCloseReception(t *testing.T) {
 		{
 			name: "Error closing reception with no receptions",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT id, execution_date, pvz_id, status FROM reception 
-					ORDER BY execution_date DESC 
-					LIMIT 1`,
+					query1,
 				).WillReturnError(
 					sql.ErrNoRows,
 				)
+				mock.ExpectRollback()
 
-				_, err := r.CloseReception(context.Background(), "1")
+				_, err := r.CloseReception(context.Background(), "1", nil)
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -282,10 +437,9 @@ func TestCloseReception(t *testing.T) {
 		{
 			name: "Error closing reception with already closed reception",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT id, execution_date, pvz_id, status FROM reception 
-					ORDER BY execution_date DESC 
-					LIMIT 1`,
+					query1,
 				).WillReturnRows(
 					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
 						1,
@@ -294,8 +448,9 @@ func TestCloseReception(t *testing.T) {
 						closeReceptionStatus,
 					),
 				)
+				mock.ExpectRollback()
 
-				_, err := r.CloseReception(context.Background(), "1")
+				_, err := r.CloseReception(context.Background(), "1", nil)
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -305,15 +460,15 @@ func TestCloseReception(t *testing.T) {
 		{
 			name: "Error querying last reception",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT id, execution_date, pvz_id, status FROM reception 
-					ORDER BY execution_date DESC 
-					LIMIT 1`,
+					query1,
 				).WillReturnError(
 					fmt.Errorf("error getting last reception status"),
 				)
+				mock.ExpectRollback()
 
-				_, err := r.CloseReception(context.Background(), "1")
+				_, err := r.CloseReception(context.Background(), "1", nil)
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -323,10 +478,9 @@ func TestCloseReception(t *testing.T) {
 		{
 			name: "Error updating reception",
 			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
 				mock.ExpectQuery(
-					`SELECT id, execution_date, pvz_id, status FROM reception 
-					ORDER BY execution_date DESC 
-					LIMIT 1`,
+					query1,
 				).WillReturnRows(
 					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
 						1,
@@ -336,14 +490,46 @@ func TestCloseReception(t *testing.T) {
 					),
 				)
 				mock.ExpectExec(
-					`UPDATE reception
-					SET status = $1
-					WHERE id = $2`,
+					query2,
 				).WillReturnError(
 					fmt.Errorf("error updating reception status"),
 				)
+				mock.ExpectRollback()
+
+				_, err := r.CloseReception(context.Background(), "1", nil)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error storing reception products",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+						1,
+						dummyDate,
+						1,
+						inProgressReceptionStatus,
+					),
+				)
+				mock.ExpectExec(
+					query2,
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				mock.ExpectExec(
+					query3,
+				).WillReturnError(
+					fmt.Errorf("error storing reception products"),
+				)
+				mock.ExpectRollback()
 
-				_, err := r.CloseReception(context.Background(), "1")
+				_, err := r.CloseReception(context.Background(), "1", nil)
 				require.Error(t, err)
 
 				err = mock.ExpectationsWereMet()
@@ -361,3 +547,126 @@ func TestCloseReception(t *testing.T) {
 	}
 
 }
+
+func TestGetReception(t *testing.T) {
+	const query = `SELECT id, execution_date, pvz_id, status FROM reception WHERE id = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+						"1", dummyDate, "2", inProgressReceptionStatus,
+					),
+				)
+
+				rc, err := r.GetReception(context.Background(), "1")
+				require.NoError(t, err)
+				require.Equal(t, &Reception{
+					ID:            "1",
+					ExecutionDate: dummyDate,
+					PVZID:         "2",
+					Status:        inProgressReceptionStatus,
+				}, rc)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error reception not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetReception(context.Background(), "1")
+				require.ErrorIs(t, err, ErrReceptionNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestGetLastReception(t *testing.T) {
+	const query = `SELECT id, execution_date, pvz_id, status FROM reception
+		WHERE pvz_id = $1
+		ORDER BY execution_date DESC
+		LIMIT 1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"2",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "execution_date", "pvz_id", "status"}).AddRow(
+						"1", dummyDate, "2", closeReceptionStatus,
+					),
+				)
+
+				rc, err := r.GetLastReception(context.Background(), "2")
+				require.NoError(t, err)
+				require.Equal(t, &Reception{
+					ID:            "1",
+					ExecutionDate: dummyDate,
+					PVZID:         "2",
+					Status:        closeReceptionStatus,
+				}, rc)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error no receptions",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetLastReception(context.Background(), "2")
+				require.ErrorIs(t, err, ErrReceptionNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
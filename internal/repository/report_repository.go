@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Разрезы отчетов
+const (
+	ReportDimensionPVZ  = "pvz"
+	ReportDimensionCity = "city"
+	ReportDimensionType = "type"
+)
+
+// reportDimension описывает колонку разреза в строке отчета
+type reportDimension struct {
+	name   string
+	column string
+	alias  string
+}
+
+var (
+	receptionReportDimensions = []reportDimension{
+		{name: ReportDimensionPVZ, column: "r.pvz_id", alias: "pvz_id"},
+		{name: ReportDimensionCity, column: "pvz.city", alias: "city"},
+	}
+	productReportDimensions = append(slices.Clone(receptionReportDimensions),
+		reportDimension{name: ReportDimensionType, column: "pr.type", alias: "type"},
+	)
+)
+
+// ReceptionsReport считает приемки, закрытые приемки и принятые в них товары по периодам
+func (pr *PostgresRepository) ReceptionsReport(ctx context.Context, filter ReportFilter) ([]*ReceptionReportRow, error) {
+	args := []interface{}{filter.Period, filter.From, filter.To, closeReceptionStatus, amendedReceptionStatus}
+	conditions := []string{"r.execution_date >= $2", "r.execution_date < $3"}
+	conditions, args = appendReportConditions(conditions, args, filter)
+
+	query := `SELECT date_trunc($1, r.execution_date) AS period, ` +
+		reportDimensionColumns(receptionReportDimensions, filter.GroupBy) + `,
+		COUNT(DISTINCT r.id) AS receptions,
+		COUNT(DISTINCT r.id) FILTER (WHERE r.status IN ($4, $5)) AS closed,
+		COUNT(pr.id) AS products
+		FROM reception r
+		JOIN pvz ON pvz.id = r.pvz_id
+		LEFT JOIN product pr ON pr.reception_id = r.id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		GROUP BY 1, 2, 3
+		ORDER BY 1, 2, 3`
+
+	rows := make([]*ReceptionReportRow, 0)
+	if err := pr.conn(ctx).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("error building receptions report: %w", err)
+	}
+
+	return rows, nil
+}
+
+// ProductsReport считает принятые товары по периодам по времени приемки товара
+func (pr *PostgresRepository) ProductsReport(ctx context.Context, filter ReportFilter) ([]*ProductReportRow, error) {
+	args := []interface{}{filter.Period, filter.From, filter.To}
+	conditions := []string{"pr.reception_date >= $2", "pr.reception_date < $3"}
+	conditions, args = appendReportConditions(conditions, args, filter)
+	if filter.Type != nil {
+		args = append(args, *filter.Type)
+		conditions = append(conditions, fmt.Sprintf("pr.type = $%d", len(args)))
+	}
+
+	query := `SELECT date_trunc($1, pr.reception_date) AS period, ` +
+		reportDimensionColumns(productReportDimensions, filter.GroupBy) + `,
+		COUNT(*) AS products
+		FROM product pr
+		JOIN reception r ON r.id = pr.reception_id
+		JOIN pvz ON pvz.id = r.pvz_id
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		GROUP BY 1, 2, 3, 4
+		ORDER BY 1, 2, 3, 4`
+
+	rows := make([]*ProductReportRow, 0)
+	if err := pr.conn(ctx).SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, fmt.Errorf("error building products report: %w", err)
+	}
+
+	return rows, nil
+}
+
+func appendReportConditions(conditions []string, args []interface{}, filter ReportFilter) ([]string, []interface{}) {
+	if filter.PVZID != nil {
+		args = append(args, *filter.PVZID)
+		conditions = append(conditions, fmt.Sprintf("r.pvz_id = $%d", len(args)))
+	}
+	if filter.City != nil {
+		args = append(args, *filter.City)
+		conditions = append(conditions, fmt.Sprintf("pvz.city = $%d", len(args)))
+	}
+	return conditions, args
+}
+
+// reportDimensionColumns возвращает колонки разрезов в постоянном порядке, чтобы GROUP BY
+// ссылался на них по номеру. Разрезы без группировки выбираются как NULL
+func reportDimensionColumns(dimensions []reportDimension, groupBy []string) string {
+	columns := make([]string, len(dimensions))
+	for i, dimension := range dimensions {
+		column := "NULL::text"
+		if slices.Contains(groupBy, dimension.name) {
+			column = dimension.column
+		}
+		columns[i] = column + " AS " + dimension.alias
+	}
+	return strings.Join(columns, ", ")
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceptionsReport(t *testing.T) {
+	columns := []string{"period", "pvz_id", "city", "receptions", "closed", "products"}
+
+	testCases := []struct {
+		name   string
+		filter func() ReportFilter
+		query  string
+		args   []driver.Value
+	}{
+		{
+			name: "Without grouping",
+			filter: func() ReportFilter {
+				return ReportFilter{From: dummyDate, To: dummyDate, Period: "week"}
+			},
+			query: `SELECT date_trunc($1, r.execution_date) AS period, NULL::text AS pvz_id, NULL::text AS city,
+				COUNT(DISTINCT r.id) AS receptions,
+				COUNT(DISTINCT r.id) FILTER (WHERE r.status IN ($4, $5)) AS closed,
+				COUNT(pr.id) AS products
+				FROM reception r
+				JOIN pvz ON pvz.id = r.pvz_id
+				LEFT JOIN product pr ON pr.reception_id = r.id
+				WHERE r.execution_date >= $2 AND r.execution_date < $3
+				GROUP BY 1, 2, 3
+				ORDER BY 1, 2, 3`,
+			args: []driver.Value{"week", dummyDate, dummyDate, closeReceptionStatus, amendedReceptionStatus},
+		},
+		{
+			name: "Grouped by pvz and city with filters",
+			filter: func() ReportFilter {
+				pvzID, city := "pvz1", "Москва"
+				return ReportFilter{
+					From:    dummyDate,
+					To:      dummyDate,
+					Period:  "day",
+					GroupBy: []string{ReportDimensionCity, ReportDimensionPVZ},
+					PVZID:   &pvzID,
+					City:    &city,
+				}
+			},
+			query: `SELECT date_trunc($1, r.execution_date) AS period, r.pvz_id AS pvz_id, pvz.city AS city,
+				COUNT(DISTINCT r.id) AS receptions,
+				COUNT(DISTINCT r.id) FILTER (WHERE r.status IN ($4, $5)) AS closed,
+				COUNT(pr.id) AS products
+				FROM reception r
+				JOIN pvz ON pvz.id = r.pvz_id
+				LEFT JOIN product pr ON pr.reception_id = r.id
+				WHERE r.execution_date >= $2 AND r.execution_date < $3 AND r.pvz_id = $6 AND pvz.city = $7
+				GROUP BY 1, 2, 3
+				ORDER BY 1, 2, 3`,
+			args: []driver.Value{"day", dummyDate, dummyDate, closeReceptionStatus, amendedReceptionStatus, "pvz1", "Москва"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(tc.query).WithArgs(tc.args...).WillReturnRows(
+					sqlmock.NewRows(columns).AddRow(dummyDate, "pvz1", "Москва", 3, 2, 10),
+				)
+
+				rows, err := r.ReceptionsReport(context.Background(), tc.filter())
+				require.NoError(t, err)
+				require.Len(t, rows, 1)
+				require.Equal(t, 3, rows[0].Receptions)
+				require.Equal(t, 2, rows[0].Closed)
+				require.Equal(t, 10, rows[0].Products)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			})
+		})
+	}
+
+	t.Run("Error", func(t *testing.T) {
+		withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+			mock.ExpectQuery(testCases[0].query).WillReturnError(fmt.Errorf("db error"))
+
+			_, err := r.ReceptionsReport(context.Background(), testCases[0].filter())
+			require.Error(t, err)
+
+			err = mock.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	})
+}
+
+func TestProductsReport(t *testing.T) {
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		productType := "обувь"
+		mock.ExpectQuery(
+			`SELECT date_trunc($1, pr.reception_date) AS period, NULL::text AS pvz_id, pvz.city AS city, pr.type AS type,
+			COUNT(*) AS products
+			FROM product pr
+			JOIN reception r ON r.id = pr.reception_id
+			JOIN pvz ON pvz.id = r.pvz_id
+			WHERE pr.reception_date >= $2 AND pr.reception_date < $3 AND pr.type = $4
+			GROUP BY 1, 2, 3, 4
+			ORDER BY 1, 2, 3, 4`,
+		).WithArgs(
+			"month", dummyDate, dummyDate, productType,
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"period", "pvz_id", "city", "type", "products"}).
+				AddRow(dummyDate, nil, "Москва", productType, 7),
+		)
+
+		rows, err := r.ProductsReport(context.Background(), ReportFilter{
+			From:    dummyDate,
+			To:      dummyDate,
+			Period:  "month",
+			GroupBy: []string{ReportDimensionType, ReportDimensionCity},
+			Type:    &productType,
+		})
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Nil(t, rows[0].PVZID)
+		require.Equal(t, "Москва", *rows[0].City)
+		require.Equal(t, productType, *rows[0].Type)
+		require.Equal(t, 7, rows[0].Products)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
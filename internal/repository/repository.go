@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -10,26 +11,110 @@ import (
 
 type Repository interface {
 	ExecTx(ctx context.Context, fn func(*sqlx.Tx) error) error
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+
+	// Health
+	Ping(ctx context.Context) error
+	GetSchemaVersion(ctx context.Context) (*SchemaVersion, error)
 
 	// PVZ
-	ListPVZ(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*PVZWithReceptions, error)
+	ListPVZ(ctx context.Context, filter PVZFilter, page, limit int) ([]*PVZWithReceptions, error)
+	ListPVZAfter(ctx context.Context, filter PVZFilter, after *PVZCursor, limit int) ([]*PVZWithReceptions, error)
 	ListAllPVZ(ctx context.Context) ([]*PVZ, error)
-	CreatePVZ(ctx context.Context, city string) (*PVZ, error)
+	CreatePVZ(ctx context.Context, city string, location PVZLocation) (*PVZ, error)
+	GetPVZ(ctx context.Context, PVZID string) (*PVZWithReceptions, error)
+	UpdatePVZ(ctx context.Context, PVZID string, update PVZUpdate) (*PVZ, error)
+	ListNearbyPVZ(ctx context.Context, lat, lon, radius float64, limit int) ([]*NearbyPVZ, error)
+	DeactivatePVZ(ctx context.Context, PVZID string) (*PVZ, error)
 
 	// Reception
 	CreateReception(ctx context.Context, PVZID string) (*Reception, error)
-	CloseReception(ctx context.Context, PVZID string) (*Reception, error)
-	ListReception(ctx context.Context, PVZID string) ([]*Reception, error)
+	GetReception(ctx context.Context, receptionID string) (*Reception, error)
+	GetLastReception(ctx context.Context, PVZID string) (*Reception, error)
+	CloseReception(ctx context.Context, PVZID string, reconcile ReconcileFunc) (*Reception, error)
+	ListReception(ctx context.Context, PVZID string, filter ReceptionFilter) ([]*Reception, error)
+	ReopenReception(ctx context.Context, receptionID, reason, reopenedBy string) (*Reception, error)
+	AmendReception(ctx context.Context, receptionID string, amendment ReceptionAmendment, reconcile ReconcileFunc) (*ReceptionCorrection, error)
+	ListReceptionCorrections(ctx context.Context, receptionID string) ([]*ReceptionCorrection, error)
+
+	// Manifest
+	SaveReceptionManifest(ctx context.Context, manifest *ReceptionManifest) error
+	GetReceptionManifest(ctx context.Context, receptionID string) (*ReceptionManifest, error)
+	GetReconciliation(ctx context.Context, receptionID string) (*Reconciliation, error)
+	SaveReconciliation(ctx context.Context, reconciliation *Reconciliation) error
 
 	// Product
 	ListProducts(ctx context.Context, receptionID string) ([]*Product, error)
-	CreateProduct(ctx context.Context, receptionID string, productType string) (*Product, error)
+	CreateProduct(ctx context.Context, PVZID string, newProduct NewProduct) (*Product, error)
+	CreateProducts(ctx context.Context, PVZID string, newProducts []NewProduct) ([]*Product, error)
 	DeleteProduct(ctx context.Context, PVZID string) (*Product, error)
+	GetProduct(ctx context.Context, productID string) (*Product, error)
+	ListProductsByBarcode(ctx context.Context, barcode string) ([]*Product, error)
+	UpdateProductStatus(ctx context.Context, productID, fromStatus, toStatus, changedBy string) (*Product, error)
+	ListProductStatusHistory(ctx context.Context, productID string) ([]*ProductStatusChange, error)
+
+	// Report
+	ReceptionsReport(ctx context.Context, filter ReportFilter) ([]*ReceptionReportRow, error)
+	ProductsReport(ctx context.Context, filter ReportFilter) ([]*ProductReportRow, error)
+
+	// Export
+	ExportReceptions(ctx context.Context, startDate, endDate *time.Time, fn func(*ReceptionExportRow) error) error
+
+	// Dictionary
+	ListCities(ctx context.Context) ([]*DictionaryEntry, error)
+	GetCity(ctx context.Context, name string) (*DictionaryEntry, error)
+	CreateCity(ctx context.Context, name string) (*DictionaryEntry, error)
+	DeleteCity(ctx context.Context, name string) error
+	SeedCities(ctx context.Context, names []string) error
+	ListProductTypes(ctx context.Context) ([]*DictionaryEntry, error)
+	GetProductType(ctx context.Context, name string) (*DictionaryEntry, error)
+	CreateProductType(ctx context.Context, name string) (*DictionaryEntry, error)
+	DeleteProductType(ctx context.Context, name string) error
+	SeedProductTypes(ctx context.Context, names []string) error
+
+	// Employee
+	ListPVZEmployees(ctx context.Context, PVZID string) ([]*PVZEmployee, error)
+	AssignEmployee(ctx context.Context, PVZID, userID string) (*PVZEmployee, error)
+	UnassignEmployee(ctx context.Context, PVZID, userID string) error
+	IsEmployeeAssigned(ctx context.Context, PVZID, userID string) (bool, error)
+
+	// Audit
+	CreateAuditRecord(ctx context.Context, record *AuditRecord) error
+	ListAuditRecords(ctx context.Context, filter AuditFilter) ([]*AuditRecord, error)
+
+	// Idempotency
+	ReserveIdempotencyKey(ctx context.Context, userID, key, requestHash string, createdAt, expiresAt time.Time) (bool, error)
+	GetIdempotencyKey(ctx context.Context, userID, key string) (*IdempotencyRecord, error)
+	SaveIdempotencyResponse(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error
+	DeleteIdempotencyKey(ctx context.Context, userID, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
 
 	// User
 	ListUser(ctx context.Context) ([]*User, error)
 	CreateUser(ctx context.Context, email, password, role string) (*User, error)
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	GetUserByID(ctx context.Context, id string) (*User, error)
+
+	// Token
+	CreateRefreshToken(ctx context.Context, userID, tokenHash string, expiresAt time.Time) (*RefreshToken, error)
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	RotateRefreshToken(ctx context.Context, tokenHash, newTokenHash string, expiresAt time.Time) (*RefreshToken, error)
+	RevokeRefreshToken(ctx context.Context, userID, tokenHash string) error
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+	DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error)
+
+	// Webhook
+	CreateWebhookSubscription(ctx context.Context, url, secret string, eventTypes []string) (*WebhookSubscription, error)
+	ListWebhookSubscriptions(ctx context.Context) ([]*WebhookSubscription, error)
+	GetWebhookSubscription(ctx context.Context, id string) (*WebhookSubscription, error)
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+	FanOutOutboxEvents(ctx context.Context, now time.Time) (int64, error)
+	ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*WebhookDelivery, error)
+	MarkWebhookDelivered(ctx context.Context, id string, deliveredAt time.Time) error
+	RetryWebhookDelivery(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error
+	DeadLetterWebhookDelivery(ctx context.Context, id string, lastError string) error
 }
 
 type PostgresRepository struct {
@@ -42,9 +127,35 @@ func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
 	}
 }
 
+type txContextKey struct{}
+
+// dbConn - общие методы *sqlx.DB и *sqlx.Tx, которыми пользуется репозиторий
+type dbConn interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+// conn возвращает транзакцию, открытую WithinTx, если она есть в контексте, иначе соединение с БД
+func (pr *PostgresRepository) conn(ctx context.Context) dbConn {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return tx
+	}
+	return pr.db
+}
+
+// ExecTx выполняет fn в транзакции. Внутри WithinTx используется уже открытая транзакция
 func (pr *PostgresRepository) ExecTx(ctx context.Context, fn func(*sqlx.Tx) error) error {
+	if tx, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		if err := fn(tx); err != nil {
+			return fmt.Errorf("error executing transaction function: %w", err)
+		}
+		return nil
+	}
+
 	tx, err := pr.db.BeginTxx(ctx, nil)
 	if err != nil {
+		slog.ErrorContext(ctx, "Error starting transaction", "error", err)
 		return fmt.Errorf("error starting transaction: %w", err)
 	}
 	defer tx.Rollback()
@@ -55,6 +166,33 @@ func (pr *PostgresRepository) ExecTx(ctx context.Context, fn func(*sqlx.Tx) erro
 	}
 
 	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "Error committing transaction", "error", err)
+		return fmt.Errorf("error committing transaction: %w", err)
+	}
+
+	return nil
+}
+
+// WithinTx выполняет fn в одной транзакции: все методы репозитория, вызванные с контекстом fn,
+// работают в ней. Ошибка fn откатывает транзакцию и возвращается без обертки
+func (pr *PostgresRepository) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := pr.db.BeginTxx(ctx, nil)
+	if err != nil {
+		slog.ErrorContext(ctx, "Error starting transaction", "error", err)
+		return fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		slog.ErrorContext(ctx, "Error committing transaction", "error", err)
 		return fmt.Errorf("error committing transaction: %w", err)
 	}
 
@@ -25,6 +25,17 @@ func withMockRepository(t *testing.T, fn func(Repository, sqlmock.Sqlmock)) {
 	fn(r, mock)
 }
 
+func expectOutboxEvent(mock sqlmock.Sqlmock, eventType string) {
+	mock.ExpectExec(
+		`INSERT INTO outbox_events (id, event_type, aggregate_id, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+	).WithArgs(
+		sqlmock.AnyArg(), eventType, sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+	).WillReturnResult(
+		sqlmock.NewResult(1, 1),
+	)
+}
+
 func TestExecTx(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -70,3 +81,84 @@ func TestExecTx(t *testing.T) {
 		})
 	}
 }
+
+func TestWithinTx(t *testing.T) {
+	auditQuery := `INSERT INTO audit_log (id, actor_id, actor_role, action, entity_type, entity_id, before, after, request_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`
+	updateQuery := `UPDATE pvz SET status = 'inactive' WHERE id = $1`
+
+	withinTx := func(r Repository) error {
+		return r.WithinTx(context.Background(), func(ctx context.Context) error {
+			err := r.ExecTx(ctx, func(tx *sqlx.Tx) error {
+				_, err := tx.ExecContext(ctx, updateQuery, "pvz1")
+				return err
+			})
+			if err != nil {
+				return err
+			}
+			return r.CreateAuditRecord(ctx, &AuditRecord{Action: "deactivate", EntityType: "pvz", EntityID: "pvz1"})
+		})
+	}
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Mutation and audit record share transaction",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(updateQuery).WithArgs("pvz1").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(auditQuery).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+
+				err := withinTx(r)
+				require.NoError(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		},
+		{
+			name: "Audit error rolls back mutation",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				auditErr := fmt.Errorf("audit_log is unavailable")
+				mock.ExpectBegin()
+				mock.ExpectExec(updateQuery).WithArgs("pvz1").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(auditQuery).WillReturnError(auditErr)
+				mock.ExpectRollback()
+
+				err := withinTx(r)
+				require.ErrorIs(t, err, auditErr)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		},
+		{
+			name: "Error committing transaction",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(updateQuery).WithArgs("pvz1").WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectExec(auditQuery).WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit().WillReturnError(fmt.Errorf("error committing transaction"))
+
+				err := withinTx(r)
+				require.Error(t, err)
+				require.NoError(t, mock.ExpectationsWereMet())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func expectPVZStatus(mock sqlmock.Sqlmock, status, lock string) {
+	mock.ExpectQuery(
+		`SELECT status FROM pvz WHERE id = $1 ` + lock,
+	).WillReturnRows(
+		sqlmock.NewRows([]string{"status"}).AddRow(status),
+	)
+}
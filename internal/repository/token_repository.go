@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrRefreshTokenNotFound = errors.New("refresh token not found")
+	ErrRefreshTokenInvalid  = errors.New("refresh token is expired or revoked")
+)
+
+func (pr *PostgresRepository) CreateRefreshToken(ctx context.Context, userID, tokenHash string, expiresAt time.Time) (*RefreshToken, error) {
+	createdAt := time.Now()
+	newID := uuid.New().String()
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		newID,
+		userID,
+		tokenHash,
+		expiresAt,
+		createdAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating refresh token: %w", err)
+	}
+
+	return &RefreshToken{
+		ID:        newID,
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+func (pr *PostgresRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	var token RefreshToken
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		&token,
+		`SELECT id, user_id, token_hash, expires_at, revoked_at, created_at FROM refresh_tokens WHERE token_hash = $1`,
+		tokenHash,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRefreshTokenNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting refresh token: %w", err)
+	}
+
+	return &token, nil
+}
+
+func (pr *PostgresRepository) RotateRefreshToken(ctx context.Context, tokenHash, newTokenHash string, expiresAt time.Time) (*RefreshToken, error) {
+	token := &RefreshToken{}
+	err := pr.ExecTx(
+		ctx,
+		func(tx *sqlx.Tx) error {
+			now := time.Now()
+			var userID string
+			err := tx.QueryRowContext(
+				ctx,
+				`UPDATE refresh_tokens
+				SET revoked_at = $2
+				WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > $2
+				RETURNING user_id`,
+				tokenHash,
+				now,
+			).Scan(&userID)
+			if errors.Is(err, sql.ErrNoRows) {
+				return ErrRefreshTokenInvalid
+			}
+			if err != nil {
+				return fmt.Errorf("error revoking refresh token: %w", err)
+			}
+
+			newID := uuid.New().String()
+			_, err = tx.ExecContext(
+				ctx,
+				`INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)`,
+				newID,
+				userID,
+				newTokenHash,
+				expiresAt,
+				now,
+			)
+			if err != nil {
+				return fmt.Errorf("error inserting refresh token: %w", err)
+			}
+
+			token.ID = newID
+			token.UserID = userID
+			token.TokenHash = newTokenHash
+			token.ExpiresAt = expiresAt
+			token.CreatedAt = now
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error rotating refresh token: %w", err)
+	}
+
+	return token, nil
+}
+
+// RevokeRefreshToken отзывает refresh токен, только если он выдан этому пользователю
+func (pr *PostgresRepository) RevokeRefreshToken(ctx context.Context, userID, tokenHash string) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`UPDATE refresh_tokens SET revoked_at = $3 WHERE token_hash = $1 AND user_id = $2 AND revoked_at IS NULL`,
+		tokenHash,
+		userID,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("error revoking refresh token: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`UPDATE refresh_tokens SET revoked_at = $2 WHERE user_id = $1 AND revoked_at IS NULL`,
+		userID,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("error revoking user refresh tokens: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`INSERT INTO revoked_tokens (jti, expires_at, revoked_at) VALUES ($1, $2, $3)
+		ON CONFLICT (jti) DO NOTHING`,
+		jti,
+		expiresAt,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("error revoking access token: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpiredRevokedTokens удаляет отозванные access токены, срок действия которых уже истек
+func (pr *PostgresRepository) DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error) {
+	result, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`DELETE FROM revoked_tokens WHERE expires_at <= $1`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("error deleting expired revoked tokens: %w", err)
+	}
+
+	return result.RowsAffected()
+}
+
+func (pr *PostgresRepository) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	var revoked bool
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		&revoked,
+		`SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`,
+		jti,
+	)
+	if err != nil {
+		return false, fmt.Errorf("error checking access token revocation: %w", err)
+	}
+
+	return revoked, nil
+}
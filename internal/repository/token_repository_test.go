@@ -0,0 +1,361 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRefreshToken(t *testing.T) {
+	query := `INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					sqlmock.AnyArg(), "1", "hash", dummyDate, sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+
+				token, err := r.CreateRefreshToken(context.Background(), "1", "hash", dummyDate)
+				require.NoError(t, err)
+				require.Equal(t, "1", token.UserID)
+				require.Equal(t, "hash", token.TokenHash)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error creating",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnError(fmt.Errorf("error creating refresh token"))
+
+				_, err := r.CreateRefreshToken(context.Background(), "1", "hash", dummyDate)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestGetRefreshToken(t *testing.T) {
+	query := `SELECT id, user_id, token_hash, expires_at, revoked_at, created_at FROM refresh_tokens WHERE token_hash = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"hash",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "user_id", "token_hash", "expires_at", "revoked_at", "created_at"}).
+						AddRow("1", "2", "hash", dummyDate, nil, dummyDate),
+				)
+
+				token, err := r.GetRefreshToken(context.Background(), "hash")
+				require.NoError(t, err)
+				require.Equal(t, &RefreshToken{
+					ID:        "1",
+					UserID:    "2",
+					TokenHash: "hash",
+					ExpiresAt: dummyDate,
+					CreatedAt: dummyDate,
+				}, token)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error token not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(sql.ErrNoRows)
+
+				_, err := r.GetRefreshToken(context.Background(), "hash")
+				require.ErrorIs(t, err, ErrRefreshTokenNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	query1 := `UPDATE refresh_tokens
+		SET revoked_at = $2
+		WHERE token_hash = $1 AND revoked_at IS NULL AND expires_at > $2
+		RETURNING user_id`
+	query2 := `INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WithArgs(
+					"old", sqlmock.AnyArg(),
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"user_id"}).AddRow("1"),
+				)
+				mock.ExpectExec(
+					query2,
+				).WithArgs(
+					sqlmock.AnyArg(), "1", "new", dummyDate, sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+				mock.ExpectCommit()
+
+				token, err := r.RotateRefreshToken(context.Background(), "old", "new", dummyDate)
+				require.NoError(t, err)
+				require.Equal(t, "1", token.UserID)
+				require.Equal(t, "new", token.TokenHash)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error rotating revoked or expired token",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+
+				_, err := r.RotateRefreshToken(context.Background(), "old", "new", dummyDate)
+				require.ErrorIs(t, err, ErrRefreshTokenInvalid)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error inserting new token",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(
+					query1,
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"user_id"}).AddRow("1"),
+				)
+				mock.ExpectExec(
+					query2,
+				).WillReturnError(fmt.Errorf("error inserting refresh token"))
+				mock.ExpectRollback()
+
+				_, err := r.RotateRefreshToken(context.Background(), "old", "new", dummyDate)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestRevokeRefreshToken(t *testing.T) {
+	query := `UPDATE refresh_tokens SET revoked_at = $3 WHERE token_hash = $1 AND user_id = $2 AND revoked_at IS NULL`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					"hash", "1", sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(0, 1),
+				)
+
+				err := r.RevokeRefreshToken(context.Background(), "1", "hash")
+				require.NoError(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error revoking",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnError(fmt.Errorf("error revoking refresh token"))
+
+				err := r.RevokeRefreshToken(context.Background(), "1", "hash")
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestDeleteExpiredRevokedTokens(t *testing.T) {
+	query := `DELETE FROM revoked_tokens WHERE expires_at <= $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					dummyDate,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 3),
+				)
+
+				deleted, err := r.DeleteExpiredRevokedTokens(context.Background(), dummyDate)
+				require.NoError(t, err)
+				require.Equal(t, int64(3), deleted)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error deleting",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnError(fmt.Errorf("error deleting expired revoked tokens"))
+
+				_, err := r.DeleteExpiredRevokedTokens(context.Background(), dummyDate)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestIsAccessTokenRevoked(t *testing.T) {
+	query := `SELECT EXISTS (SELECT 1 FROM revoked_tokens WHERE jti = $1)`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Revoked",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"jti",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"exists"}).AddRow(true),
+				)
+
+				revoked, err := r.IsAccessTokenRevoked(context.Background(), "jti")
+				require.NoError(t, err)
+				require.True(t, revoked)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error checking",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(fmt.Errorf("error checking access token revocation"))
+
+				_, err := r.IsAccessTokenRevoked(context.Background(), "jti")
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
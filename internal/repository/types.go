@@ -1,18 +1,244 @@
 package repository
 
-import "time"
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
 
 type PVZ struct {
-	ID               string    `db:"id"`
-	City             string    `db:"city"`
-	RegistrationDate time.Time `db:"registration_date"`
+	ID               string     `db:"id" json:"id"`
+	City             string     `db:"city" json:"city"`
+	RegistrationDate time.Time  `db:"registration_date" json:"registrationDate"`
+	Status           string     `db:"status" json:"status"`
+	DeactivatedAt    *time.Time `db:"deactivated_at" json:"deactivatedAt,omitempty"`
+	PVZLocation
+}
+
+// PVZFilter - фильтры списка ПВЗ. StartDate, EndDate и ReceptionStatus отбирают приемки:
+// в список попадают только подходящие приемки, а ПВЗ без них - только при IncludeEmpty
+type PVZFilter struct {
+	StartDate       *time.Time
+	EndDate         *time.Time
+	City            *string
+	ReceptionStatus *string
+	IncludeEmpty    bool
+}
+
+// PVZCursor - позиция в списке ПВЗ, отсортированном по дате регистрации и id по убыванию
+type PVZCursor struct {
+	RegistrationDate time.Time
+	ID               string
+}
+
+// PVZLocation - адрес, координаты и часы работы ПВЗ, все поля необязательные
+type PVZLocation struct {
+	Street       *string  `db:"street" json:"street,omitempty"`
+	House        *string  `db:"house" json:"house,omitempty"`
+	PostalCode   *string  `db:"postal_code" json:"postalCode,omitempty"`
+	Latitude     *float64 `db:"latitude" json:"latitude,omitempty"`
+	Longitude    *float64 `db:"longitude" json:"longitude,omitempty"`
+	OpeningHours *string  `db:"opening_hours" json:"openingHours,omitempty"`
+}
+
+// PVZUpdate - частичное изменение ПВЗ, nil-поля остаются без изменений
+type PVZUpdate struct {
+	City *string
+	PVZLocation
+}
+
+// NearbyPVZ - ПВЗ с расстоянием до точки поиска в метрах
+type NearbyPVZ struct {
+	PVZ
+	Distance float64 `db:"distance" json:"distance"`
 }
 
 type Reception struct {
-	ID            string    `db:"id"`
-	ExecutionDate time.Time `db:"execution_date"`
-	PVZID         string    `db:"pvz_id"`
-	Status        string    `db:"status"`
+	ID            string    `db:"id" json:"id"`
+	ExecutionDate time.Time `db:"execution_date" json:"dateTime"`
+	PVZID         string    `db:"pvz_id" json:"pvzId"`
+	Status        string    `db:"status" json:"status"`
+}
+
+// ReceptionManifest - ожидаемый состав приемки от перевозчика
+type ReceptionManifest struct {
+	ReceptionID string `db:"reception_id"`
+	// BlockOnDiscrepancies запрещает закрывать приемку с неподтвержденными расхождениями
+	BlockOnDiscrepancies bool            `db:"block_on_discrepancies"`
+	UploadedBy           *string         `db:"uploaded_by"`
+	UploadedAt           time.Time       `db:"uploaded_at"`
+	Items                []*ManifestItem `db:"-"`
+}
+
+// ManifestItem - строка манифеста: конкретный штрихкод или количество товаров типа
+type ManifestItem struct {
+	Barcode  *string `db:"barcode" json:"barcode,omitempty"`
+	Type     *string `db:"type" json:"type,omitempty"`
+	Quantity int     `db:"quantity" json:"quantity"`
+}
+
+// ReconciliationReport - результат сверки товаров приемки с манифестом
+type ReconciliationReport struct {
+	Matched []*ManifestItem `json:"matched"`
+	Missing []*ManifestItem `json:"missing"`
+	Extra   []*ManifestItem `json:"extra"`
+}
+
+func (r ReconciliationReport) HasDiscrepancies() bool {
+	return len(r.Missing) > 0 || len(r.Extra) > 0
+}
+
+func (r ReconciliationReport) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *ReconciliationReport) Scan(src interface{}) error {
+	return scanJSON(src, r)
+}
+
+// Reconciliation - сохраненная сверка приемки. Подтверждение сбрасывается, если отчет изменился
+type Reconciliation struct {
+	ReceptionID      string               `db:"reception_id"`
+	Report           ReconciliationReport `db:"report"`
+	HasDiscrepancies bool                 `db:"has_discrepancies"`
+	AcknowledgedBy   *string              `db:"acknowledged_by"`
+	AcknowledgedAt   *time.Time           `db:"acknowledged_at"`
+	CreatedAt        time.Time            `db:"created_at"`
+}
+
+// ReconcileFunc сверяет товары закрываемой приемки с манифестом и возвращает сверку для сохранения.
+// previous - ранее сохраненная сверка или nil. Ошибка отменяет закрытие приемки
+type ReconcileFunc func(manifest *ReceptionManifest, products []*Product, previous *Reconciliation) (*Reconciliation, error)
+
+// ReceptionCorrection - открытие заново или исправление закрытой приемки модератором с указанием причины
+type ReceptionCorrection struct {
+	ID          string           `db:"id"`
+	ReceptionID string           `db:"reception_id"`
+	Action      string           `db:"action"`
+	Reason      string           `db:"reason"`
+	ChangedBy   *string          `db:"changed_by"`
+	Changes     ReceptionChanges `db:"changes"`
+	CreatedAt   time.Time        `db:"created_at"`
+}
+
+// ReceptionChanges - товары, добавленные в приемку и удаленные из нее при исправлении
+type ReceptionChanges struct {
+	Added   []*Product `json:"added"`
+	Removed []*Product `json:"removed"`
+}
+
+func (c ReceptionChanges) Value() (driver.Value, error) {
+	return json.Marshal(c)
+}
+
+func (c *ReceptionChanges) Scan(src interface{}) error {
+	return scanJSON(src, c)
+}
+
+// ReceptionAmendment - исправление состава закрытой приемки. Удалить можно только товары,
+// которые еще хранятся в ПВЗ, добавленные товары сразу переходят на хранение
+type ReceptionAmendment struct {
+	AddProducts      []NewProduct
+	RemoveProductIDs []string
+	Reason           string
+	AmendedBy        string
+}
+
+type DictionaryEntry struct {
+	Name      string    `db:"name" json:"name"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+type PVZEmployee struct {
+	PVZID      string    `db:"pvz_id" json:"pvzId"`
+	UserID     string    `db:"user_id" json:"userId"`
+	Email      string    `db:"email" json:"email"`
+	AssignedAt time.Time `db:"assigned_at" json:"assignedAt"`
+}
+
+// AuditRecord - запись журнала аудита. Before и After содержат JSON сущности до и после изменения
+type AuditRecord struct {
+	ID         string    `db:"id"`
+	ActorID    *string   `db:"actor_id"`
+	ActorRole  *string   `db:"actor_role"`
+	Action     string    `db:"action"`
+	EntityType string    `db:"entity_type"`
+	EntityID   string    `db:"entity_id"`
+	Before     []byte    `db:"before"`
+	After      []byte    `db:"after"`
+	RequestID  *string   `db:"request_id"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// AuditFilter - условия выборки журнала аудита, nil-поля выборку не ограничивают
+type AuditFilter struct {
+	EntityType *string
+	EntityID   *string
+	ActorID    *string
+	From       *time.Time
+	To         *time.Time
+	Page       int
+	Limit      int
+}
+
+// ReceptionFilter - условия выборки истории приемок ПВЗ, nil-поля выборку не ограничивают
+type ReceptionFilter struct {
+	Status *string
+	From   *time.Time
+	To     *time.Time
+	Page   int
+	Limit  int
+}
+
+// ReportFilter - параметры отчета за период [From, To). Period - единица группировки по времени
+// (day, week, month), GroupBy - дополнительные разрезы, nil-фильтры выборку не ограничивают
+type ReportFilter struct {
+	From    time.Time
+	To      time.Time
+	Period  string
+	GroupBy []string
+	PVZID   *string
+	City    *string
+	Type    *string
+}
+
+// ReceptionReportRow - строка отчета по приемкам. Разрезы, по которым не группировали, равны nil
+type ReceptionReportRow struct {
+	Period     time.Time `db:"period"`
+	PVZID      *string   `db:"pvz_id"`
+	City       *string   `db:"city"`
+	Receptions int       `db:"receptions"`
+	Closed     int       `db:"closed"`
+	Products   int       `db:"products"`
+}
+
+// ProductReportRow - строка отчета по принятым товарам
+type ProductReportRow struct {
+	Period   time.Time `db:"period"`
+	PVZID    *string   `db:"pvz_id"`
+	City     *string   `db:"city"`
+	Type     *string   `db:"type"`
+	Products int       `db:"products"`
+}
+
+// ReceptionExportRow - строка выгрузки приемок: товар вместе с приемкой и ПВЗ.
+// У приемки без товаров поля товара равны nil
+type ReceptionExportRow struct {
+	PVZID           string     `db:"pvz_id"`
+	City            string     `db:"city"`
+	Street          *string    `db:"street"`
+	House           *string    `db:"house"`
+	ReceptionID     string     `db:"reception_id"`
+	ReceptionDate   time.Time  `db:"reception_date"`
+	ReceptionStatus string     `db:"reception_status"`
+	ProductID       *string    `db:"product_id"`
+	ProductType     *string    `db:"product_type"`
+	ProductStatus   *string    `db:"product_status"`
+	Barcode         *string    `db:"barcode"`
+	ProductDate     *time.Time `db:"product_date"`
 }
 
 type User struct {
@@ -24,10 +250,68 @@ type User struct {
 }
 
 type Product struct {
-	ID            string    `db:"id"`
-	ReceptionDate time.Time `db:"reception_date"`
-	ReceptionId   string    `db:"reception_id"`
-	Type          string    `db:"type"`
+	ID            string    `db:"id" json:"id"`
+	ReceptionDate time.Time `db:"reception_date" json:"dateTime"`
+	ReceptionId   string    `db:"reception_id" json:"receptionId"`
+	Type          string    `db:"type" json:"type"`
+	Status        string    `db:"status" json:"status"`
+	ProductDetails
+}
+
+// ProductDetails - штрихкод, вес, габариты и произвольные атрибуты товара, все поля необязательные.
+// Штрихкод уникален в пределах приемки
+type ProductDetails struct {
+	Barcode     *string           `db:"barcode" json:"barcode,omitempty"`
+	WeightGrams *int              `db:"weight_grams" json:"weightGrams,omitempty"`
+	LengthMm    *int              `db:"length_mm" json:"lengthMm,omitempty"`
+	WidthMm     *int              `db:"width_mm" json:"widthMm,omitempty"`
+	HeightMm    *int              `db:"height_mm" json:"heightMm,omitempty"`
+	Attributes  ProductAttributes `db:"attributes" json:"attributes,omitempty"`
+}
+
+// NewProduct - данные для добавления товара в приемку
+type NewProduct struct {
+	Type string
+	ProductDetails
+}
+
+// ProductAttributes хранится в колонке JSONB
+type ProductAttributes map[string]string
+
+func (a ProductAttributes) Value() (driver.Value, error) {
+	if a == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(a)
+}
+
+func (a *ProductAttributes) Scan(src interface{}) error {
+	if src == nil {
+		*a = nil
+		return nil
+	}
+	return scanJSON(src, a)
+}
+
+// scanJSON разбирает значение колонки JSONB
+func scanJSON(src interface{}, dest interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return fmt.Errorf("unsupported json column type %T", src)
+	}
+}
+
+type ProductStatusChange struct {
+	ID         string    `db:"id"`
+	ProductID  string    `db:"product_id"`
+	FromStatus *string   `db:"from_status"`
+	ToStatus   string    `db:"to_status"`
+	ChangedBy  *string   `db:"changed_by"`
+	ChangedAt  time.Time `db:"changed_at"`
 }
 
 type ReceptionWithProducts struct {
@@ -39,3 +323,51 @@ type PVZWithReceptions struct {
 	PVZ        *PVZ
 	Receptions []*ReceptionWithProducts
 }
+
+type RefreshToken struct {
+	ID        string     `db:"id"`
+	UserID    string     `db:"user_id"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+type WebhookSubscription struct {
+	ID         string         `db:"id"`
+	URL        string         `db:"url"`
+	Secret     string         `db:"secret"`
+	EventTypes pq.StringArray `db:"event_types"`
+	CreatedAt  time.Time      `db:"created_at"`
+}
+
+// WebhookDelivery - доставка события подписчику вместе с данными события и подписки
+type WebhookDelivery struct {
+	ID             string `db:"id"`
+	EventID        string `db:"event_id"`
+	SubscriptionID string `db:"subscription_id"`
+	Attempts       int    `db:"attempts"`
+	EventType      string `db:"event_type"`
+	Payload        []byte `db:"payload"`
+	URL            string `db:"url"`
+	Secret         string `db:"secret"`
+}
+
+// SchemaVersion - версия схемы из таблицы schema_migrations, которую ведет golang-migrate
+type SchemaVersion struct {
+	Version int64 `db:"version"`
+	Dirty   bool  `db:"dirty"`
+}
+
+// IdempotencyRecord - сохраненный ответ на запрос с заголовком Idempotency-Key.
+// Пока запрос выполняется, StatusCode пустой
+type IdempotencyRecord struct {
+	UserID       string    `db:"user_id"`
+	Key          string    `db:"key"`
+	RequestHash  string    `db:"request_hash"`
+	StatusCode   *int      `db:"status_code"`
+	ContentType  *string   `db:"content_type"`
+	ResponseBody []byte    `db:"response_body"`
+	CreatedAt    time.Time `db:"created_at"`
+	ExpiresAt    time.Time `db:"expires_at"`
+}
@@ -10,7 +10,7 @@ import (
 
 func (pr *PostgresRepository) ListUser(ctx context.Context) ([]*User, error) {
 	var users []*User
-	err := pr.db.SelectContext(ctx, &users, `SELECT * FROM users`)
+	err := pr.conn(ctx).SelectContext(ctx, &users, `SELECT * FROM users`)
 	if err != nil {
 		return nil, fmt.Errorf("error listing users: %w", err)
 	}
@@ -21,7 +21,7 @@ func (pr *PostgresRepository) ListUser(ctx context.Context) ([]*User, error) {
 func (pr *PostgresRepository) CreateUser(ctx context.Context, email, password, role string) (*User, error) {
 	var registrationDate = time.Now()
 	newID := uuid.New().String()
-	_, err := pr.db.ExecContext(
+	_, err := pr.conn(ctx).ExecContext(
 		ctx,
 		`INSERT INTO users (id, email, password, role, registration_date) VALUES ($1, $2, $3, $4, $5)`,
 		newID,
@@ -47,10 +47,20 @@ func (pr *PostgresRepository) CreateUser(ctx context.Context, email, password, r
 
 func (pr *PostgresRepository) GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	var user User
-	err := pr.db.GetContext(ctx, &user, `SELECT * FROM users WHERE email = $1`, email)
+	err := pr.conn(ctx).GetContext(ctx, &user, `SELECT * FROM users WHERE email = $1`, email)
 	if err != nil {
 		return nil, fmt.Errorf("error getting user by email: %w", err)
 	}
 
 	return &user, nil
 }
+
+func (pr *PostgresRepository) GetUserByID(ctx context.Context, id string) (*User, error) {
+	var user User
+	err := pr.conn(ctx).GetContext(ctx, &user, `SELECT * FROM users WHERE id = $1`, id)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user by id: %w", err)
+	}
+
+	return &user, nil
+}
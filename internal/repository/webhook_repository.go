@@ -0,0 +1,181 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const (
+	deliveredWebhookStatus = "delivered"
+	deadWebhookStatus      = "dead"
+)
+
+var ErrWebhookSubscriptionNotFound = errors.New("webhook subscription not found")
+
+func (pr *PostgresRepository) CreateWebhookSubscription(ctx context.Context, url, secret string, eventTypes []string) (*WebhookSubscription, error) {
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	subscription := &WebhookSubscription{
+		ID:         uuid.New().String(),
+		URL:        url,
+		Secret:     secret,
+		EventTypes: eventTypes,
+		CreatedAt:  time.Now(),
+	}
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`INSERT INTO webhook_subscriptions (id, url, secret, event_types, created_at) VALUES ($1, $2, $3, $4, $5)`,
+		subscription.ID,
+		subscription.URL,
+		subscription.Secret,
+		pq.Array(eventTypes),
+		subscription.CreatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error creating webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (pr *PostgresRepository) ListWebhookSubscriptions(ctx context.Context) ([]*WebhookSubscription, error) {
+	subscriptions := make([]*WebhookSubscription, 0)
+	err := pr.conn(ctx).SelectContext(
+		ctx,
+		&subscriptions,
+		`SELECT id, url, secret, event_types, created_at FROM webhook_subscriptions ORDER BY created_at`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing webhook subscriptions: %w", err)
+	}
+
+	return subscriptions, nil
+}
+
+func (pr *PostgresRepository) GetWebhookSubscription(ctx context.Context, id string) (*WebhookSubscription, error) {
+	subscription := &WebhookSubscription{}
+	err := pr.conn(ctx).GetContext(
+		ctx,
+		subscription,
+		`SELECT id, url, secret, event_types, created_at FROM webhook_subscriptions WHERE id = $1`,
+		id,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrWebhookSubscriptionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting webhook subscription: %w", err)
+	}
+
+	return subscription, nil
+}
+
+func (pr *PostgresRepository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	result, err := pr.conn(ctx).ExecContext(ctx, `DELETE FROM webhook_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("error deleting webhook subscription: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("error deleting webhook subscription: %w", err)
+	}
+	if affected == 0 {
+		return ErrWebhookSubscriptionNotFound
+	}
+
+	return nil
+}
+
+// ClaimWebhookDeliveries выбирает готовые к отправке доставки и откладывает их до leaseUntil,
+// чтобы параллельно работающие диспетчеры не отправили одно событие дважды
+func (pr *PostgresRepository) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*WebhookDelivery, error) {
+	deliveries := make([]*WebhookDelivery, 0)
+	err := pr.conn(ctx).SelectContext(
+		ctx,
+		&deliveries,
+		`WITH claimed AS (
+			UPDATE webhook_deliveries
+			SET next_attempt_at = $2
+			WHERE id IN (
+				SELECT id FROM webhook_deliveries
+				WHERE status = 'pending' AND next_attempt_at <= $1
+				ORDER BY next_attempt_at
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, event_id, subscription_id, attempts
+		)
+		SELECT c.id, c.event_id, c.subscription_id, c.attempts, e.event_type, e.payload, s.url, s.secret
+		FROM claimed c
+		JOIN outbox_events e ON e.id = c.event_id
+		JOIN webhook_subscriptions s ON s.id = c.subscription_id`,
+		now,
+		leaseUntil,
+		limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error claiming webhook deliveries: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+func (pr *PostgresRepository) MarkWebhookDelivered(ctx context.Context, id string, deliveredAt time.Time) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, delivered_at = $3, last_error = NULL
+		WHERE id = $1`,
+		id,
+		deliveredWebhookStatus,
+		deliveredAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error marking webhook delivered: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) RetryWebhookDelivery(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`UPDATE webhook_deliveries
+		SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+		WHERE id = $1`,
+		id,
+		lastError,
+		nextAttemptAt,
+	)
+	if err != nil {
+		return fmt.Errorf("error scheduling webhook retry: %w", err)
+	}
+
+	return nil
+}
+
+func (pr *PostgresRepository) DeadLetterWebhookDelivery(ctx context.Context, id string, lastError string) error {
+	_, err := pr.conn(ctx).ExecContext(
+		ctx,
+		`UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, last_error = $3
+		WHERE id = $1`,
+		id,
+		deadWebhookStatus,
+		lastError,
+	)
+	if err != nil {
+		return fmt.Errorf("error moving webhook to dead letter: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhookSubscription(t *testing.T) {
+	query := `INSERT INTO webhook_subscriptions (id, url, secret, event_types, created_at) VALUES ($1, $2, $3, $4, $5)`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					sqlmock.AnyArg(), "http://example.com/hook", "secret", sqlmock.AnyArg(), sqlmock.AnyArg(),
+				).WillReturnResult(
+					sqlmock.NewResult(1, 1),
+				)
+
+				subscription, err := r.CreateWebhookSubscription(context.Background(), "http://example.com/hook", "secret", []string{EventPVZCreated})
+				require.NoError(t, err)
+				require.Equal(t, "http://example.com/hook", subscription.URL)
+				require.Equal(t, []string{EventPVZCreated}, []string(subscription.EventTypes))
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error inserting",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnError(
+					fmt.Errorf("error inserting webhook subscription"),
+				)
+
+				_, err := r.CreateWebhookSubscription(context.Background(), "http://example.com/hook", "secret", nil)
+				require.Error(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestGetWebhookSubscription(t *testing.T) {
+	query := `SELECT id, url, secret, event_types, created_at FROM webhook_subscriptions WHERE id = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WithArgs(
+					"1",
+				).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "url", "secret", "event_types", "created_at"}).
+						AddRow("1", "https://example.com/hook", "secret", "{ReceptionClosed}", dummyDate),
+				)
+
+				subscription, err := r.GetWebhookSubscription(context.Background(), "1")
+				require.NoError(t, err)
+				require.Equal(t, &WebhookSubscription{
+					ID:         "1",
+					URL:        "https://example.com/hook",
+					Secret:     "secret",
+					EventTypes: []string{EventReceptionClosed},
+					CreatedAt:  dummyDate,
+				}, subscription)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error subscription not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(
+					query,
+				).WillReturnError(
+					sql.ErrNoRows,
+				)
+
+				_, err := r.GetWebhookSubscription(context.Background(), "1")
+				require.ErrorIs(t, err, ErrWebhookSubscriptionNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestDeleteWebhookSubscription(t *testing.T) {
+	query := `DELETE FROM webhook_subscriptions WHERE id = $1`
+
+	testCases := []struct {
+		name string
+		test func(*testing.T, Repository, sqlmock.Sqlmock)
+	}{
+		{
+			name: "Success",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WithArgs(
+					"1",
+				).WillReturnResult(
+					sqlmock.NewResult(0, 1),
+				)
+
+				err := r.DeleteWebhookSubscription(context.Background(), "1")
+				require.NoError(t, err)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "Error subscription not found",
+			test: func(t *testing.T, r Repository, mock sqlmock.Sqlmock) {
+				mock.ExpectExec(
+					query,
+				).WillReturnResult(
+					sqlmock.NewResult(0, 0),
+				)
+
+				err := r.DeleteWebhookSubscription(context.Background(), "1")
+				require.ErrorIs(t, err, ErrWebhookSubscriptionNotFound)
+
+				err = mock.ExpectationsWereMet()
+				require.NoError(t, err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+				tc.test(t, r, mock)
+			})
+		})
+	}
+}
+
+func TestFanOutOutboxEvents(t *testing.T) {
+	query := `WITH dispatched AS (
+			UPDATE outbox_events
+			SET dispatched_at = $1
+			WHERE id IN (
+				SELECT id FROM outbox_events
+				WHERE dispatched_at IS NULL
+				ORDER BY created_at
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, event_type
+		)
+		INSERT INTO webhook_deliveries (event_id, subscription_id, next_attempt_at)
+		SELECT e.id, s.id, $1
+		FROM dispatched e
+		JOIN webhook_subscriptions s
+			ON cardinality(s.event_types) = 0 OR e.event_type = ANY(s.event_types)
+		ON CONFLICT (event_id, subscription_id) DO NOTHING`
+
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			query,
+		).WithArgs(
+			dummyDate,
+		).WillReturnResult(
+			sqlmock.NewResult(0, 3),
+		)
+
+		created, err := r.FanOutOutboxEvents(context.Background(), dummyDate)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), created)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
+
+func TestClaimWebhookDeliveries(t *testing.T) {
+	query := `WITH claimed AS (
+			UPDATE webhook_deliveries
+			SET next_attempt_at = $2
+			WHERE id IN (
+				SELECT id FROM webhook_deliveries
+				WHERE status = 'pending' AND next_attempt_at <= $1
+				ORDER BY next_attempt_at
+				LIMIT $3
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, event_id, subscription_id, attempts
+		)
+		SELECT c.id, c.event_id, c.subscription_id, c.attempts, e.event_type, e.payload, s.url, s.secret
+		FROM claimed c
+		JOIN outbox_events e ON e.id = c.event_id
+		JOIN webhook_subscriptions s ON s.id = c.subscription_id`
+
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		leaseUntil := dummyDate.Add(time.Minute)
+		mock.ExpectQuery(
+			query,
+		).WithArgs(
+			dummyDate, leaseUntil, 10,
+		).WillReturnRows(
+			sqlmock.NewRows([]string{"id", "event_id", "subscription_id", "attempts", "event_type", "payload", "url", "secret"}).
+				AddRow("1", "2", "3", 1, EventPVZCreated, []byte(`{"id":"4"}`), "http://example.com/hook", "secret"),
+		)
+
+		deliveries, err := r.ClaimWebhookDeliveries(context.Background(), dummyDate, leaseUntil, 10)
+		require.NoError(t, err)
+		require.Equal(t, []*WebhookDelivery{
+			{
+				ID:             "1",
+				EventID:        "2",
+				SubscriptionID: "3",
+				Attempts:       1,
+				EventType:      EventPVZCreated,
+				Payload:        []byte(`{"id":"4"}`),
+				URL:            "http://example.com/hook",
+				Secret:         "secret",
+			},
+		}, deliveries)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
+
+func TestDeadLetterWebhookDelivery(t *testing.T) {
+	query := `UPDATE webhook_deliveries
+		SET status = $2, attempts = attempts + 1, last_error = $3
+		WHERE id = $1`
+
+	withMockRepository(t, func(r Repository, mock sqlmock.Sqlmock) {
+		mock.ExpectExec(
+			query,
+		).WithArgs(
+			"1", deadWebhookStatus, "status 500",
+		).WillReturnResult(
+			sqlmock.NewResult(0, 1),
+		)
+
+		err := r.DeadLetterWebhookDelivery(context.Background(), "1", "status 500")
+		require.NoError(t, err)
+
+		err = mock.ExpectationsWereMet()
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/DarRo9/pvz_service/internal/logger"
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	auditActionCreate     = "create"
+	auditActionUpdate     = "update"
+	auditActionDeactivate = "deactivate"
+	auditActionClose      = "close"
+	auditActionDelete     = "delete"
+	auditActionIssue      = "issue"
+	auditActionReturn     = "return"
+	auditActionAssign     = "assign_employee"
+	auditActionUnassign   = "unassign_employee"
+
+	auditActionUploadManifest = "upload_manifest"
+	auditActionAcknowledge    = "acknowledge_reconciliation"
+	auditActionReopen         = "reopen"
+	auditActionAmend          = "amend"
+)
+
+var productStatusAuditActions = map[ProductStatus]string{
+	ProductStatusIssued:   auditActionIssue,
+	ProductStatusReturned: auditActionReturn,
+}
+
+const (
+	auditEntityPVZ                 = "pvz"
+	auditEntityReception           = "reception"
+	auditEntityProduct             = "product"
+	auditEntityCity                = "city"
+	auditEntityProductType         = "product_type"
+	auditEntityWebhookSubscription = "webhook_subscription"
+	auditEntityUser                = "user"
+)
+
+func (s *Service) ListAuditRecords(ctx context.Context, filter repository.AuditFilter) ([]*repository.AuditRecord, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListAuditRecords")
+	defer span.End()
+
+	records, err := s.repo.ListAuditRecords(ctx, filter)
+	return records, err
+}
+
+// recordAudit записывает изменение сущности в журнал аудита. Вызывается внутри WithinTx
+// вместе с самим изменением: если запись в журнал не удалась, изменение откатывается
+func (s *Service) recordAudit(ctx context.Context, action, entityType, entityID string, before, after interface{}) error {
+	record := &repository.AuditRecord{
+		Action:     action,
+		EntityType: entityType,
+		EntityID:   entityID,
+	}
+	record.ActorID, record.ActorRole = auditActor(ctx)
+	if requestID := logger.RequestIDFromContext(ctx); requestID != "" {
+		record.RequestID = &requestID
+	}
+
+	var err error
+	if record.Before, err = marshalAuditPayload(before); err != nil {
+		return fmt.Errorf("error marshaling audit payload: %w", err)
+	}
+	if record.After, err = marshalAuditPayload(after); err != nil {
+		return fmt.Errorf("error marshaling audit payload: %w", err)
+	}
+
+	if err := s.repo.CreateAuditRecord(ctx, record); err != nil {
+		slog.ErrorContext(ctx, "Error writing audit record", "error", err)
+		return err
+	}
+	return nil
+}
+
+// auditActor возвращает пользователя из JWT токена запроса. Для запросов без токена,
+// например регистрации, автор изменения не заполняется
+func auditActor(ctx context.Context) (*string, *string) {
+	claims, ok := ctx.Value("user").(jwt.MapClaims)
+	if !ok {
+		return nil, nil
+	}
+
+	var actorID, actorRole *string
+	if userID, ok := claims["user_id"].(string); ok {
+		actorID = &userID
+	}
+	if role, ok := claims["role"].(string); ok {
+		actorRole = &role
+	}
+	return actorID, actorRole
+}
+
+func marshalAuditPayload(payload interface{}) ([]byte, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	return json.Marshal(payload)
+}
+
+// userAuditPayload не включает хэш пароля в журнал аудита
+func userAuditPayload(user *repository.User) map[string]interface{} {
+	return map[string]interface{}{
+		"id":               user.ID,
+		"email":            user.Email,
+		"role":             user.Role,
+		"registrationDate": user.RegistrationDate,
+	}
+}
+
+// webhookSubscriptionAuditPayload не включает секрет подписи в журнал аудита
+func webhookSubscriptionAuditPayload(subscription *repository.WebhookSubscription) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         subscription.ID,
+		"url":        subscription.URL,
+		"eventTypes": subscription.EventTypes,
+		"createdAt":  subscription.CreatedAt,
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+// maxCorrectionReasonLength - максимальная длина причины исправления приемки
+const maxCorrectionReasonLength = 1000
+
+var (
+	ErrInvalidCorrection    = errors.New("invalid reception correction")
+	ErrReceptionNotClosed   = errors.New("reception is not closed")
+	ErrNewerReceptionExists = errors.New("pvz has a newer reception")
+	ErrProductNotAmendable  = errors.New("product is not stored in the reception")
+)
+
+// ReopenReception возвращает закрытую приемку в работу, пока в ПВЗ не появилось новой приемки.
+// Сотрудники ПВЗ добавляют и удаляют в ней товары и закрывают ее как обычно
+func (s *Service) ReopenReception(ctx context.Context, receptionId string, reason string, userId string) (*repository.Reception, error) {
+	ctx, span := tracing.Start(ctx, "Service.ReopenReception")
+	defer span.End()
+
+	reason, err := validateCorrectionReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	var rc *repository.Reception
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		rc, err = s.repo.ReopenReception(ctx, receptionId, reason, userId)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionReopen, auditEntityReception, rc.ID, nil, rc)
+	})
+	if err != nil {
+		return nil, correctionError(err, receptionId)
+	}
+
+	return rc, nil
+}
+
+// AmendReception исправляет состав закрытой приемки без открытия: удаляет товары, которые еще
+// хранятся в ПВЗ, и добавляет недостающие сразу на хранение
+func (s *Service) AmendReception(ctx context.Context, receptionId string, amendment repository.ReceptionAmendment, userId string) (*repository.ReceptionCorrection, error) {
+	ctx, span := tracing.Start(ctx, "Service.AmendReception")
+	defer span.End()
+
+	reason, err := validateCorrectionReason(amendment.Reason)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateAmendment(ctx, amendment); err != nil {
+		return nil, err
+	}
+	amendment.Reason = reason
+	amendment.AmendedBy = userId
+
+	var correction *repository.ReceptionCorrection
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		correction, err = s.repo.AmendReception(ctx, receptionId, amendment, reconcileOnAmend)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionAmend, auditEntityReception, receptionId, nil, correction)
+	})
+	if err != nil {
+		return nil, correctionError(err, receptionId)
+	}
+
+	return correction, nil
+}
+
+// ListReceptionCorrections возвращает историю открытий и исправлений приемки, старые первыми
+func (s *Service) ListReceptionCorrections(ctx context.Context, receptionId string) ([]*repository.ReceptionCorrection, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListReceptionCorrections")
+	defer span.End()
+
+	if _, err := s.repo.GetReception(ctx, receptionId); err != nil {
+		return nil, receptionError(err, receptionId)
+	}
+
+	return s.repo.ListReceptionCorrections(ctx, receptionId)
+}
+
+func validateCorrectionReason(reason string) (string, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return "", fmt.Errorf("%w: reason is required", ErrInvalidCorrection)
+	}
+	if len([]rune(reason)) > maxCorrectionReasonLength {
+		return "", fmt.Errorf("%w: reason exceeds %d characters", ErrInvalidCorrection, maxCorrectionReasonLength)
+	}
+	return reason, nil
+}
+
+func (s *Service) validateAmendment(ctx context.Context, amendment repository.ReceptionAmendment) error {
+	if len(amendment.AddProducts) == 0 && len(amendment.RemoveProductIDs) == 0 {
+		return fmt.Errorf("%w: nothing to change", ErrInvalidCorrection)
+	}
+	if len(amendment.AddProducts)+len(amendment.RemoveProductIDs) > MaxProductBatchSize {
+		return fmt.Errorf("%w: more than %d products", ErrInvalidCorrection, MaxProductBatchSize)
+	}
+
+	removed := make(map[string]bool, len(amendment.RemoveProductIDs))
+	for _, id := range amendment.RemoveProductIDs {
+		if removed[id] {
+			return fmt.Errorf("%w: product %s is removed twice", ErrInvalidCorrection, id)
+		}
+		removed[id] = true
+	}
+	for _, newProduct := range amendment.AddProducts {
+		if err := s.validateNewProduct(ctx, newProduct); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reconcileOnAmend пересчитывает сверку исправленной приемки. Модератор исправляет приемку
+// осознанно, поэтому расхождения с манифестом исправление не блокируют
+func reconcileOnAmend(manifest *repository.ReceptionManifest, products []*repository.Product, previous *repository.Reconciliation) (*repository.Reconciliation, error) {
+	return buildReconciliation(manifest, products, previous), nil
+}
+
+func correctionError(err error, receptionId string) error {
+	switch {
+	case errors.Is(err, repository.ErrReceptionNotClosed):
+		return fmt.Errorf("%w: %s", ErrReceptionNotClosed, receptionId)
+	case errors.Is(err, repository.ErrNewerReceptionExists):
+		return fmt.Errorf("%w: %s", ErrNewerReceptionExists, receptionId)
+	case errors.Is(err, repository.ErrProductNotAmendable):
+		return ErrProductNotAmendable
+	case errors.Is(err, repository.ErrDuplicateBarcode):
+		return ErrDuplicateBarcode
+	case errors.Is(err, repository.ErrPVZInactive):
+		return ErrPVZInactive
+	default:
+		return receptionError(err, receptionId)
+	}
+}
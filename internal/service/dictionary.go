@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+var (
+	ErrInvalidDictionaryEntry  = errors.New("invalid dictionary entry")
+	ErrDictionaryEntryExists   = errors.New("dictionary entry already exists")
+	ErrDictionaryEntryNotFound = errors.New("dictionary entry not found")
+)
+
+// dictionaryCache хранит значения справочника в памяти. Кэш сбрасывается при изменении
+// справочника через сервис и по истечении ttl, чтобы подхватывать изменения других экземпляров.
+type dictionaryCache struct {
+	mu       sync.RWMutex
+	load     func(ctx context.Context) ([]*repository.DictionaryEntry, error)
+	ttl      time.Duration
+	values   map[string]struct{}
+	loadedAt time.Time
+}
+
+func newDictionaryCache(load func(ctx context.Context) ([]*repository.DictionaryEntry, error), ttl time.Duration) *dictionaryCache {
+	return &dictionaryCache{
+		load: load,
+		ttl:  ttl,
+	}
+}
+
+func (c *dictionaryCache) contains(ctx context.Context, value string) (bool, error) {
+	c.mu.RLock()
+	if c.isFresh() {
+		_, ok := c.values[value]
+		c.mu.RUnlock()
+		return ok, nil
+	}
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.isFresh() {
+		entries, err := c.load(ctx)
+		if err != nil {
+			return false, err
+		}
+		c.values = make(map[string]struct{}, len(entries))
+		for _, entry := range entries {
+			c.values[entry.Name] = struct{}{}
+		}
+		c.loadedAt = time.Now()
+	}
+
+	_, ok := c.values[value]
+	return ok, nil
+}
+
+func (c *dictionaryCache) isFresh() bool {
+	return c.values != nil && (c.ttl <= 0 || time.Since(c.loadedAt) < c.ttl)
+}
+
+func (c *dictionaryCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values = nil
+}
+
+func (s *Service) IsValidCity(ctx context.Context, city string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "Service.IsValidCity")
+	defer span.End()
+
+	return s.cities.contains(ctx, city)
+}
+
+func (s *Service) IsValidProductType(ctx context.Context, productType string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "Service.IsValidProductType")
+	defer span.End()
+
+	return s.productTypes.contains(ctx, productType)
+}
+
+func (s *Service) ListCities(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListCities")
+	defer span.End()
+
+	cities, err := s.repo.ListCities(ctx)
+	return cities, err
+}
+
+func (s *Service) CreateCity(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	ctx, span := tracing.Start(ctx, "Service.CreateCity")
+	defer span.End()
+
+	return s.createDictionaryEntry(ctx, s.cities, s.repo.CreateCity, auditEntityCity, name)
+}
+
+func (s *Service) DeleteCity(ctx context.Context, name string) error {
+	ctx, span := tracing.Start(ctx, "Service.DeleteCity")
+	defer span.End()
+
+	return s.deleteDictionaryEntry(ctx, s.cities, s.repo.GetCity, s.repo.DeleteCity, auditEntityCity, name)
+}
+
+func (s *Service) ListProductTypes(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListProductTypes")
+	defer span.End()
+
+	productTypes, err := s.repo.ListProductTypes(ctx)
+	return productTypes, err
+}
+
+func (s *Service) CreateProductType(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	ctx, span := tracing.Start(ctx, "Service.CreateProductType")
+	defer span.End()
+
+	return s.createDictionaryEntry(ctx, s.productTypes, s.repo.CreateProductType, auditEntityProductType, name)
+}
+
+func (s *Service) DeleteProductType(ctx context.Context, name string) error {
+	ctx, span := tracing.Start(ctx, "Service.DeleteProductType")
+	defer span.End()
+
+	return s.deleteDictionaryEntry(ctx, s.productTypes, s.repo.GetProductType, s.repo.DeleteProductType, auditEntityProductType, name)
+}
+
+// SeedDictionaries заполняет пустые справочники значениями из конфигурации
+func (s *Service) SeedDictionaries(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "Service.SeedDictionaries")
+	defer span.End()
+
+	if err := s.repo.SeedCities(ctx, s.config.Cities); err != nil {
+		return err
+	}
+	if err := s.repo.SeedProductTypes(ctx, s.config.ProductTypes); err != nil {
+		return err
+	}
+
+	s.cities.invalidate()
+	s.productTypes.invalidate()
+	return nil
+}
+
+// createDictionaryEntry добавляет значение справочника вместе с записью аудита
+// и сбрасывает кэш после фиксации транзакции
+func (s *Service) createDictionaryEntry(
+	ctx context.Context,
+	cache *dictionaryCache,
+	create func(ctx context.Context, name string) (*repository.DictionaryEntry, error),
+	entityType string,
+	name string,
+) (*repository.DictionaryEntry, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidDictionaryEntry)
+	}
+
+	var entry *repository.DictionaryEntry
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		entry, err = create(ctx, name)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionCreate, entityType, entry.Name, nil, entry)
+	})
+	if errors.Is(err, repository.ErrDictionaryEntryExists) {
+		return nil, fmt.Errorf("%w: %s", ErrDictionaryEntryExists, name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cache.invalidate()
+	return entry, nil
+}
+
+// deleteDictionaryEntry удаляет значение справочника, сохраняя его в записи аудита,
+// и сбрасывает кэш после фиксации транзакции
+func (s *Service) deleteDictionaryEntry(
+	ctx context.Context,
+	cache *dictionaryCache,
+	get func(ctx context.Context, name string) (*repository.DictionaryEntry, error),
+	remove func(ctx context.Context, name string) error,
+	entityType string,
+	name string,
+) error {
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		before, err := get(ctx, name)
+		if err != nil {
+			return err
+		}
+		if err := remove(ctx, name); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionDelete, entityType, name, before, nil)
+	})
+	if errors.Is(err, repository.ErrDictionaryEntryNotFound) {
+		return fmt.Errorf("%w: %s", ErrDictionaryEntryNotFound, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	cache.invalidate()
+	return nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEmployeeNotAssigned = errors.New("employee is not assigned to pvz")
+	ErrUserNotFound        = errors.New("user not found")
+	ErrUserNotEmployee     = errors.New("user is not an employee")
+	ErrAssignmentExists    = errors.New("employee is already assigned to pvz")
+	ErrAssignmentNotFound  = errors.New("assignment not found")
+)
+
+func (s *Service) ListPVZEmployees(ctx context.Context, pvzId string) ([]*repository.PVZEmployee, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListPVZEmployees")
+	defer span.End()
+
+	employees, err := s.repo.ListPVZEmployees(ctx, pvzId)
+	return employees, err
+}
+
+func (s *Service) AssignEmployee(ctx context.Context, pvzId string, userId string) (*repository.PVZEmployee, error) {
+	ctx, span := tracing.Start(ctx, "Service.AssignEmployee")
+	defer span.End()
+
+	var employee *repository.PVZEmployee
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		employee, err = s.repo.AssignEmployee(ctx, pvzId, userId)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionAssign, auditEntityPVZ, pvzId, nil, employee)
+	})
+	switch {
+	case errors.Is(err, repository.ErrUserNotFound):
+		return nil, ErrUserNotFound
+	case errors.Is(err, repository.ErrUserNotEmployee):
+		return nil, fmt.Errorf("%w: %s", ErrUserNotEmployee, userId)
+	case errors.Is(err, repository.ErrAssignmentExists):
+		return nil, ErrAssignmentExists
+	case err != nil:
+		return nil, pvzError(err, pvzId)
+	}
+
+	return employee, nil
+}
+
+func (s *Service) UnassignEmployee(ctx context.Context, pvzId string, userId string) error {
+	ctx, span := tracing.Start(ctx, "Service.UnassignEmployee")
+	defer span.End()
+
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.UnassignEmployee(ctx, pvzId, userId); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionUnassign, auditEntityPVZ, pvzId, map[string]string{"pvzId": pvzId, "userId": userId}, nil)
+	})
+	if errors.Is(err, repository.ErrAssignmentNotFound) {
+		return ErrAssignmentNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkAssignment проверяет, что сотрудник закреплен за ПВЗ, в котором выполняет операцию
+func (s *Service) checkAssignment(ctx context.Context, pvzId string, userId string) error {
+	// Токены dummyLogin не связаны с пользователем: тестовый сотрудник закреплен только
+	// за ПВЗ, переданным при получении токена
+	if _, err := uuid.Parse(userId); err != nil {
+		if dummyPVZ(ctx) != pvzId {
+			return fmt.Errorf("%w: %s", ErrEmployeeNotAssigned, pvzId)
+		}
+		return nil
+	}
+	assigned, err := s.repo.IsEmployeeAssigned(ctx, pvzId, userId)
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return fmt.Errorf("%w: %s", ErrEmployeeNotAssigned, pvzId)
+	}
+	return nil
+}
+
+// dummyPVZ возвращает ПВЗ из тестового токена dummyLogin
+func dummyPVZ(ctx context.Context) string {
+	claims, ok := ctx.Value("user").(jwt.MapClaims)
+	if !ok {
+		return ""
+	}
+	pvzID, _ := claims["pvz_id"].(string)
+	return pvzID
+}
@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+// ExportReceptions передает строки выгрузки приемок в fn по мере чтения из базы.
+// Ошибка fn, например обрыв соединения с клиентом, прерывает выгрузку
+func (s *Service) ExportReceptions(ctx context.Context, startDate, endDate *time.Time, fn func(*repository.ReceptionExportRow) error) error {
+	ctx, span := tracing.Start(ctx, "Service.ExportReceptions")
+	defer span.End()
+
+	return s.repo.ExportReceptions(ctx, startDate, endDate, fn)
+}
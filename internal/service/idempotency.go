@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+var (
+	ErrIdempotencyKeyMismatch   = errors.New("idempotency key is already used with a different request")
+	ErrIdempotencyKeyInProgress = errors.New("request with this idempotency key is in progress")
+)
+
+// BeginIdempotentRequest закрепляет ключ за пользователем. Если по ключу уже сохранен ответ,
+// он возвращается для повтора. nil означает, что ключ новый и запрос нужно выполнить
+func (s *Service) BeginIdempotentRequest(ctx context.Context, userID, key, requestHash string) (*repository.IdempotencyRecord, error) {
+	ctx, span := tracing.Start(ctx, "Service.BeginIdempotentRequest")
+	defer span.End()
+
+	now := time.Now()
+	reserved, err := s.repo.ReserveIdempotencyKey(ctx, userID, key, requestHash, now, now.Add(s.config.Idempotency.TTL))
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	record, err := s.repo.GetIdempotencyKey(ctx, userID, key)
+	if err != nil {
+		// Ключ освободили между вставкой и чтением, клиент может повторить запрос
+		if errors.Is(err, repository.ErrIdempotencyKeyNotFound) {
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		return nil, err
+	}
+	if record.RequestHash != requestHash {
+		return nil, ErrIdempotencyKeyMismatch
+	}
+	if record.StatusCode == nil {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+
+	return record, nil
+}
+
+func (s *Service) CompleteIdempotentRequest(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error {
+	ctx, span := tracing.Start(ctx, "Service.CompleteIdempotentRequest")
+	defer span.End()
+
+	return s.repo.SaveIdempotencyResponse(ctx, userID, key, statusCode, contentType, body)
+}
+
+// ReleaseIdempotentRequest освобождает ключ, если запрос не удалось выполнить, чтобы его можно было повторить
+func (s *Service) ReleaseIdempotentRequest(ctx context.Context, userID, key string) error {
+	ctx, span := tracing.Start(ctx, "Service.ReleaseIdempotentRequest")
+	defer span.End()
+
+	return s.repo.DeleteIdempotencyKey(ctx, userID, key)
+}
+
+// RunIdempotencyCleanup периодически удаляет истекшие ключи идемпотентности
+func (s *Service) RunIdempotencyCleanup(ctx context.Context) {
+	interval := s.config.Idempotency.CleanupInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.repo.DeleteExpiredIdempotencyKeys(ctx, time.Now())
+			if err != nil {
+				slog.ErrorContext(ctx, "Error deleting expired idempotency keys", "error", err)
+				continue
+			}
+			slog.DebugContext(ctx, "Expired idempotency keys deleted", "count", deleted)
+		}
+	}
+}
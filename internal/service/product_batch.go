@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+// MaxProductBatchSize - максимальное количество товаров в одном пакетном добавлении
+const MaxProductBatchSize = 1000
+
+var (
+	ErrEmptyProductBatch    = errors.New("product batch is empty")
+	ErrProductBatchTooLarge = fmt.Errorf("product batch exceeds %d items", MaxProductBatchSize)
+)
+
+// ProductBatchResult - результат добавления одного товара из пакета.
+// Заполнено либо Product, либо Err
+type ProductBatchResult struct {
+	Product *repository.Product
+	Err     error
+}
+
+// CreateProducts добавляет товары разных типов в открытую приемку ПВЗ одной транзакцией.
+// Товары с неизвестным типом, неверными характеристиками или уже отсканированным штрихкодом
+// не добавляются и возвращаются с ошибкой, остальные добавляются вместе
+func (s *Service) CreateProducts(ctx context.Context, pvzId string, newProducts []repository.NewProduct, userId string) ([]*ProductBatchResult, error) {
+	ctx, span := tracing.Start(ctx, "Service.CreateProducts")
+	defer span.End()
+
+	if len(newProducts) == 0 {
+		return nil, ErrEmptyProductBatch
+	}
+	if len(newProducts) > MaxProductBatchSize {
+		return nil, ErrProductBatchTooLarge
+	}
+
+	if err := s.checkAssignment(ctx, pvzId, userId); err != nil {
+		return nil, err
+	}
+
+	results := make([]*ProductBatchResult, len(newProducts))
+	validIndexes := make([]int, 0, len(newProducts))
+	validProducts := make([]repository.NewProduct, 0, len(newProducts))
+	for i, newProduct := range newProducts {
+		if err := s.validateNewProduct(ctx, newProduct); err != nil {
+			if !errors.Is(err, ErrInvalidProductType) && !errors.Is(err, ErrInvalidProductDetails) {
+				return nil, err
+			}
+			results[i] = &ProductBatchResult{Err: err}
+			continue
+		}
+		validIndexes = append(validIndexes, i)
+		validProducts = append(validProducts, newProduct)
+	}
+
+	if len(validProducts) == 0 {
+		return results, nil
+	}
+
+	var products []*repository.Product
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		products, err = s.repo.CreateProducts(ctx, pvzId, validProducts)
+		if err != nil {
+			return err
+		}
+		for _, product := range products {
+			if product == nil {
+				continue
+			}
+			if err := s.recordAudit(ctx, auditActionCreate, auditEntityProduct, product.ID, nil, product); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for j, product := range products {
+		i := validIndexes[j]
+		if product == nil {
+			results[i] = &ProductBatchResult{Err: fmt.Errorf("%w: %s", ErrDuplicateBarcode, *newProducts[i].Barcode)}
+			continue
+		}
+		results[i] = &ProductBatchResult{Product: product}
+	}
+	return results, nil
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+	"github.com/google/uuid"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// pvzCursor - содержимое курсора списка ПВЗ. Клиенту курсор передается непрозрачной строкой
+type pvzCursor struct {
+	RegistrationDate time.Time `json:"d"`
+	ID               string    `json:"id"`
+}
+
+// ListPVZByCursor возвращает страницу ПВЗ после cursor и курсор следующей страницы.
+// Пустой cursor - первая страница, пустой nextCursor - следующей страницы нет.
+// В отличие от OFFSET, выборка не пропускает и не повторяет ПВЗ, созданные во время листания
+func (s *Service) ListPVZByCursor(ctx context.Context, filter repository.PVZFilter, cursor string, limit int) ([]*repository.PVZWithReceptions, string, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListPVZByCursor")
+	defer span.End()
+
+	if err := validatePVZFilter(filter); err != nil {
+		return nil, "", err
+	}
+	after, err := decodePVZCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Лишний ПВЗ показывает, есть ли следующая страница
+	pvzs, err := s.repo.ListPVZAfter(ctx, filter, after, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(pvzs) <= limit {
+		return pvzs, "", nil
+	}
+
+	pvzs = pvzs[:limit]
+	last := pvzs[limit-1].PVZ
+	return pvzs, encodePVZCursor(&repository.PVZCursor{RegistrationDate: last.RegistrationDate, ID: last.ID}), nil
+}
+
+func encodePVZCursor(cursor *repository.PVZCursor) string {
+	data, _ := json.Marshal(pvzCursor{RegistrationDate: cursor.RegistrationDate, ID: cursor.ID})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePVZCursor(cursor string) (*repository.PVZCursor, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var decoded pvzCursor
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.RegistrationDate.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	// id сравнивается с колонкой uuid, поэтому подделанный курсор отклоняется до запроса в БД
+	if _, err := uuid.Parse(decoded.ID); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &repository.PVZCursor{RegistrationDate: decoded.RegistrationDate, ID: decoded.ID}, nil
+}
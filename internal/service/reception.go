@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+var ErrInvalidReceptionFilter = errors.New("invalid reception filter")
+
+// ListReceptions возвращает историю приемок ПВЗ, начиная с самых новых
+func (s *Service) ListReceptions(ctx context.Context, pvzId string, filter repository.ReceptionFilter) ([]*repository.Reception, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListReceptions")
+	defer span.End()
+
+	if err := validateReceptionFilter(filter); err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListReception(ctx, pvzId, filter)
+}
+
+func validateReceptionFilter(filter repository.ReceptionFilter) error {
+	if filter.From != nil && filter.To != nil && filter.From.After(*filter.To) {
+		return fmt.Errorf("%w: startDate must not be after endDate", ErrInvalidReceptionFilter)
+	}
+	if filter.Status != nil {
+		switch ReceptionStatus(*filter.Status) {
+		case InProgress, Close, Reopened, Amended:
+		default:
+			return fmt.Errorf("%w: unknown reception status %s", ErrInvalidReceptionFilter, *filter.Status)
+		}
+	}
+	return nil
+}
+
+// GetReception возвращает приемку вместе с принятыми в ней товарами
+func (s *Service) GetReception(ctx context.Context, receptionId string) (*repository.ReceptionWithProducts, error) {
+	ctx, span := tracing.Start(ctx, "Service.GetReception")
+	defer span.End()
+
+	rc, err := s.repo.GetReception(ctx, receptionId)
+	if err != nil {
+		return nil, receptionError(err, receptionId)
+	}
+	products, err := s.repo.ListProducts(ctx, receptionId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.ReceptionWithProducts{Reception: rc, Products: products}, nil
+}
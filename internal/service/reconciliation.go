@@ -0,0 +1,321 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+// maxManifestItems - максимальное количество строк в манифесте приемки
+const maxManifestItems = 1000
+
+var (
+	ErrReceptionNotFound           = errors.New("reception not found")
+	ErrReceptionClosed             = errors.New("reception is closed")
+	ErrManifestNotFound            = errors.New("reception manifest not found")
+	ErrReconciliationNotFound      = errors.New("reconciliation not found")
+	ErrInvalidManifest             = errors.New("invalid reception manifest")
+	ErrUnacknowledgedDiscrepancies = errors.New("reception has unacknowledged discrepancies with manifest")
+)
+
+// SetReceptionManifest загружает ожидаемый состав открытой приемки, заменяя ранее загруженный
+func (s *Service) SetReceptionManifest(ctx context.Context, receptionId string, items []*repository.ManifestItem, blockOnDiscrepancies bool, userId string) (*repository.ReceptionManifest, error) {
+	ctx, span := tracing.Start(ctx, "Service.SetReceptionManifest")
+	defer span.End()
+
+	rc, err := s.openReception(ctx, receptionId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.validateManifest(ctx, items); err != nil {
+		return nil, err
+	}
+
+	manifest := &repository.ReceptionManifest{
+		ReceptionID:          rc.ID,
+		BlockOnDiscrepancies: blockOnDiscrepancies,
+		UploadedBy:           &userId,
+		Items:                items,
+	}
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveReceptionManifest(ctx, manifest); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionUploadManifest, auditEntityReception, rc.ID, nil, manifest)
+	})
+	if err != nil {
+		return nil, receptionError(err, receptionId)
+	}
+
+	return manifest, nil
+}
+
+func (s *Service) GetReceptionManifest(ctx context.Context, receptionId string) (*repository.ReceptionManifest, error) {
+	ctx, span := tracing.Start(ctx, "Service.GetReceptionManifest")
+	defer span.End()
+
+	manifest, err := s.repo.GetReceptionManifest(ctx, receptionId)
+	if err != nil {
+		return nil, receptionError(err, receptionId)
+	}
+	return manifest, nil
+}
+
+// GetReconciliation возвращает сверку закрытой приемки. Для открытой приемки сверка
+// считается по текущим товарам и не сохраняется
+func (s *Service) GetReconciliation(ctx context.Context, receptionId string) (*repository.Reconciliation, error) {
+	ctx, span := tracing.Start(ctx, "Service.GetReconciliation")
+	defer span.End()
+
+	rc, err := s.repo.GetReception(ctx, receptionId)
+	if err != nil {
+		return nil, receptionError(err, receptionId)
+	}
+
+	previous, err := s.repo.GetReconciliation(ctx, rc.ID)
+	if err != nil && !errors.Is(err, repository.ErrReconciliationNotFound) {
+		return nil, err
+	}
+	if isClosedReception(rc) {
+		if previous == nil {
+			return nil, ErrReconciliationNotFound
+		}
+		return previous, nil
+	}
+
+	return s.currentReconciliation(ctx, rc.ID, previous)
+}
+
+// AcknowledgeReconciliation подтверждает текущие расхождения открытой приемки с манифестом.
+// Подтверждение действует, пока состав приемки и манифест не изменятся
+func (s *Service) AcknowledgeReconciliation(ctx context.Context, receptionId string, userId string) (*repository.Reconciliation, error) {
+	ctx, span := tracing.Start(ctx, "Service.AcknowledgeReconciliation")
+	defer span.End()
+
+	rc, err := s.openReception(ctx, receptionId, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	reconciliation, err := s.currentReconciliation(ctx, rc.ID, nil)
+	if err != nil {
+		return nil, err
+	}
+	acknowledgedAt := time.Now()
+	reconciliation.AcknowledgedBy = &userId
+	reconciliation.AcknowledgedAt = &acknowledgedAt
+
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		if err := s.repo.SaveReconciliation(ctx, reconciliation); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionAcknowledge, auditEntityReception, rc.ID, nil, reconciliation)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reconciliation, nil
+}
+
+// reconcileOnClose сверяет закрываемую приемку с манифестом. Если манифест запрещает закрытие
+// с расхождениями, а они не подтверждены, закрытие отменяется
+func reconcileOnClose(manifest *repository.ReceptionManifest, products []*repository.Product, previous *repository.Reconciliation) (*repository.Reconciliation, error) {
+	reconciliation := buildReconciliation(manifest, products, previous)
+	if manifest.BlockOnDiscrepancies && reconciliation.HasDiscrepancies && reconciliation.AcknowledgedBy == nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnacknowledgedDiscrepancies, manifest.ReceptionID)
+	}
+	return reconciliation, nil
+}
+
+// openReception проверяет, что приемка существует, открыта и сотрудник закреплен за ее ПВЗ
+func (s *Service) openReception(ctx context.Context, receptionId string, userId string) (*repository.Reception, error) {
+	rc, err := s.repo.GetReception(ctx, receptionId)
+	if err != nil {
+		return nil, receptionError(err, receptionId)
+	}
+	if err := s.checkAssignment(ctx, rc.PVZID, userId); err != nil {
+		return nil, err
+	}
+	if isClosedReception(rc) {
+		return nil, fmt.Errorf("%w: %s", ErrReceptionClosed, receptionId)
+	}
+	return rc, nil
+}
+
+func (s *Service) currentReconciliation(ctx context.Context, receptionId string, previous *repository.Reconciliation) (*repository.Reconciliation, error) {
+	manifest, err := s.repo.GetReceptionManifest(ctx, receptionId)
+	if err != nil {
+		return nil, receptionError(err, receptionId)
+	}
+	products, err := s.repo.ListProducts(ctx, receptionId)
+	if err != nil {
+		return nil, err
+	}
+	return buildReconciliation(manifest, products, previous), nil
+}
+
+func (s *Service) validateManifest(ctx context.Context, items []*repository.ManifestItem) error {
+	if len(items) == 0 || len(items) > maxManifestItems {
+		return fmt.Errorf("%w: manifest must have from 1 to %d items", ErrInvalidManifest, maxManifestItems)
+	}
+
+	barcodes := make(map[string]bool, len(items))
+	for i, item := range items {
+		if item.Quantity <= 0 {
+			return fmt.Errorf("%w: item %d: quantity must be positive", ErrInvalidManifest, i)
+		}
+		if item.Barcode == nil && item.Type == nil {
+			return fmt.Errorf("%w: item %d: barcode or type is required", ErrInvalidManifest, i)
+		}
+		if item.Type != nil {
+			valid, err := s.IsValidProductType(ctx, *item.Type)
+			if err != nil {
+				return err
+			}
+			if !valid {
+				return fmt.Errorf("%w: item %d: %w: %s", ErrInvalidManifest, i, ErrInvalidProductType, *item.Type)
+			}
+		}
+		if item.Barcode == nil {
+			continue
+		}
+		if *item.Barcode == "" || len(*item.Barcode) > maxBarcodeLength {
+			return fmt.Errorf("%w: item %d: barcode must be from 1 to %d characters", ErrInvalidManifest, i, maxBarcodeLength)
+		}
+		if item.Quantity != 1 {
+			return fmt.Errorf("%w: item %d: quantity of barcode item must be 1", ErrInvalidManifest, i)
+		}
+		if barcodes[*item.Barcode] {
+			return fmt.Errorf("%w: item %d: duplicate barcode %s", ErrInvalidManifest, i, *item.Barcode)
+		}
+		barcodes[*item.Barcode] = true
+	}
+	return nil
+}
+
+// buildReconciliation сверяет товары с манифестом. Подтверждение предыдущей сверки
+// переносится, только если отчет с тех пор не изменился
+func buildReconciliation(manifest *repository.ReceptionManifest, products []*repository.Product, previous *repository.Reconciliation) *repository.Reconciliation {
+	report := reconcileProducts(manifest.Items, products)
+	reconciliation := &repository.Reconciliation{
+		ReceptionID:      manifest.ReceptionID,
+		Report:           report,
+		HasDiscrepancies: report.HasDiscrepancies(),
+		CreatedAt:        time.Now(),
+	}
+	if previous != nil && previous.AcknowledgedBy != nil && sameReport(previous.Report, report) {
+		reconciliation.AcknowledgedBy = previous.AcknowledgedBy
+		reconciliation.AcknowledgedAt = previous.AcknowledgedAt
+	}
+	return reconciliation
+}
+
+// reconcileProducts сопоставляет строки манифеста с товарами приемки.
+// Строки со штрихкодом ищут товар с тем же штрихкодом. Строки с типом суммируются по типу
+// и забирают оставшиеся товары этого типа в порядке приемки. Не забранные товары попадают
+// в лишние: со штрихкодом - поштучно, без штрихкода - суммарно по типу
+func reconcileProducts(items []*repository.ManifestItem, products []*repository.Product) repository.ReconciliationReport {
+	report := repository.ReconciliationReport{
+		Matched: make([]*repository.ManifestItem, 0),
+		Missing: make([]*repository.ManifestItem, 0),
+		Extra:   make([]*repository.ManifestItem, 0),
+	}
+
+	products = slices.Clone(products)
+	slices.SortStableFunc(products, func(a, b *repository.Product) int {
+		return a.ReceptionDate.Compare(b.ReceptionDate)
+	})
+
+	consumed := make([]bool, len(products))
+	byBarcode := make(map[string]int)
+	for i, product := range products {
+		if product.Barcode == nil {
+			continue
+		}
+		if _, ok := byBarcode[*product.Barcode]; !ok {
+			byBarcode[*product.Barcode] = i
+		}
+	}
+
+	expectedByType := make(map[string]int)
+	for _, item := range items {
+		if item.Barcode == nil {
+			expectedByType[*item.Type] += item.Quantity
+			continue
+		}
+		i, ok := byBarcode[*item.Barcode]
+		if !ok || consumed[i] {
+			report.Missing = append(report.Missing, &repository.ManifestItem{Barcode: item.Barcode, Type: item.Type, Quantity: 1})
+			continue
+		}
+		consumed[i] = true
+		report.Matched = append(report.Matched, &repository.ManifestItem{Barcode: item.Barcode, Type: &products[i].Type, Quantity: 1})
+	}
+
+	for _, productType := range slices.Sorted(maps.Keys(expectedByType)) {
+		expected, matched := expectedByType[productType], 0
+		for i, product := range products {
+			if matched == expected {
+				break
+			}
+			if !consumed[i] && product.Type == productType {
+				consumed[i] = true
+				matched++
+			}
+		}
+		if matched > 0 {
+			report.Matched = append(report.Matched, typeLine(productType, matched))
+		}
+		if expected > matched {
+			report.Missing = append(report.Missing, typeLine(productType, expected-matched))
+		}
+	}
+
+	extraByType := make(map[string]int)
+	for i, product := range products {
+		if consumed[i] {
+			continue
+		}
+		if product.Barcode != nil {
+			report.Extra = append(report.Extra, &repository.ManifestItem{Barcode: product.Barcode, Type: &product.Type, Quantity: 1})
+			continue
+		}
+		extraByType[product.Type]++
+	}
+	for _, productType := range slices.Sorted(maps.Keys(extraByType)) {
+		report.Extra = append(report.Extra, typeLine(productType, extraByType[productType]))
+	}
+
+	return report
+}
+
+func typeLine(productType string, quantity int) *repository.ManifestItem {
+	return &repository.ManifestItem{Type: &productType, Quantity: quantity}
+}
+
+func sameReport(a, b repository.ReconciliationReport) bool {
+	aJSON, errA := json.Marshal(a)
+	bJSON, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(aJSON) == string(bJSON)
+}
+
+func receptionError(err error, receptionId string) error {
+	switch {
+	case errors.Is(err, repository.ErrReceptionNotFound):
+		return ErrReceptionNotFound
+	case errors.Is(err, repository.ErrReceptionClosed):
+		return fmt.Errorf("%w: %s", ErrReceptionClosed, receptionId)
+	case errors.Is(err, repository.ErrManifestNotFound):
+		return ErrManifestNotFound
+	default:
+		return err
+	}
+}
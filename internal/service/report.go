@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+)
+
+type ReportPeriod string
+
+const (
+	ReportPeriodDay   ReportPeriod = "day"
+	ReportPeriodWeek  ReportPeriod = "week"
+	ReportPeriodMonth ReportPeriod = "month"
+)
+
+// maxReportRange - максимальная длина периода отчета
+const maxReportRange = 366 * 24 * time.Hour
+
+var ErrInvalidReportFilter = errors.New("invalid report filter")
+
+var (
+	receptionReportDimensions = []string{repository.ReportDimensionPVZ, repository.ReportDimensionCity}
+	productReportDimensions   = []string{repository.ReportDimensionPVZ, repository.ReportDimensionCity, repository.ReportDimensionType}
+)
+
+// ReceptionsReport возвращает количество приемок и принятых в них товаров по периодам
+func (s *Service) ReceptionsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ReceptionReportRow, error) {
+	ctx, span := tracing.Start(ctx, "Service.ReceptionsReport")
+	defer span.End()
+
+	if filter.Type != nil {
+		return nil, fmt.Errorf("%w: receptions report can not be filtered by product type", ErrInvalidReportFilter)
+	}
+	filter, err := normalizeReportFilter(filter, receptionReportDimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ReceptionsReport(ctx, filter)
+	return rows, err
+}
+
+// ProductsReport возвращает количество принятых товаров по периодам
+func (s *Service) ProductsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ProductReportRow, error) {
+	ctx, span := tracing.Start(ctx, "Service.ProductsReport")
+	defer span.End()
+
+	filter, err := normalizeReportFilter(filter, productReportDimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.repo.ProductsReport(ctx, filter)
+	return rows, err
+}
+
+// normalizeReportFilter проверяет период и разрезы отчета. По умолчанию отчет строится по дням
+func normalizeReportFilter(filter repository.ReportFilter, dimensions []string) (repository.ReportFilter, error) {
+	if filter.From.IsZero() || filter.To.IsZero() || !filter.From.Before(filter.To) {
+		return filter, fmt.Errorf("%w: startDate must be before endDate", ErrInvalidReportFilter)
+	}
+	if filter.To.Sub(filter.From) > maxReportRange {
+		return filter, fmt.Errorf("%w: date range must not exceed %d days", ErrInvalidReportFilter, int(maxReportRange.Hours()/24))
+	}
+
+	switch ReportPeriod(filter.Period) {
+	case "":
+		filter.Period = string(ReportPeriodDay)
+	case ReportPeriodDay, ReportPeriodWeek, ReportPeriodMonth:
+	default:
+		return filter, fmt.Errorf("%w: unknown period %s", ErrInvalidReportFilter, filter.Period)
+	}
+
+	groupBy := make([]string, 0, len(filter.GroupBy))
+	for _, dimension := range filter.GroupBy {
+		if !slices.Contains(dimensions, dimension) {
+			return filter, fmt.Errorf("%w: unknown groupBy %s", ErrInvalidReportFilter, dimension)
+		}
+		if !slices.Contains(groupBy, dimension) {
+			groupBy = append(groupBy, dimension)
+		}
+	}
+	filter.GroupBy = groupBy
+
+	return filter, nil
+}
@@ -2,11 +2,19 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
 	"time"
 
 	"github.com/DarRo9/pvz_service/config"
 	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/tracing"
+	"github.com/DarRo9/pvz_service/internal/utils"
+	"github.com/DarRo9/pvz_service/internal/webhook"
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -15,8 +23,20 @@ type ReceptionStatus string
 const (
 	Close      ReceptionStatus = "close"
 	InProgress ReceptionStatus = "in_progress"
+	Reopened   ReceptionStatus = "reopened"
+	Amended    ReceptionStatus = "amended"
 )
 
+// isClosedReception - приемка закрыта, в том числе после исправления модератором
+func isClosedReception(rc *repository.Reception) bool {
+	switch ReceptionStatus(rc.Status) {
+	case Close, Amended:
+		return true
+	default:
+		return false
+	}
+}
+
 type UserRole string
 
 const (
@@ -24,6 +44,54 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
+type ProductStatus string
+
+const (
+	ProductStatusAccepted ProductStatus = "accepted"
+	ProductStatusStored   ProductStatus = "stored"
+	ProductStatusIssued   ProductStatus = "issued"
+	ProductStatusReturned ProductStatus = "returned"
+)
+
+// productStatusTransitions описывает допустимые переходы статусов товара:
+// принят в приемке -> на хранении -> выдан клиенту или возвращен отправителю.
+// Выданный товар может быть возвращен клиентом, после чего уходит отправителю.
+var productStatusTransitions = map[ProductStatus][]ProductStatus{
+	ProductStatusAccepted: {ProductStatusStored},
+	ProductStatusStored:   {ProductStatusIssued, ProductStatusReturned},
+	ProductStatusIssued:   {ProductStatusReturned},
+}
+
+var (
+	ErrInvalidRefreshToken            = errors.New("invalid refresh token")
+	ErrInvalidPVZFilter               = errors.New("invalid pvz filter")
+	ErrPVZNotFound                    = errors.New("pvz not found")
+	ErrPVZInactive                    = errors.New("pvz is inactive")
+	ErrReceptionInProgress            = errors.New("pvz has a reception in progress")
+	ErrProductNotFound                = errors.New("product not found")
+	ErrInvalidProductStatusTransition = errors.New("invalid product status transition")
+	ErrWebhookSubscriptionNotFound    = errors.New("webhook subscription not found")
+	ErrInvalidWebhookSubscription     = errors.New("invalid webhook subscription")
+	ErrInvalidLocation                = errors.New("invalid location")
+	ErrInvalidProductType             = errors.New("invalid product type")
+	ErrInvalidProductDetails          = errors.New("invalid product details")
+	ErrDuplicateBarcode               = errors.New("barcode already scanned in this reception")
+)
+
+// maxNearbyRadius - максимальный радиус поиска ближайших ПВЗ в метрах
+const maxNearbyRadius = 50000
+
+const (
+	maxBarcodeLength      = 64
+	maxProductAttributes  = 50
+	maxProductAttrKeySize = 64
+)
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
 type ServiceInterface interface {
 	RegisterUser(ctx context.Context, email string, password string, role string) (*repository.User, error)
 
@@ -31,57 +99,127 @@ type ServiceInterface interface {
 
 	GetUserByEmail(ctx context.Context, email string) (*repository.User, error)
 
-	CreatePVZ(ctx context.Context, city string) (*repository.PVZ, error)
+	CreatePVZ(ctx context.Context, city string, location repository.PVZLocation) (*repository.PVZ, error)
+
+	GetPVZ(ctx context.Context, pvzId string) (*repository.PVZWithReceptions, error)
+
+	UpdatePVZ(ctx context.Context, pvzId string, update repository.PVZUpdate) (*repository.PVZ, error)
+
+	ListNearbyPVZ(ctx context.Context, lat, lon, radius float64, limit int) ([]*repository.NearbyPVZ, error)
+
+	DeactivatePVZ(ctx context.Context, pvzId string) (*repository.PVZ, error)
+
+	CloseReception(ctx context.Context, pvzId string, userId string) (*repository.Reception, error)
+
+	SetReceptionManifest(ctx context.Context, receptionId string, items []*repository.ManifestItem, blockOnDiscrepancies bool, userId string) (*repository.ReceptionManifest, error)
+
+	GetReceptionManifest(ctx context.Context, receptionId string) (*repository.ReceptionManifest, error)
+
+	GetReconciliation(ctx context.Context, receptionId string) (*repository.Reconciliation, error)
+
+	AcknowledgeReconciliation(ctx context.Context, receptionId string, userId string) (*repository.Reconciliation, error)
 
-	CloseReception(ctx context.Context, pvzId string) (*repository.Reception, error)
+	DeleteProduct(ctx context.Context, pvzId string, userId string) (*repository.Product, error)
 
-	DeleteProduct(ctx context.Context, pvzId string) (*repository.Product, error)
+	CreateReception(ctx context.Context, pvzId string, userId string) (*repository.Reception, error)
 
-	CreateReception(ctx context.Context, pvzId string) (*repository.Reception, error)
+	ListReceptions(ctx context.Context, pvzId string, filter repository.ReceptionFilter) ([]*repository.Reception, error)
 
-	ListPVZ(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*repository.PVZWithReceptions, error)
+	GetReception(ctx context.Context, receptionId string) (*repository.ReceptionWithProducts, error)
 
-	CreateProduct(ctx context.Context, receptionID string, productType string) (*repository.Product, error)
+	ReopenReception(ctx context.Context, receptionId string, reason string, userId string) (*repository.Reception, error)
+
+	AmendReception(ctx context.Context, receptionId string, amendment repository.ReceptionAmendment, userId string) (*repository.ReceptionCorrection, error)
+
+	ListReceptionCorrections(ctx context.Context, receptionId string) ([]*repository.ReceptionCorrection, error)
+
+	ListPVZ(ctx context.Context, filter repository.PVZFilter, page, limit int) ([]*repository.PVZWithReceptions, error)
+
+	ListPVZByCursor(ctx context.Context, filter repository.PVZFilter, cursor string, limit int) ([]*repository.PVZWithReceptions, string, error)
+
+	CreateProduct(ctx context.Context, pvzId string, product repository.NewProduct, userId string) (*repository.Product, error)
+
+	CreateProducts(ctx context.Context, pvzId string, products []repository.NewProduct, userId string) ([]*ProductBatchResult, error)
+
+	FindProductsByBarcode(ctx context.Context, barcode string) ([]*repository.Product, error)
 
 	ListAllPVZ(ctx context.Context) ([]*repository.PVZ, error)
 
-	IsValidCity(city string) bool
+	IssueProduct(ctx context.Context, productId string, userId string) (*repository.Product, error)
+
+	ReturnProduct(ctx context.Context, productId string, userId string) (*repository.Product, error)
+
+	GetProductHistory(ctx context.Context, productId string) ([]*repository.ProductStatusChange, error)
 
-	IsValidProductType(productType string) bool
+	CreateWebhookSubscription(ctx context.Context, webhookURL string, eventTypes []string) (*repository.WebhookSubscription, error)
+
+	ListWebhookSubscriptions(ctx context.Context) ([]*repository.WebhookSubscription, error)
+
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+
+	ReceptionsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ReceptionReportRow, error)
+
+	ProductsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ProductReportRow, error)
+
+	ExportReceptions(ctx context.Context, startDate, endDate *time.Time, fn func(*repository.ReceptionExportRow) error) error
+
+	ListCities(ctx context.Context) ([]*repository.DictionaryEntry, error)
+
+	CreateCity(ctx context.Context, name string) (*repository.DictionaryEntry, error)
+
+	DeleteCity(ctx context.Context, name string) error
+
+	ListProductTypes(ctx context.Context) ([]*repository.DictionaryEntry, error)
+
+	CreateProductType(ctx context.Context, name string) (*repository.DictionaryEntry, error)
+
+	DeleteProductType(ctx context.Context, name string) error
+
+	ListPVZEmployees(ctx context.Context, pvzId string) ([]*repository.PVZEmployee, error)
+
+	AssignEmployee(ctx context.Context, pvzId string, userId string) (*repository.PVZEmployee, error)
+
+	UnassignEmployee(ctx context.Context, pvzId string, userId string) error
+
+	ListAuditRecords(ctx context.Context, filter repository.AuditFilter) ([]*repository.AuditRecord, error)
+
+	BeginIdempotentRequest(ctx context.Context, userID, key, requestHash string) (*repository.IdempotencyRecord, error)
+
+	CompleteIdempotentRequest(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error
+
+	ReleaseIdempotentRequest(ctx context.Context, userID, key string) error
+
+	IsValidCity(ctx context.Context, city string) (bool, error)
+
+	IsValidProductType(ctx context.Context, productType string) (bool, error)
 
 	IsValidRole(role UserRole) bool
+
+	IssueTokens(ctx context.Context, user *repository.User) (*TokenPair, error)
+
+	RefreshTokens(ctx context.Context, refreshToken string) (*TokenPair, error)
+
+	Logout(ctx context.Context, userId, jti string, expiresAt time.Time, refreshToken string) error
+
+	IsTokenRevoked(ctx context.Context, jti string) (bool, error)
 }
 
 type Service struct {
-	repo   repository.Repository
-	config *config.Config
+	repo         repository.Repository
+	config       *config.Config
+	cities       *dictionaryCache
+	productTypes *dictionaryCache
 }
 
 func NewService(repo repository.Repository, config *config.Config) *Service {
 	return &Service{
-		repo:   repo,
-		config: config,
+		repo:         repo,
+		config:       config,
+		cities:       newDictionaryCache(repo.ListCities, config.Dictionaries.CacheTTL),
+		productTypes: newDictionaryCache(repo.ListProductTypes, config.Dictionaries.CacheTTL),
 	}
 }
 
-func (s *Service) IsValidCity(city string) bool {
-	for _, c := range s.config.Cities {
-		if c == city {
-			return true
-		}
-	}
-	return false
-}
-
-func (s *Service) IsValidProductType(productType string) bool {
-	for _, p := range s.config.ProductTypes {
-		if p == productType {
-			return true
-		}
-	}
-	return false
-}
-
 func (s *Service) IsValidRole(role UserRole) bool {
 	switch role {
 	case UserRoleEmployee, UserRoleModerator:
@@ -92,6 +230,9 @@ func (s *Service) IsValidRole(role UserRole) bool {
 }
 
 func (s *Service) RegisterUser(ctx context.Context, email string, password string, role string) (*repository.User, error) {
+	ctx, span := tracing.Start(ctx, "Service.RegisterUser")
+	defer span.End()
+
 	if !s.IsValidRole(UserRole(role)) {
 		return nil, fmt.Errorf("invalid role: %s", role)
 	}
@@ -101,59 +242,648 @@ func (s *Service) RegisterUser(ctx context.Context, email string, password strin
 		return nil, fmt.Errorf("error hashing password: %w", err)
 	}
 
-	user, err := s.repo.CreateUser(ctx, email, string(hashedPassword), role)
-	return user, err
+	var user *repository.User
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		user, err = s.repo.CreateUser(ctx, email, string(hashedPassword), role)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionCreate, auditEntityUser, user.ID, nil, userAuditPayload(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
 }
 
 func (s *Service) CheckPassword(ctx context.Context, user *repository.User, password string) error {
+	_, span := tracing.Start(ctx, "Service.CheckPassword")
+	defer span.End()
+
 	return bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
 }
 
 func (s *Service) GetUserByEmail(ctx context.Context, email string) (*repository.User, error) {
+	ctx, span := tracing.Start(ctx, "Service.GetUserByEmail")
+	defer span.End()
+
 	user, err := s.repo.GetUserByEmail(ctx, email)
 	return user, err
 }
 
-func (s *Service) CreatePVZ(ctx context.Context, city string) (*repository.PVZ, error) {
-	if !s.IsValidCity(city) {
+func (s *Service) CreatePVZ(ctx context.Context, city string, location repository.PVZLocation) (*repository.PVZ, error) {
+	ctx, span := tracing.Start(ctx, "Service.CreatePVZ")
+	defer span.End()
+
+	valid, err := s.IsValidCity(ctx, city)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
 		return nil, fmt.Errorf("invalid city: %s", city)
 	}
-	pvz, err := s.repo.CreatePVZ(ctx, city)
+	if err := validateLocation(location); err != nil {
+		return nil, err
+	}
+	var pvz *repository.PVZ
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pvz, err = s.repo.CreatePVZ(ctx, city, location)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionCreate, auditEntityPVZ, pvz.ID, nil, pvz)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pvz, nil
+}
+
+func (s *Service) GetPVZ(ctx context.Context, pvzId string) (*repository.PVZWithReceptions, error) {
+	ctx, span := tracing.Start(ctx, "Service.GetPVZ")
+	defer span.End()
+
+	pvz, err := s.repo.GetPVZ(ctx, pvzId)
+	if errors.Is(err, repository.ErrPVZNotFound) {
+		return nil, ErrPVZNotFound
+	}
 	return pvz, err
 }
 
-func (s *Service) CloseReception(ctx context.Context, pvzId string) (*repository.Reception, error) {
-	rc, err := s.repo.CloseReception(ctx, pvzId)
-	return rc, err
+func (s *Service) UpdatePVZ(ctx context.Context, pvzId string, update repository.PVZUpdate) (*repository.PVZ, error) {
+	ctx, span := tracing.Start(ctx, "Service.UpdatePVZ")
+	defer span.End()
+
+	if update.City != nil {
+		valid, err := s.IsValidCity(ctx, *update.City)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid city: %s", *update.City)
+		}
+	}
+	if err := validateLocation(update.PVZLocation); err != nil {
+		return nil, err
+	}
+
+	before, err := s.GetPVZ(ctx, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pvz *repository.PVZ
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pvz, err = s.repo.UpdatePVZ(ctx, pvzId, update)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionUpdate, auditEntityPVZ, pvzId, before.PVZ, pvz)
+	})
+	if err != nil {
+		return nil, pvzError(err, pvzId)
+	}
+
+	return pvz, nil
+}
+
+// ListNearbyPVZ ищет активные ПВЗ в радиусе radius метров от точки
+func (s *Service) ListNearbyPVZ(ctx context.Context, lat, lon, radius float64, limit int) ([]*repository.NearbyPVZ, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListNearbyPVZ")
+	defer span.End()
+
+	if err := validateCoordinates(lat, lon); err != nil {
+		return nil, err
+	}
+	if radius <= 0 || radius > maxNearbyRadius {
+		return nil, fmt.Errorf("%w: radius must be between 0 and %d meters", ErrInvalidLocation, maxNearbyRadius)
+	}
+
+	nearby, err := s.repo.ListNearbyPVZ(ctx, lat, lon, radius, limit)
+	return nearby, err
+}
+
+// validateLocation проверяет, что координаты переданы парой и лежат в допустимых пределах
+func validateLocation(location repository.PVZLocation) error {
+	if (location.Latitude == nil) != (location.Longitude == nil) {
+		return fmt.Errorf("%w: latitude and longitude must be set together", ErrInvalidLocation)
+	}
+	if location.Latitude == nil {
+		return nil
+	}
+	return validateCoordinates(*location.Latitude, *location.Longitude)
+}
+
+func validateCoordinates(lat, lon float64) error {
+	if lat < -90 || lat > 90 {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", ErrInvalidLocation)
+	}
+	if lon < -180 || lon > 180 {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", ErrInvalidLocation)
+	}
+	return nil
+}
+
+func (s *Service) DeactivatePVZ(ctx context.Context, pvzId string) (*repository.PVZ, error) {
+	ctx, span := tracing.Start(ctx, "Service.DeactivatePVZ")
+	defer span.End()
+
+	before, err := s.GetPVZ(ctx, pvzId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pvz *repository.PVZ
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		pvz, err = s.repo.DeactivatePVZ(ctx, pvzId)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionDeactivate, auditEntityPVZ, pvzId, before.PVZ, pvz)
+	})
+	if err != nil {
+		return nil, pvzError(err, pvzId)
+	}
+
+	return pvz, nil
+}
+
+func pvzError(err error, pvzId string) error {
+	switch {
+	case errors.Is(err, repository.ErrPVZNotFound):
+		return ErrPVZNotFound
+	case errors.Is(err, repository.ErrPVZInactive):
+		return fmt.Errorf("%w: %s", ErrPVZInactive, pvzId)
+	case errors.Is(err, repository.ErrReceptionInProgress):
+		return fmt.Errorf("%w: %s", ErrReceptionInProgress, pvzId)
+	default:
+		return err
+	}
 }
 
-func (s *Service) DeleteProduct(ctx context.Context, pvzId string) (*repository.Product, error) {
-	product, err := s.repo.DeleteProduct(ctx, pvzId)
-	return product, err
+func (s *Service) CloseReception(ctx context.Context, pvzId string, userId string) (*repository.Reception, error) {
+	ctx, span := tracing.Start(ctx, "Service.CloseReception")
+	defer span.End()
+
+	if err := s.checkAssignment(ctx, pvzId, userId); err != nil {
+		return nil, err
+	}
+	var rc *repository.Reception
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		// Если приемок нет, CloseReception вернет свою ошибку
+		before, err := s.repo.GetLastReception(ctx, pvzId)
+		if err != nil && !errors.Is(err, repository.ErrReceptionNotFound) {
+			return err
+		}
+		rc, err = s.repo.CloseReception(ctx, pvzId, reconcileOnClose)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionClose, auditEntityReception, rc.ID, before, rc)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rc, nil
 }
 
-func (s *Service) CreateReception(ctx context.Context, pvzId string) (*repository.Reception, error) {
-	rc, err := s.repo.CreateReception(ctx, pvzId)
-	return rc, err
+func (s *Service) DeleteProduct(ctx context.Context, pvzId string, userId string) (*repository.Product, error) {
+	ctx, span := tracing.Start(ctx, "Service.DeleteProduct")
+	defer span.End()
+
+	if err := s.checkAssignment(ctx, pvzId, userId); err != nil {
+		return nil, err
+	}
+	var product *repository.Product
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = s.repo.DeleteProduct(ctx, pvzId)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionDelete, auditEntityProduct, product.ID, product, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
 }
 
-func (s *Service) ListPVZ(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*repository.PVZWithReceptions, error) {
-	pvzs, err := s.repo.ListPVZ(ctx, startDate, endDate, page, limit)
+func (s *Service) CreateReception(ctx context.Context, pvzId string, userId string) (*repository.Reception, error) {
+	ctx, span := tracing.Start(ctx, "Service.CreateReception")
+	defer span.End()
+
+	if err := s.checkAssignment(ctx, pvzId, userId); err != nil {
+		return nil, err
+	}
+	var rc *repository.Reception
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		rc, err = s.repo.CreateReception(ctx, pvzId)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionCreate, auditEntityReception, rc.ID, nil, rc)
+	})
+	if err != nil {
+		return nil, pvzError(err, pvzId)
+	}
+
+	return rc, nil
+}
+
+func (s *Service) ListPVZ(ctx context.Context, filter repository.PVZFilter, page, limit int) ([]*repository.PVZWithReceptions, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListPVZ")
+	defer span.End()
+
+	if err := validatePVZFilter(filter); err != nil {
+		return nil, err
+	}
+
+	pvzs, err := s.repo.ListPVZ(ctx, filter, page, limit)
 
 	return pvzs, err
 }
 
-func (s *Service) CreateProduct(ctx context.Context, receptionID string, productType string) (*repository.Product, error) {
-	if !s.IsValidProductType(productType) {
-		return nil, fmt.Errorf("invalid product type: %s", productType)
+func validatePVZFilter(filter repository.PVZFilter) error {
+	if filter.StartDate != nil && filter.EndDate != nil && filter.StartDate.After(*filter.EndDate) {
+		return fmt.Errorf("%w: startDate must not be after endDate", ErrInvalidPVZFilter)
+	}
+	if filter.ReceptionStatus != nil {
+		switch ReceptionStatus(*filter.ReceptionStatus) {
+		case InProgress, Close, Reopened, Amended:
+		default:
+			return fmt.Errorf("%w: unknown reception status %s", ErrInvalidPVZFilter, *filter.ReceptionStatus)
+		}
+	}
+	return nil
+}
+
+func (s *Service) CreateProduct(ctx context.Context, pvzId string, newProduct repository.NewProduct, userId string) (*repository.Product, error) {
+	ctx, span := tracing.Start(ctx, "Service.CreateProduct")
+	defer span.End()
+
+	if err := s.checkAssignment(ctx, pvzId, userId); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateNewProduct(ctx, newProduct); err != nil {
+		return nil, err
+	}
+
+	var product *repository.Product
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		product, err = s.repo.CreateProduct(ctx, pvzId, newProduct)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionCreate, auditEntityProduct, product.ID, nil, product)
+	})
+	if errors.Is(err, repository.ErrDuplicateBarcode) {
+		return nil, fmt.Errorf("%w: %s", ErrDuplicateBarcode, *newProduct.Barcode)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// validateNewProduct проверяет тип товара по справочнику и его необязательные характеристики
+func (s *Service) validateNewProduct(ctx context.Context, newProduct repository.NewProduct) error {
+	valid, err := s.IsValidProductType(ctx, newProduct.Type)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("%w: %s", ErrInvalidProductType, newProduct.Type)
 	}
+	return validateProductDetails(newProduct.ProductDetails)
+}
 
-	product, err := s.repo.CreateProduct(ctx, receptionID, productType)
+func validateProductDetails(details repository.ProductDetails) error {
+	if details.Barcode != nil && (*details.Barcode == "" || len(*details.Barcode) > maxBarcodeLength) {
+		return fmt.Errorf("%w: barcode must be from 1 to %d characters", ErrInvalidProductDetails, maxBarcodeLength)
+	}
+	for name, value := range map[string]*int{
+		"weight": details.WeightGrams,
+		"length": details.LengthMm,
+		"width":  details.WidthMm,
+		"height": details.HeightMm,
+	} {
+		if value != nil && *value <= 0 {
+			return fmt.Errorf("%w: %s must be positive", ErrInvalidProductDetails, name)
+		}
+	}
+	if len(details.Attributes) > maxProductAttributes {
+		return fmt.Errorf("%w: at most %d attributes are allowed", ErrInvalidProductDetails, maxProductAttributes)
+	}
+	for key := range details.Attributes {
+		if key == "" || len(key) > maxProductAttrKeySize {
+			return fmt.Errorf("%w: attribute name must be from 1 to %d characters", ErrInvalidProductDetails, maxProductAttrKeySize)
+		}
+	}
+	return nil
+}
 
-	return product, err
+// FindProductsByBarcode ищет товары по штрихкоду во всех приемках, начиная с последнего
+func (s *Service) FindProductsByBarcode(ctx context.Context, barcode string) ([]*repository.Product, error) {
+	ctx, span := tracing.Start(ctx, "Service.FindProductsByBarcode")
+	defer span.End()
+
+	if barcode == "" {
+		return nil, fmt.Errorf("%w: barcode is required", ErrInvalidProductDetails)
+	}
+
+	products, err := s.repo.ListProductsByBarcode(ctx, barcode)
+	return products, err
 }
 
 func (s *Service) ListAllPVZ(ctx context.Context) ([]*repository.PVZ, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListAllPVZ")
+	defer span.End()
+
 	pvzs, err := s.repo.ListAllPVZ(ctx)
 	return pvzs, err
 }
+
+func (s *Service) IssueProduct(ctx context.Context, productId string, userId string) (*repository.Product, error) {
+	ctx, span := tracing.Start(ctx, "Service.IssueProduct")
+	defer span.End()
+
+	return s.changeProductStatus(ctx, productId, ProductStatusIssued, userId)
+}
+
+func (s *Service) ReturnProduct(ctx context.Context, productId string, userId string) (*repository.Product, error) {
+	ctx, span := tracing.Start(ctx, "Service.ReturnProduct")
+	defer span.End()
+
+	return s.changeProductStatus(ctx, productId, ProductStatusReturned, userId)
+}
+
+func (s *Service) GetProductHistory(ctx context.Context, productId string) ([]*repository.ProductStatusChange, error) {
+	ctx, span := tracing.Start(ctx, "Service.GetProductHistory")
+	defer span.End()
+
+	if _, err := s.repo.GetProduct(ctx, productId); err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+
+	history, err := s.repo.ListProductStatusHistory(ctx, productId)
+	return history, err
+}
+
+func (s *Service) changeProductStatus(ctx context.Context, productId string, status ProductStatus, userId string) (*repository.Product, error) {
+	product, err := s.repo.GetProduct(ctx, productId)
+	if errors.Is(err, repository.ErrProductNotFound) {
+		return nil, ErrProductNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	current := ProductStatus(product.Status)
+	if !canChangeProductStatus(current, status) {
+		return nil, fmt.Errorf("%w: %s -> %s", ErrInvalidProductStatusTransition, current, status)
+	}
+
+	var updated *repository.Product
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = s.repo.UpdateProductStatus(ctx, productId, string(current), string(status), userId)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, productStatusAuditActions[status], auditEntityProduct, productId, product, updated)
+	})
+	if errors.Is(err, repository.ErrProductStatusConflict) {
+		return nil, fmt.Errorf("%w: product %s status has changed", ErrInvalidProductStatusTransition, productId)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func canChangeProductStatus(from, to ProductStatus) bool {
+	for _, status := range productStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Service) CreateWebhookSubscription(ctx context.Context, webhookURL string, eventTypes []string) (*repository.WebhookSubscription, error) {
+	ctx, span := tracing.Start(ctx, "Service.CreateWebhookSubscription")
+	defer span.End()
+
+	if !isValidWebhookURL(webhookURL) {
+		return nil, fmt.Errorf("%w: invalid url %s", ErrInvalidWebhookSubscription, webhookURL)
+	}
+	for _, eventType := range eventTypes {
+		if !slices.Contains(repository.EventTypes, eventType) {
+			return nil, fmt.Errorf("%w: invalid event type %s", ErrInvalidWebhookSubscription, eventType)
+		}
+	}
+
+	secret, err := webhook.GenerateSecret()
+	if err != nil {
+		return nil, fmt.Errorf("error generating webhook secret: %w", err)
+	}
+
+	var subscription *repository.WebhookSubscription
+	err = s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		subscription, err = s.repo.CreateWebhookSubscription(ctx, webhookURL, secret, eventTypes)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionCreate, auditEntityWebhookSubscription, subscription.ID, nil, webhookSubscriptionAuditPayload(subscription))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *Service) ListWebhookSubscriptions(ctx context.Context) ([]*repository.WebhookSubscription, error) {
+	ctx, span := tracing.Start(ctx, "Service.ListWebhookSubscriptions")
+	defer span.End()
+
+	subscriptions, err := s.repo.ListWebhookSubscriptions(ctx)
+	return subscriptions, err
+}
+
+func (s *Service) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	ctx, span := tracing.Start(ctx, "Service.DeleteWebhookSubscription")
+	defer span.End()
+
+	err := s.repo.WithinTx(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetWebhookSubscription(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := s.repo.DeleteWebhookSubscription(ctx, id); err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, auditActionDelete, auditEntityWebhookSubscription, id, webhookSubscriptionAuditPayload(before), nil)
+	})
+	if errors.Is(err, repository.ErrWebhookSubscriptionNotFound) {
+		return ErrWebhookSubscriptionNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func isValidWebhookURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func (s *Service) IssueTokens(ctx context.Context, user *repository.User) (*TokenPair, error) {
+	ctx, span := tracing.Start(ctx, "Service.IssueTokens")
+	defer span.End()
+
+	accessToken, err := utils.GenerateJWT(user.ID, user.Email, user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("error generating access token: %w", err)
+	}
+
+	refreshToken, expiresAt, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("error generating refresh token: %w", err)
+	}
+
+	if _, err := s.repo.CreateRefreshToken(ctx, user.ID, utils.HashToken(refreshToken), expiresAt); err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// RefreshTokens выдает новую пару токенов и отзывает использованный refresh токен.
+// Повторное предъявление уже отозванного токена считается кражей, и все сессии пользователя завершаются.
+func (s *Service) RefreshTokens(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	ctx, span := tracing.Start(ctx, "Service.RefreshTokens")
+	defer span.End()
+
+	tokenHash := utils.HashToken(refreshToken)
+
+	stored, err := s.repo.GetRefreshToken(ctx, tokenHash)
+	if errors.Is(err, repository.ErrRefreshTokenNotFound) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.RevokedAt != nil {
+		if err := s.repo.RevokeUserRefreshTokens(ctx, stored.UserID); err != nil {
+			return nil, err
+		}
+		return nil, ErrInvalidRefreshToken
+	}
+
+	user, err := s.repo.GetUserByID(ctx, stored.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	newRefreshToken, expiresAt, err := utils.GenerateRefreshToken()
+	if err != nil {
+		return nil, fmt.Errorf("error generating refresh token: %w", err)
+	}
+
+	_, err = s.repo.RotateRefreshToken(ctx, tokenHash, utils.HashToken(newRefreshToken), expiresAt)
+	if errors.Is(err, repository.ErrRefreshTokenInvalid) {
+		return nil, ErrInvalidRefreshToken
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := utils.GenerateJWT(user.ID, user.Email, user.Role)
+	if err != nil {
+		return nil, fmt.Errorf("error generating access token: %w", err)
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: newRefreshToken,
+	}, nil
+}
+
+// Logout отзывает access токен и переданный refresh токен, если он принадлежит тому же пользователю
+func (s *Service) Logout(ctx context.Context, userId, jti string, expiresAt time.Time, refreshToken string) error {
+	ctx, span := tracing.Start(ctx, "Service.Logout")
+	defer span.End()
+
+	if err := s.repo.RevokeAccessToken(ctx, jti, expiresAt); err != nil {
+		return err
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+	// У токенов dummyLogin нет пользователя, а значит и refresh токенов
+	if _, err := uuid.Parse(userId); err != nil {
+		return nil
+	}
+
+	return s.repo.RevokeRefreshToken(ctx, userId, utils.HashToken(refreshToken))
+}
+
+// RunTokenCleanup периодически удаляет отозванные access токены с истекшим сроком действия
+func (s *Service) RunTokenCleanup(ctx context.Context) {
+	interval := s.config.Tokens.CleanupInterval
+	if interval <= 0 {
+		interval = time.Hour
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := s.repo.DeleteExpiredRevokedTokens(ctx, time.Now())
+			if err != nil {
+				slog.ErrorContext(ctx, "Error deleting expired revoked tokens", "error", err)
+				continue
+			}
+			slog.DebugContext(ctx, "Expired revoked tokens deleted", "count", deleted)
+		}
+	}
+}
+
+func (s *Service) IsTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "Service.IsTokenRevoked")
+	defer span.End()
+
+	return s.repo.IsAccessTokenRevoked(ctx, jti)
+}
@@ -2,18 +2,25 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/DarRo9/pvz_service/config"
 	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/DarRo9/pvz_service/internal/utils"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// employeeID - идентификатор сотрудника в тестах, в БД user_id хранится как UUID
+const employeeID = "3fa85f64-5717-4562-b3fc-2c963f66afa6"
+
 // MockRepository реализует интерфейс repository.Repository для тестов
 type MockRepository struct {
 	mock.Mock
@@ -29,16 +36,107 @@ func (m *MockRepository) GetUserByEmail(ctx context.Context, email string) (*rep
 	return args.Get(0).(*repository.User), args.Error(1)
 }
 
-func (m *MockRepository) CreatePVZ(ctx context.Context, city string) (*repository.PVZ, error) {
-	args := m.Called(ctx, city)
+func (m *MockRepository) CreatePVZ(ctx context.Context, city string, location repository.PVZLocation) (*repository.PVZ, error) {
+	args := m.Called(ctx, city, location)
+	return args.Get(0).(*repository.PVZ), args.Error(1)
+}
+
+func (m *MockRepository) GetPVZ(ctx context.Context, PVZID string) (*repository.PVZWithReceptions, error) {
+	args := m.Called(ctx, PVZID)
+	return args.Get(0).(*repository.PVZWithReceptions), args.Error(1)
+}
+
+func (m *MockRepository) UpdatePVZ(ctx context.Context, PVZID string, update repository.PVZUpdate) (*repository.PVZ, error) {
+	args := m.Called(ctx, PVZID, update)
+	return args.Get(0).(*repository.PVZ), args.Error(1)
+}
+
+func (m *MockRepository) ListNearbyPVZ(ctx context.Context, lat, lon, radius float64, limit int) ([]*repository.NearbyPVZ, error) {
+	args := m.Called(ctx, lat, lon, radius, limit)
+	return args.Get(0).([]*repository.NearbyPVZ), args.Error(1)
+}
+
+func (m *MockRepository) DeactivatePVZ(ctx context.Context, PVZID string) (*repository.PVZ, error) {
+	args := m.Called(ctx, PVZID)
 	return args.Get(0).(*repository.PVZ), args.Error(1)
 }
 
-func (m *MockRepository) CloseReception(ctx context.Context, pvzId string) (*repository.Reception, error) {
+func (m *MockRepository) CloseReception(ctx context.Context, pvzId string, reconcile repository.ReconcileFunc) (*repository.Reception, error) {
 	args := m.Called(ctx, pvzId)
 	return args.Get(0).(*repository.Reception), args.Error(1)
 }
 
+func (m *MockRepository) ReceptionsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ReceptionReportRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ReceptionReportRow), args.Error(1)
+}
+
+func (m *MockRepository) ProductsReport(ctx context.Context, filter repository.ReportFilter) ([]*repository.ProductReportRow, error) {
+	args := m.Called(ctx, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.ProductReportRow), args.Error(1)
+}
+
+// ExportReceptions передает в fn строки, заданные в Return, и возвращает заданную ошибку
+func (m *MockRepository) ExportReceptions(ctx context.Context, startDate, endDate *time.Time, fn func(*repository.ReceptionExportRow) error) error {
+	args := m.Called(ctx, startDate, endDate)
+	if rows, ok := args.Get(0).([]*repository.ReceptionExportRow); ok {
+		for _, row := range rows {
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
+func (m *MockRepository) GetReception(ctx context.Context, receptionID string) (*repository.Reception, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reception), args.Error(1)
+}
+
+func (m *MockRepository) SaveReceptionManifest(ctx context.Context, manifest *repository.ReceptionManifest) error {
+	args := m.Called(ctx, manifest)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetLastReception(ctx context.Context, PVZID string) (*repository.Reception, error) {
+	args := m.Called(ctx, PVZID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reception), args.Error(1)
+}
+
+func (m *MockRepository) GetReceptionManifest(ctx context.Context, receptionID string) (*repository.ReceptionManifest, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionManifest), args.Error(1)
+}
+
+func (m *MockRepository) GetReconciliation(ctx context.Context, receptionID string) (*repository.Reconciliation, error) {
+	args := m.Called(ctx, receptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reconciliation), args.Error(1)
+}
+
+func (m *MockRepository) SaveReconciliation(ctx context.Context, reconciliation *repository.Reconciliation) error {
+	args := m.Called(ctx, reconciliation)
+	return args.Error(0)
+}
+
 func (m *MockRepository) DeleteProduct(ctx context.Context, pvzId string) (*repository.Product, error) {
 	args := m.Called(ctx, pvzId)
 	return args.Get(0).(*repository.Product), args.Error(1)
@@ -49,16 +147,34 @@ func (m *MockRepository) CreateReception(ctx context.Context, pvzId string) (*re
 	return args.Get(0).(*repository.Reception), args.Error(1)
 }
 
-func (m *MockRepository) ListPVZ(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*repository.PVZWithReceptions, error) {
-	args := m.Called(ctx, startDate, endDate, page, limit)
+func (m *MockRepository) ListPVZ(ctx context.Context, filter repository.PVZFilter, page, limit int) ([]*repository.PVZWithReceptions, error) {
+	args := m.Called(ctx, filter, page, limit)
 	return args.Get(0).([]*repository.PVZWithReceptions), args.Error(1)
 }
 
-func (m *MockRepository) CreateProduct(ctx context.Context, receptionID, productType string) (*repository.Product, error) {
-	args := m.Called(ctx, receptionID, productType)
+func (m *MockRepository) ListPVZAfter(ctx context.Context, filter repository.PVZFilter, after *repository.PVZCursor, limit int) ([]*repository.PVZWithReceptions, error) {
+	args := m.Called(ctx, filter, after, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*repository.PVZWithReceptions), args.Error(1)
+}
+
+func (m *MockRepository) CreateProduct(ctx context.Context, pvzId string, newProduct repository.NewProduct) (*repository.Product, error) {
+	args := m.Called(ctx, pvzId, newProduct)
 	return args.Get(0).(*repository.Product), args.Error(1)
 }
 
+func (m *MockRepository) CreateProducts(ctx context.Context, pvzId string, newProducts []repository.NewProduct) ([]*repository.Product, error) {
+	args := m.Called(ctx, pvzId, newProducts)
+	return args.Get(0).([]*repository.Product), args.Error(1)
+}
+
+func (m *MockRepository) ListProductsByBarcode(ctx context.Context, barcode string) ([]*repository.Product, error) {
+	args := m.Called(ctx, barcode)
+	return args.Get(0).([]*repository.Product), args.Error(1)
+}
+
 func (m *MockRepository) ListAllPVZ(ctx context.Context) ([]*repository.PVZ, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]*repository.PVZ), args.Error(1)
@@ -69,46 +185,338 @@ func (m *MockRepository) ListProducts(ctx context.Context, receptionID string) (
 	return args.Get(0).([]*repository.Product), args.Error(1)
 }
 
-func (m *MockRepository) ListReception(ctx context.Context, PVZID string) ([]*repository.Reception, error) {
-	args := m.Called(ctx)
+func (m *MockRepository) GetProduct(ctx context.Context, productID string) (*repository.Product, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockRepository) UpdateProductStatus(ctx context.Context, productID, fromStatus, toStatus, changedBy string) (*repository.Product, error) {
+	args := m.Called(ctx, productID, fromStatus, toStatus, changedBy)
+	return args.Get(0).(*repository.Product), args.Error(1)
+}
+
+func (m *MockRepository) ListProductStatusHistory(ctx context.Context, productID string) ([]*repository.ProductStatusChange, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).([]*repository.ProductStatusChange), args.Error(1)
+}
+
+func (m *MockRepository) ListReception(ctx context.Context, PVZID string, filter repository.ReceptionFilter) ([]*repository.Reception, error) {
+	args := m.Called(ctx, PVZID, filter)
 	return args.Get(0).([]*repository.Reception), args.Error(1)
 }
 
+func (m *MockRepository) ReopenReception(ctx context.Context, receptionID, reason, reopenedBy string) (*repository.Reception, error) {
+	args := m.Called(ctx, receptionID, reason, reopenedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.Reception), args.Error(1)
+}
+
+func (m *MockRepository) AmendReception(ctx context.Context, receptionID string, amendment repository.ReceptionAmendment, reconcile repository.ReconcileFunc) (*repository.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionID, amendment)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.ReceptionCorrection), args.Error(1)
+}
+
+func (m *MockRepository) ListReceptionCorrections(ctx context.Context, receptionID string) ([]*repository.ReceptionCorrection, error) {
+	args := m.Called(ctx, receptionID)
+	return args.Get(0).([]*repository.ReceptionCorrection), args.Error(1)
+}
+
 func (m *MockRepository) ListUser(ctx context.Context) ([]*repository.User, error) {
 	args := m.Called(ctx)
 	return args.Get(0).([]*repository.User), args.Error(1)
 }
 
+func (m *MockRepository) GetUserByID(ctx context.Context, id string) (*repository.User, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(*repository.User), args.Error(1)
+}
+
+func (m *MockRepository) CreateRefreshToken(ctx context.Context, userID, tokenHash string, expiresAt time.Time) (*repository.RefreshToken, error) {
+	args := m.Called(ctx, userID, tokenHash, expiresAt)
+	return args.Get(0).(*repository.RefreshToken), args.Error(1)
+}
+
+func (m *MockRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*repository.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash)
+	return args.Get(0).(*repository.RefreshToken), args.Error(1)
+}
+
+func (m *MockRepository) RotateRefreshToken(ctx context.Context, tokenHash, newTokenHash string, expiresAt time.Time) (*repository.RefreshToken, error) {
+	args := m.Called(ctx, tokenHash, newTokenHash, expiresAt)
+	return args.Get(0).(*repository.RefreshToken), args.Error(1)
+}
+
+func (m *MockRepository) RevokeRefreshToken(ctx context.Context, userID, tokenHash string) error {
+	args := m.Called(ctx, userID, tokenHash)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteExpiredRevokedTokens(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	args := m.Called(ctx, jti, expiresAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	args := m.Called(ctx, jti)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) CreateWebhookSubscription(ctx context.Context, url, secret string, eventTypes []string) (*repository.WebhookSubscription, error) {
+	args := m.Called(ctx, url, secret, eventTypes)
+	return args.Get(0).(*repository.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockRepository) GetWebhookSubscription(ctx context.Context, id string) (*repository.WebhookSubscription, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockRepository) ListWebhookSubscriptions(ctx context.Context) ([]*repository.WebhookSubscription, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.WebhookSubscription), args.Error(1)
+}
+
+func (m *MockRepository) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) FanOutOutboxEvents(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*repository.WebhookDelivery, error) {
+	args := m.Called(ctx, now, leaseUntil, limit)
+	return args.Get(0).([]*repository.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockRepository) MarkWebhookDelivered(ctx context.Context, id string, deliveredAt time.Time) error {
+	args := m.Called(ctx, id, deliveredAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RetryWebhookDelivery(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, id, lastError, nextAttemptAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeadLetterWebhookDelivery(ctx context.Context, id string, lastError string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
 func (m *MockRepository) ExecTx(ctx context.Context, fn func(*sqlx.Tx) error) error {
 	args := m.Called(ctx)
 	return args.Error(1)
 }
 
+func (m *MockRepository) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (m *MockRepository) Ping(ctx context.Context) error {
+	args := m.Called(ctx)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetSchemaVersion(ctx context.Context) (*repository.SchemaVersion, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.SchemaVersion), args.Error(1)
+}
+
+func (m *MockRepository) ReserveIdempotencyKey(ctx context.Context, userID, key, requestHash string, createdAt, expiresAt time.Time) (bool, error) {
+	args := m.Called(ctx, userID, key, requestHash, createdAt, expiresAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) GetIdempotencyKey(ctx context.Context, userID, key string) (*repository.IdempotencyRecord, error) {
+	args := m.Called(ctx, userID, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.IdempotencyRecord), args.Error(1)
+}
+
+func (m *MockRepository) SaveIdempotencyResponse(ctx context.Context, userID, key string, statusCode int, contentType string, body []byte) error {
+	args := m.Called(ctx, userID, key, statusCode, contentType, body)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteIdempotencyKey(ctx context.Context, userID, key string) error {
+	args := m.Called(ctx, userID, key)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListCities(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockRepository) GetCity(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockRepository) CreateCity(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockRepository) DeleteCity(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SeedCities(ctx context.Context, names []string) error {
+	args := m.Called(ctx, names)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListProductTypes(ctx context.Context) ([]*repository.DictionaryEntry, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockRepository) ListPVZEmployees(ctx context.Context, PVZID string) ([]*repository.PVZEmployee, error) {
+	args := m.Called(ctx, PVZID)
+	return args.Get(0).([]*repository.PVZEmployee), args.Error(1)
+}
+
+func (m *MockRepository) AssignEmployee(ctx context.Context, PVZID, userID string) (*repository.PVZEmployee, error) {
+	args := m.Called(ctx, PVZID, userID)
+	return args.Get(0).(*repository.PVZEmployee), args.Error(1)
+}
+
+func (m *MockRepository) UnassignEmployee(ctx context.Context, PVZID, userID string) error {
+	args := m.Called(ctx, PVZID, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) IsEmployeeAssigned(ctx context.Context, PVZID, userID string) (bool, error) {
+	args := m.Called(ctx, PVZID, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) CreateAuditRecord(ctx context.Context, record *repository.AuditRecord) error {
+	args := m.Called(ctx, record)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListAuditRecords(ctx context.Context, filter repository.AuditFilter) ([]*repository.AuditRecord, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*repository.AuditRecord), args.Error(1)
+}
+
+// expectAudit ожидает запись в журнал аудита с указанным действием над сущностью
+func expectAudit(mockRepo *MockRepository, action, entityType, entityID string) {
+	mockRepo.On("CreateAuditRecord", mock.Anything, mock.MatchedBy(func(record *repository.AuditRecord) bool {
+		return record.Action == action && record.EntityType == entityType && record.EntityID == entityID
+	})).Return(nil).Once()
+}
+
+func (m *MockRepository) GetProductType(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+// captureAudit сохраняет в record запись аудита, переданную в репозиторий
+func captureAudit(mockRepo *MockRepository, record **repository.AuditRecord) {
+	mockRepo.On("CreateAuditRecord", mock.Anything, mock.AnythingOfType("*repository.AuditRecord")).
+		Run(func(args mock.Arguments) {
+			*record = args.Get(1).(*repository.AuditRecord)
+		}).
+		Return(nil).Once()
+}
+
+func assertAuditPayload(t *testing.T, expected interface{}, payload []byte) {
+	data, err := json.Marshal(expected)
+	assert.NoError(t, err)
+	assert.JSONEq(t, string(data), string(payload))
+}
+
+func (m *MockRepository) CreateProductType(ctx context.Context, name string) (*repository.DictionaryEntry, error) {
+	args := m.Called(ctx, name)
+	return args.Get(0).(*repository.DictionaryEntry), args.Error(1)
+}
+
+func (m *MockRepository) DeleteProductType(ctx context.Context, name string) error {
+	args := m.Called(ctx, name)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SeedProductTypes(ctx context.Context, names []string) error {
+	args := m.Called(ctx, names)
+	return args.Error(0)
+}
+
+func dictionaryEntries(names ...string) []*repository.DictionaryEntry {
+	entries := make([]*repository.DictionaryEntry, len(names))
+	for i, name := range names {
+		entries[i] = &repository.DictionaryEntry{Name: name}
+	}
+	return entries
+}
+
 func TestService_IsValidCity(t *testing.T) {
 	tests := []struct {
 		name     string
 		city     string
-		config   *config.Config
 		expected bool
 	}{
 		{
 			name:     "valid city",
 			city:     "Moscow",
-			config:   &config.Config{Cities: []string{"Moscow", "Saint Petersburg"}},
 			expected: true,
 		},
 		{
 			name:     "invalid city",
 			city:     "Paris",
-			config:   &config.Config{Cities: []string{"Moscow", "Saint Petersburg"}},
 			expected: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := &Service{config: tt.config}
-			assert.Equal(t, tt.expected, s.IsValidCity(tt.city))
+			mockRepo := &MockRepository{}
+			mockRepo.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Saint Petersburg"), nil)
+
+			s := NewService(mockRepo, &config.Config{})
+			valid, err := s.IsValidCity(context.Background(), tt.city)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, valid)
 		})
 	}
 }
@@ -117,31 +525,150 @@ func TestService_IsValidProductType(t *testing.T) {
 	tests := []struct {
 		name        string
 		productType string
-		config      *config.Config
 		expected    bool
 	}{
 		{
 			name:        "valid product type",
 			productType: "electronics",
-			config:      &config.Config{ProductTypes: []string{"electronics", "clothing"}},
 			expected:    true,
 		},
 		{
 			name:        "invalid product type",
 			productType: "food",
-			config:      &config.Config{ProductTypes: []string{"electronics", "clothing"}},
 			expected:    false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := &Service{config: tt.config}
-			assert.Equal(t, tt.expected, s.IsValidProductType(tt.productType))
+			mockRepo := &MockRepository{}
+			mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics", "clothing"), nil)
+
+			s := NewService(mockRepo, &config.Config{})
+			valid, err := s.IsValidProductType(context.Background(), tt.productType)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, valid)
+		})
+	}
+}
+
+func TestService_DictionaryCache(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow"), nil).Once()
+	mockRepo.On("CreateCity", mock.Anything, "Kazan").Return(&repository.DictionaryEntry{Name: "Kazan"}, nil)
+	expectAudit(mockRepo, auditActionCreate, auditEntityCity, "Kazan")
+	mockRepo.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Kazan"), nil).Once()
+
+	s := NewService(mockRepo, &config.Config{Dictionaries: config.DictionaryConfig{CacheTTL: time.Hour}})
+
+	valid, err := s.IsValidCity(context.Background(), "Kazan")
+	assert.NoError(t, err)
+	assert.False(t, valid)
+
+	// повторная проверка берется из кэша
+	valid, err = s.IsValidCity(context.Background(), "Moscow")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+
+	_, err = s.CreateCity(context.Background(), "Kazan")
+	assert.NoError(t, err)
+
+	valid, err = s.IsValidCity(context.Background(), "Kazan")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_CreateCity(t *testing.T) {
+	tests := []struct {
+		name        string
+		city        string
+		mockSetup   func(*MockRepository)
+		expectedErr error
+	}{
+		{
+			name: "successful creation",
+			city: " Kazan ",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("CreateCity", mock.Anything, "Kazan").Return(&repository.DictionaryEntry{Name: "Kazan"}, nil)
+				expectAudit(mr, auditActionCreate, auditEntityCity, "Kazan")
+			},
+		},
+		{
+			name:        "empty name",
+			city:        " ",
+			mockSetup:   func(mr *MockRepository) {},
+			expectedErr: ErrInvalidDictionaryEntry,
+		},
+		{
+			name: "already exists",
+			city: "Moscow",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("CreateCity", mock.Anything, "Moscow").
+					Return((*repository.DictionaryEntry)(nil), repository.ErrDictionaryEntryExists)
+			},
+			expectedErr: ErrDictionaryEntryExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			tt.mockSetup(mockRepo)
+
+			s := NewService(mockRepo, &config.Config{})
+			_, err := s.CreateCity(context.Background(), tt.city)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
 		})
 	}
 }
 
+func TestService_DeleteCity(t *testing.T) {
+	entry := &repository.DictionaryEntry{Name: "Moscow", CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetCity", mock.Anything, "Moscow").Return(entry, nil)
+	mockRepo.On("DeleteCity", mock.Anything, "Moscow").Return(nil)
+	var record *repository.AuditRecord
+	captureAudit(mockRepo, &record)
+
+	s := NewService(mockRepo, &config.Config{})
+	err := s.DeleteCity(context.Background(), "Moscow")
+
+	assert.NoError(t, err)
+	assert.Equal(t, auditActionDelete, record.Action)
+	assertAuditPayload(t, entry, record.Before)
+	assert.Nil(t, record.After)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_DeleteProductType_NotFound(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetProductType", mock.Anything, "food").Return(nil, repository.ErrDictionaryEntryNotFound)
+
+	s := NewService(mockRepo, &config.Config{})
+	err := s.DeleteProductType(context.Background(), "food")
+
+	assert.ErrorIs(t, err, ErrDictionaryEntryNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_SeedDictionaries(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("SeedCities", mock.Anything, []string{"Moscow"}).Return(nil)
+	mockRepo.On("SeedProductTypes", mock.Anything, []string{"electronics"}).Return(nil)
+
+	s := NewService(mockRepo, &config.Config{Cities: []string{"Moscow"}, ProductTypes: []string{"electronics"}})
+	err := s.SeedDictionaries(context.Background())
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
 func TestService_IsValidRole(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -193,10 +720,12 @@ func TestService_RegisterUser(t *testing.T) {
 			mockSetup: func(mr *MockRepository, hashedPassword string) {
 				mr.On("CreateUser", mock.Anything, "test@example.com", mock.AnythingOfType("string"), "employee").
 					Return(&repository.User{
+						ID:       employeeID,
 						Email:    "test@example.com",
 						Password: hashedPassword, // Возвращаем тот же хеш, что и получили
 						Role:     "employee",
 					}, nil)
+				expectAudit(mr, auditActionCreate, auditEntityUser, employeeID)
 			},
 			expectErr: false,
 		},
@@ -293,9 +822,11 @@ func TestService_CheckPassword(t *testing.T) {
 }
 
 func TestService_CreatePVZ(t *testing.T) {
+	lat := 55.75
 	tests := []struct {
 		name       string
 		city       string
+		location   repository.PVZLocation
 		config     *config.Config
 		mockSetup  func(*MockRepository)
 		expectErr  bool
@@ -304,27 +835,43 @@ func TestService_CreatePVZ(t *testing.T) {
 		{
 			name:   "successful creation",
 			city:   "Moscow",
-			config: &config.Config{Cities: []string{"Moscow", "Saint Petersburg"}},
+			config: &config.Config{},
 			mockSetup: func(mr *MockRepository) {
-				mr.On("CreatePVZ", mock.Anything, "Moscow").
-					Return(&repository.PVZ{}, nil)
+				mr.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Saint Petersburg"), nil)
+				mr.On("CreatePVZ", mock.Anything, "Moscow", repository.PVZLocation{}).
+					Return(&repository.PVZ{ID: "pvz1"}, nil)
+				expectAudit(mr, auditActionCreate, auditEntityPVZ, "pvz1")
 			},
 			expectErr: false,
 		},
 		{
-			name:       "invalid city",
-			city:       "Paris",
-			config:     &config.Config{Cities: []string{"Moscow", "Saint Petersburg"}},
-			mockSetup:  func(mr *MockRepository) {},
+			name:   "invalid city",
+			city:   "Paris",
+			config: &config.Config{},
+			mockSetup: func(mr *MockRepository) {
+				mr.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Saint Petersburg"), nil)
+			},
 			expectErr:  true,
 			errMessage: "invalid city: Paris",
 		},
+		{
+			name:     "latitude without longitude",
+			city:     "Moscow",
+			location: repository.PVZLocation{Latitude: &lat},
+			config:   &config.Config{},
+			mockSetup: func(mr *MockRepository) {
+				mr.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Saint Petersburg"), nil)
+			},
+			expectErr:  true,
+			errMessage: "latitude and longitude must be set together",
+		},
 		{
 			name:   "repository error",
 			city:   "Moscow",
-			config: &config.Config{Cities: []string{"Moscow", "Saint Petersburg"}},
+			config: &config.Config{},
 			mockSetup: func(mr *MockRepository) {
-				mr.On("CreatePVZ", mock.Anything, "Moscow").
+				mr.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Saint Petersburg"), nil)
+				mr.On("CreatePVZ", mock.Anything, "Moscow", repository.PVZLocation{}).
 					Return(&repository.PVZ{}, errors.New("repository error"))
 			},
 			expectErr:  true,
@@ -338,7 +885,7 @@ func TestService_CreatePVZ(t *testing.T) {
 			tt.mockSetup(mockRepo)
 
 			s := NewService(mockRepo, tt.config)
-			_, err := s.CreatePVZ(context.Background(), tt.city)
+			_, err := s.CreatePVZ(context.Background(), tt.city, tt.location)
 
 			if tt.expectErr {
 				assert.Error(t, err)
@@ -352,47 +899,100 @@ func TestService_CreatePVZ(t *testing.T) {
 }
 
 func TestService_CreateProduct(t *testing.T) {
+	barcode := "4601234567890"
+	zero := 0
 	tests := []struct {
-		name        string
-		receptionID string
-		productType string
-		config      *config.Config
-		mockSetup   func(*MockRepository)
-		expectErr   bool
-		errMessage  string
-	}{
+		name       string
+		pvzID      string
+		product    repository.NewProduct
+		config     *config.Config
+		mockSetup  func(*MockRepository)
+		expectErr  bool
+		errMessage string
+	}{
 		{
-			name:        "successful creation",
-			receptionID: "123",
-			productType: "electronics",
-			config:      &config.Config{ProductTypes: []string{"electronics", "clothing"}},
+			name:    "successful creation",
+			pvzID:   "123",
+			product: repository.NewProduct{Type: "electronics"},
+			config:  &config.Config{},
 			mockSetup: func(mr *MockRepository) {
-				mr.On("CreateProduct", mock.Anything, "123", "electronics").
-					Return(&repository.Product{}, nil)
+				mr.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+				mr.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics", "clothing"), nil)
+				mr.On("CreateProduct", mock.Anything, "123", repository.NewProduct{Type: "electronics"}).
+					Return(&repository.Product{ID: "product1"}, nil)
+				expectAudit(mr, auditActionCreate, auditEntityProduct, "product1")
 			},
 			expectErr: false,
 		},
 		{
-			name:        "invalid product type",
-			receptionID: "123",
-			productType: "food",
-			config:      &config.Config{ProductTypes: []string{"electronics", "clothing"}},
-			mockSetup:   func(mr *MockRepository) {},
-			expectErr:   true,
-			errMessage:  "invalid product type: food",
+			name:    "invalid product type",
+			pvzID:   "123",
+			product: repository.NewProduct{Type: "food"},
+			config:  &config.Config{},
+			mockSetup: func(mr *MockRepository) {
+				mr.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+				mr.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics", "clothing"), nil)
+			},
+			expectErr:  true,
+			errMessage: "invalid product type: food",
 		},
 		{
-			name:        "repository error",
-			receptionID: "123",
-			productType: "electronics",
-			config:      &config.Config{ProductTypes: []string{"electronics", "clothing"}},
+			name:  "invalid product details",
+			pvzID: "123",
+			product: repository.NewProduct{
+				Type:           "electronics",
+				ProductDetails: repository.ProductDetails{WeightGrams: &zero},
+			},
+			config: &config.Config{},
+			mockSetup: func(mr *MockRepository) {
+				mr.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+				mr.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics", "clothing"), nil)
+			},
+			expectErr:  true,
+			errMessage: "weight must be positive",
+		},
+		{
+			name:  "barcode already scanned",
+			pvzID: "123",
+			product: repository.NewProduct{
+				Type:           "electronics",
+				ProductDetails: repository.ProductDetails{Barcode: &barcode},
+			},
+			config: &config.Config{},
+			mockSetup: func(mr *MockRepository) {
+				mr.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+				mr.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics", "clothing"), nil)
+				mr.On("CreateProduct", mock.Anything, "123", mock.Anything).
+					Return((*repository.Product)(nil), fmt.Errorf("error creating product: %w", repository.ErrDuplicateBarcode))
+			},
+			expectErr:  true,
+			errMessage: ErrDuplicateBarcode.Error() + ": " + barcode,
+		},
+		{
+			name:    "repository error",
+			pvzID:   "123",
+			product: repository.NewProduct{Type: "electronics"},
+			config:  &config.Config{},
 			mockSetup: func(mr *MockRepository) {
-				mr.On("CreateProduct", mock.Anything, "123", "electronics").
+				mr.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+				mr.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics", "clothing"), nil)
+				mr.On("CreateProduct", mock.Anything, "123", repository.NewProduct{Type: "electronics"}).
 					Return(&repository.Product{}, errors.New("repository error"))
 			},
 			expectErr:  true,
 			errMessage: "repository error",
 		},
+		{
+			name:    "employee not assigned",
+			pvzID:   "123",
+			product: repository.NewProduct{Type: "electronics"},
+			config:  &config.Config{},
+			mockSetup: func(mr *MockRepository) {
+				mr.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(false, nil)
+			},
+			expectErr:  true,
+			errMessage: ErrEmployeeNotAssigned.Error(),
+		},
 	}
 
 	for _, tt := range tests {
@@ -401,7 +1001,7 @@ func TestService_CreateProduct(t *testing.T) {
 			tt.mockSetup(mockRepo)
 
 			s := NewService(mockRepo, tt.config)
-			_, err := s.CreateProduct(context.Background(), tt.receptionID, tt.productType)
+			_, err := s.CreateProduct(context.Background(), tt.pvzID, tt.product, employeeID)
 
 			if tt.expectErr {
 				assert.Error(t, err)
@@ -414,6 +1014,113 @@ func TestService_CreateProduct(t *testing.T) {
 	}
 }
 
+func TestService_CreateProducts(t *testing.T) {
+	newProducts := func(types ...string) []repository.NewProduct {
+		products := make([]repository.NewProduct, len(types))
+		for i, productType := range types {
+			products[i] = repository.NewProduct{Type: productType}
+		}
+		return products
+	}
+
+	t.Run("invalid types are reported per item", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+		mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics", "clothing"), nil)
+		mockRepo.On("CreateProducts", mock.Anything, "123", newProducts("electronics", "clothing")).
+			Return([]*repository.Product{{ID: "product1"}, {ID: "product2"}}, nil)
+		expectAudit(mockRepo, auditActionCreate, auditEntityProduct, "product1")
+		expectAudit(mockRepo, auditActionCreate, auditEntityProduct, "product2")
+
+		s := NewService(mockRepo, &config.Config{})
+		results, err := s.CreateProducts(context.Background(), "123", newProducts("electronics", "food", "clothing"), employeeID)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 3)
+		assert.Equal(t, "product1", results[0].Product.ID)
+		assert.EqualError(t, results[1].Err, "invalid product type: food")
+		assert.Equal(t, "product2", results[2].Product.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("already scanned barcodes are reported per item", func(t *testing.T) {
+		first, second := "111", "222"
+		products := []repository.NewProduct{
+			{Type: "electronics", ProductDetails: repository.ProductDetails{Barcode: &first}},
+			{Type: "electronics", ProductDetails: repository.ProductDetails{Barcode: &second}},
+		}
+		mockRepo := &MockRepository{}
+		mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+		mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics"), nil)
+		mockRepo.On("CreateProducts", mock.Anything, "123", products).
+			Return([]*repository.Product{{ID: "product1"}, nil}, nil)
+		expectAudit(mockRepo, auditActionCreate, auditEntityProduct, "product1")
+
+		s := NewService(mockRepo, &config.Config{})
+		results, err := s.CreateProducts(context.Background(), "123", products, employeeID)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, "product1", results[0].Product.ID)
+		assert.ErrorIs(t, results[1].Err, ErrDuplicateBarcode)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("nothing is inserted without valid types", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+		mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics"), nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		results, err := s.CreateProducts(context.Background(), "123", newProducts("food"), employeeID)
+
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Error(t, results[0].Err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		s := NewService(&MockRepository{}, &config.Config{})
+		_, err := s.CreateProducts(context.Background(), "123", nil, employeeID)
+		assert.ErrorIs(t, err, ErrEmptyProductBatch)
+	})
+
+	t.Run("too large batch", func(t *testing.T) {
+		s := NewService(&MockRepository{}, &config.Config{})
+		_, err := s.CreateProducts(context.Background(), "123", make([]repository.NewProduct, MaxProductBatchSize+1), employeeID)
+		assert.ErrorIs(t, err, ErrProductBatchTooLarge)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+		mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics"), nil)
+		mockRepo.On("CreateProducts", mock.Anything, "123", newProducts("electronics")).
+			Return([]*repository.Product(nil), errors.New("last reception is closed"))
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.CreateProducts(context.Background(), "123", newProducts("electronics"), employeeID)
+		assert.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestService_FindProductsByBarcode(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("ListProductsByBarcode", mock.Anything, "4601234567890").
+		Return([]*repository.Product{{ID: "product1"}}, nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	products, err := s.FindProductsByBarcode(context.Background(), "4601234567890")
+	assert.NoError(t, err)
+	assert.Len(t, products, 1)
+
+	_, err = s.FindProductsByBarcode(context.Background(), "")
+	assert.ErrorIs(t, err, ErrInvalidProductDetails)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestService_GetUserByEmail(t *testing.T) {
 	mockRepo := &MockRepository{}
 	mockRepo.On("GetUserByEmail", mock.Anything, "test@example.com").
@@ -428,44 +1135,209 @@ func TestService_GetUserByEmail(t *testing.T) {
 }
 
 func TestService_CloseReception(t *testing.T) {
+	before := &repository.Reception{ID: "rc1", PVZID: "123", Status: "in_progress"}
 	mockRepo := &MockRepository{}
+	mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+	mockRepo.On("GetLastReception", mock.Anything, "123").Return(before, nil)
 	mockRepo.On("CloseReception", mock.Anything, "123").
-		Return(&repository.Reception{ID: "123", Status: "close"}, nil)
+		Return(&repository.Reception{ID: "rc1", PVZID: "123", Status: "close"}, nil)
+	var record *repository.AuditRecord
+	captureAudit(mockRepo, &record)
 
 	s := NewService(mockRepo, &config.Config{})
-	reception, err := s.CloseReception(context.Background(), "123")
+	reception, err := s.CloseReception(context.Background(), "123", employeeID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "close", reception.Status)
+	assert.Equal(t, auditActionClose, record.Action)
+	assert.Equal(t, "rc1", record.EntityID)
+	assertAuditPayload(t, before, record.Before)
+	assertAuditPayload(t, reception, record.After)
 	mockRepo.AssertExpectations(t)
 }
 
 func TestService_DeleteProduct(t *testing.T) {
 	mockRepo := &MockRepository{}
+	mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
 	mockRepo.On("DeleteProduct", mock.Anything, "123").
 		Return(&repository.Product{ID: "123"}, nil)
+	expectAudit(mockRepo, auditActionDelete, auditEntityProduct, "123")
 
 	s := NewService(mockRepo, &config.Config{})
-	product, err := s.DeleteProduct(context.Background(), "123")
+	product, err := s.DeleteProduct(context.Background(), "123", employeeID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "123", product.ID)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestService_IssueProduct(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        string
+		mockSetup     func(*MockRepository)
+		expectedError error
+	}{
+		{
+			name:   "stored product is issued",
+			status: "stored",
+			mockSetup: func(m *MockRepository) {
+				m.On("UpdateProductStatus", mock.Anything, "123", "stored", "issued", employeeID).
+					Return(&repository.Product{ID: "123", Status: "issued"}, nil)
+				expectAudit(m, auditActionIssue, auditEntityProduct, "123")
+			},
+		},
+		{
+			name:          "accepted product cannot be issued",
+			status:        "accepted",
+			mockSetup:     func(m *MockRepository) {},
+			expectedError: ErrInvalidProductStatusTransition,
+		},
+		{
+			name:          "issued product cannot be issued again",
+			status:        "issued",
+			mockSetup:     func(m *MockRepository) {},
+			expectedError: ErrInvalidProductStatusTransition,
+		},
+		{
+			name:   "status changed concurrently",
+			status: "stored",
+			mockSetup: func(m *MockRepository) {
+				m.On("UpdateProductStatus", mock.Anything, "123", "stored", "issued", employeeID).
+					Return((*repository.Product)(nil), fmt.Errorf("error changing product status: %w", repository.ErrProductStatusConflict))
+			},
+			expectedError: ErrInvalidProductStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			mockRepo.On("GetProduct", mock.Anything, "123").
+				Return(&repository.Product{ID: "123", Status: tt.status}, nil)
+			tt.mockSetup(mockRepo)
+
+			s := NewService(mockRepo, &config.Config{})
+			product, err := s.IssueProduct(context.Background(), "123", employeeID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				assert.Nil(t, product)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "issued", product.Status)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ReturnProduct(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        string
+		expectedError error
+	}{
+		{
+			name:   "customer returns issued product",
+			status: "issued",
+		},
+		{
+			name:   "unclaimed product is returned to sender",
+			status: "stored",
+		},
+		{
+			name:          "returned product cannot be returned again",
+			status:        "returned",
+			expectedError: ErrInvalidProductStatusTransition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			mockRepo.On("GetProduct", mock.Anything, "123").
+				Return(&repository.Product{ID: "123", Status: tt.status}, nil)
+			if tt.expectedError == nil {
+				mockRepo.On("UpdateProductStatus", mock.Anything, "123", tt.status, "returned", employeeID).
+					Return(&repository.Product{ID: "123", Status: "returned"}, nil)
+				expectAudit(mockRepo, auditActionReturn, auditEntityProduct, "123")
+			}
+
+			s := NewService(mockRepo, &config.Config{})
+			product, err := s.ReturnProduct(context.Background(), "123", employeeID)
+
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "returned", product.Status)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_IssueProduct_NotFound(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetProduct", mock.Anything, "123").
+		Return((*repository.Product)(nil), repository.ErrProductNotFound)
+
+	s := NewService(mockRepo, &config.Config{})
+	_, err := s.IssueProduct(context.Background(), "123", employeeID)
+
+	assert.ErrorIs(t, err, ErrProductNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_GetProductHistory(t *testing.T) {
+	history := []*repository.ProductStatusChange{
+		{ProductID: "123", ToStatus: "stored"},
+		{ProductID: "123", ToStatus: "issued"},
+	}
+
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetProduct", mock.Anything, "123").
+		Return(&repository.Product{ID: "123", Status: "issued"}, nil)
+	mockRepo.On("ListProductStatusHistory", mock.Anything, "123").Return(history, nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	result, err := s.GetProductHistory(context.Background(), "123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, history, result)
+	mockRepo.AssertExpectations(t)
+}
+
 func TestService_CreateReception(t *testing.T) {
 	mockRepo := &MockRepository{}
+	mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
 	mockRepo.On("CreateReception", mock.Anything, "123").
 		Return(&repository.Reception{ID: "456", PVZID: "123"}, nil)
+	expectAudit(mockRepo, auditActionCreate, auditEntityReception, "456")
 
 	s := NewService(mockRepo, &config.Config{})
-	reception, err := s.CreateReception(context.Background(), "123")
+	reception, err := s.CreateReception(context.Background(), "123", employeeID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, "123", reception.PVZID)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestService_CreateReception_InProgress(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("IsEmployeeAssigned", mock.Anything, "123", employeeID).Return(true, nil)
+	mockRepo.On("CreateReception", mock.Anything, "123").
+		Return((*repository.Reception)(nil), fmt.Errorf("error creating reception: %w", repository.ErrReceptionInProgress))
+
+	s := NewService(mockRepo, &config.Config{})
+	_, err := s.CreateReception(context.Background(), "123", employeeID)
+
+	assert.ErrorIs(t, err, ErrReceptionInProgress)
+	assert.Contains(t, err.Error(), "123")
+	mockRepo.AssertExpectations(t)
+}
+
 func TestService_ListPVZ(t *testing.T) {
 	now := time.Now()
 	startDate := now.AddDate(0, -1, 0)
@@ -478,17 +1350,101 @@ func TestService_ListPVZ(t *testing.T) {
 	}
 
 	mockRepo := &MockRepository{}
-	mockRepo.On("ListPVZ", mock.Anything, &startDate, &endDate, 1, 10).
+	filter := repository.PVZFilter{StartDate: &startDate, EndDate: &endDate}
+	mockRepo.On("ListPVZ", mock.Anything, filter, 1, 10).
 		Return(expectedPVZs, nil)
 
 	s := NewService(mockRepo, &config.Config{})
-	pvzs, err := s.ListPVZ(context.Background(), &startDate, &endDate, 1, 10)
+	pvzs, err := s.ListPVZ(context.Background(), filter, 1, 10)
 
 	assert.NoError(t, err)
 	assert.Equal(t, expectedPVZs, pvzs)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestService_ListPVZ_InvalidFilter(t *testing.T) {
+	now := time.Now()
+	before := now.Add(-time.Hour)
+	unknownStatus := "unknown"
+
+	for name, filter := range map[string]repository.PVZFilter{
+		"start after end":          {StartDate: &now, EndDate: &before},
+		"unknown reception status": {ReceptionStatus: &unknownStatus},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+
+			s := NewService(mockRepo, &config.Config{})
+			_, err := s.ListPVZ(context.Background(), filter, 1, 10)
+			assert.ErrorIs(t, err, ErrInvalidPVZFilter)
+
+			_, _, err = s.ListPVZByCursor(context.Background(), filter, "", 10)
+			assert.ErrorIs(t, err, ErrInvalidPVZFilter)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListPVZByCursor(t *testing.T) {
+	registered := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	ids := []string{
+		"c9bf9e57-1685-4c89-bafb-ff5af830be8a",
+		"7f3e0c2a-5d1b-4b8e-9a6f-2c4d8e1f0a3b",
+		"1b9d6bcd-bbfd-4b2d-9b5d-ab8dfbbd4bed",
+	}
+	pvzs := []*repository.PVZWithReceptions{
+		{PVZ: &repository.PVZ{ID: ids[0], RegistrationDate: registered}},
+		{PVZ: &repository.PVZ{ID: ids[1], RegistrationDate: registered}},
+		{PVZ: &repository.PVZ{ID: ids[2], RegistrationDate: registered.AddDate(0, 0, -1)}},
+	}
+
+	t.Run("first page with next cursor", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("ListPVZAfter", mock.Anything, repository.PVZFilter{}, (*repository.PVZCursor)(nil), 3).
+			Return(pvzs, nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		result, nextCursor, err := s.ListPVZByCursor(context.Background(), repository.PVZFilter{}, "", 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, pvzs[:2], result)
+		assert.NotEmpty(t, nextCursor)
+		mockRepo.AssertExpectations(t)
+
+		after, err := decodePVZCursor(nextCursor)
+		assert.NoError(t, err)
+		assert.Equal(t, &repository.PVZCursor{RegistrationDate: registered, ID: ids[1]}, after)
+	})
+
+	t.Run("last page", func(t *testing.T) {
+		cursor := encodePVZCursor(&repository.PVZCursor{RegistrationDate: registered, ID: ids[1]})
+		mockRepo := &MockRepository{}
+		mockRepo.On("ListPVZAfter", mock.Anything, repository.PVZFilter{},
+			&repository.PVZCursor{RegistrationDate: registered, ID: ids[1]}, 3).
+			Return(pvzs[2:], nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		result, nextCursor, err := s.ListPVZByCursor(context.Background(), repository.PVZFilter{}, cursor, 2)
+
+		assert.NoError(t, err)
+		assert.Equal(t, pvzs[2:], result)
+		assert.Empty(t, nextCursor)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+
+		s := NewService(mockRepo, &config.Config{})
+		notUUID := encodePVZCursor(&repository.PVZCursor{RegistrationDate: registered, ID: "2"})
+		for _, cursor := range []string{"not base64!", "bm90IGpzb24", "e30", notUUID} {
+			_, _, err := s.ListPVZByCursor(context.Background(), repository.PVZFilter{}, cursor, 2)
+			assert.ErrorIs(t, err, ErrInvalidCursor, cursor)
+		}
+		mockRepo.AssertExpectations(t)
+	})
+}
+
 func TestService_ListAllPVZ(t *testing.T) {
 	expectedPVZs := []*repository.PVZ{
 		{ID: "1", City: "Moscow"},
@@ -506,3 +1462,1118 @@ func TestService_ListAllPVZ(t *testing.T) {
 	assert.Equal(t, expectedPVZs, pvzs)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestService_IssueTokens(t *testing.T) {
+	user := &repository.User{ID: "user123", Email: "test@example.com", Role: "employee"}
+
+	mockRepo := &MockRepository{}
+	mockRepo.On("CreateRefreshToken", mock.Anything, "user123", mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+		Return(&repository.RefreshToken{}, nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	tokens, err := s.IssueTokens(context.Background(), user)
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, tokens.AccessToken)
+	assert.NotEmpty(t, tokens.RefreshToken)
+
+	claims, err := utils.ParseJWT(tokens.AccessToken)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, claims["jti"])
+	mockRepo.AssertCalled(t, "CreateRefreshToken", mock.Anything, "user123", utils.HashToken(tokens.RefreshToken), mock.AnythingOfType("time.Time"))
+}
+
+func TestService_RefreshTokens(t *testing.T) {
+	revokedAt := time.Now()
+	tokenHash := utils.HashToken("refresh")
+
+	tests := []struct {
+		name      string
+		mockSetup func(*MockRepository)
+		expectErr error
+	}{
+		{
+			name: "successful rotation",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("GetRefreshToken", mock.Anything, tokenHash).
+					Return(&repository.RefreshToken{UserID: "user123"}, nil)
+				mr.On("GetUserByID", mock.Anything, "user123").
+					Return(&repository.User{ID: "user123", Role: "employee"}, nil)
+				mr.On("RotateRefreshToken", mock.Anything, tokenHash, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+					Return(&repository.RefreshToken{UserID: "user123"}, nil)
+			},
+		},
+		{
+			name: "unknown token",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("GetRefreshToken", mock.Anything, tokenHash).
+					Return((*repository.RefreshToken)(nil), repository.ErrRefreshTokenNotFound)
+			},
+			expectErr: ErrInvalidRefreshToken,
+		},
+		{
+			name: "reused token revokes all sessions",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("GetRefreshToken", mock.Anything, tokenHash).
+					Return(&repository.RefreshToken{UserID: "user123", RevokedAt: &revokedAt}, nil)
+				mr.On("RevokeUserRefreshTokens", mock.Anything, "user123").Return(nil)
+			},
+			expectErr: ErrInvalidRefreshToken,
+		},
+		{
+			name: "expired token",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("GetRefreshToken", mock.Anything, tokenHash).
+					Return(&repository.RefreshToken{UserID: "user123"}, nil)
+				mr.On("GetUserByID", mock.Anything, "user123").
+					Return(&repository.User{ID: "user123", Role: "employee"}, nil)
+				mr.On("RotateRefreshToken", mock.Anything, tokenHash, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).
+					Return((*repository.RefreshToken)(nil), fmt.Errorf("error rotating refresh token: %w", repository.ErrRefreshTokenInvalid))
+			},
+			expectErr: ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			tt.mockSetup(mockRepo)
+
+			s := NewService(mockRepo, &config.Config{})
+			tokens, err := s.RefreshTokens(context.Background(), "refresh")
+
+			if tt.expectErr != nil {
+				assert.ErrorIs(t, err, tt.expectErr)
+			} else {
+				assert.NoError(t, err)
+				assert.NotEmpty(t, tokens.AccessToken)
+				assert.NotEqual(t, "refresh", tokens.RefreshToken)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_Logout(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute)
+
+	mockRepo := &MockRepository{}
+	mockRepo.On("RevokeAccessToken", mock.Anything, "jti123", expiresAt).Return(nil)
+	mockRepo.On("RevokeRefreshToken", mock.Anything, employeeID, utils.HashToken("refresh")).Return(nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	err := s.Logout(context.Background(), employeeID, "jti123", expiresAt, "refresh")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_Logout_DummyUser(t *testing.T) {
+	expiresAt := time.Now().Add(time.Minute)
+
+	mockRepo := &MockRepository{}
+	mockRepo.On("RevokeAccessToken", mock.Anything, "jti123", expiresAt).Return(nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	err := s.Logout(context.Background(), "dummy_id", "jti123", expiresAt, "refresh")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "RevokeRefreshToken", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_CreateWebhookSubscription(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		eventTypes  []string
+		expectError bool
+	}{
+		{
+			name:       "valid subscription",
+			url:        "https://example.com/hook",
+			eventTypes: []string{repository.EventPVZCreated, repository.EventReceptionClosed},
+		},
+		{
+			name: "all events",
+			url:  "http://example.com/hook",
+		},
+		{
+			name:        "invalid url",
+			url:         "example.com/hook",
+			expectError: true,
+		},
+		{
+			name:        "unknown event type",
+			url:         "https://example.com/hook",
+			eventTypes:  []string{"PVZDeleted"},
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			if !tt.expectError {
+				mockRepo.On("CreateWebhookSubscription", mock.Anything, tt.url, mock.AnythingOfType("string"), tt.eventTypes).
+					Return(&repository.WebhookSubscription{ID: "1", URL: tt.url}, nil)
+				expectAudit(mockRepo, auditActionCreate, auditEntityWebhookSubscription, "1")
+			}
+
+			s := NewService(mockRepo, &config.Config{})
+			subscription, err := s.CreateWebhookSubscription(context.Background(), tt.url, tt.eventTypes)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, ErrInvalidWebhookSubscription)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.url, subscription.URL)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeleteWebhookSubscription(t *testing.T) {
+	subscription := &repository.WebhookSubscription{ID: "1", URL: "https://example.com/hook", Secret: "secret", EventTypes: []string{repository.EventReceptionClosed}}
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetWebhookSubscription", mock.Anything, "1").Return(subscription, nil)
+	mockRepo.On("DeleteWebhookSubscription", mock.Anything, "1").Return(nil)
+	var record *repository.AuditRecord
+	captureAudit(mockRepo, &record)
+
+	s := NewService(mockRepo, &config.Config{})
+	err := s.DeleteWebhookSubscription(context.Background(), "1")
+
+	assert.NoError(t, err)
+	assertAuditPayload(t, webhookSubscriptionAuditPayload(subscription), record.Before)
+	assert.NotContains(t, string(record.Before), "secret")
+	assert.Nil(t, record.After)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_DeleteWebhookSubscription_NotFound(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetWebhookSubscription", mock.Anything, "1").Return(nil, repository.ErrWebhookSubscriptionNotFound)
+
+	s := NewService(mockRepo, &config.Config{})
+	err := s.DeleteWebhookSubscription(context.Background(), "1")
+
+	assert.ErrorIs(t, err, ErrWebhookSubscriptionNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_GetPVZ_NotFound(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetPVZ", mock.Anything, "1").Return((*repository.PVZWithReceptions)(nil), repository.ErrPVZNotFound)
+
+	s := NewService(mockRepo, &config.Config{})
+	_, err := s.GetPVZ(context.Background(), "1")
+
+	assert.ErrorIs(t, err, ErrPVZNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_UpdatePVZ(t *testing.T) {
+	kazan := "Kazan"
+	tests := []struct {
+		name        string
+		city        string
+		mockSetup   func(*MockRepository)
+		expectedErr error
+		errMessage  string
+	}{
+		{
+			name: "successful update",
+			city: "Kazan",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Kazan"), nil)
+				mr.On("GetPVZ", mock.Anything, "1").Return(&repository.PVZWithReceptions{PVZ: &repository.PVZ{ID: "1", City: "Moscow"}}, nil)
+				mr.On("UpdatePVZ", mock.Anything, "1", repository.PVZUpdate{City: &kazan}).Return(&repository.PVZ{ID: "1", City: "Kazan"}, nil)
+				expectAudit(mr, auditActionUpdate, auditEntityPVZ, "1")
+			},
+		},
+		{
+			name: "invalid city",
+			city: "Paris",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Kazan"), nil)
+			},
+			errMessage: "invalid city: Paris",
+		},
+		{
+			name: "inactive pvz",
+			city: "Kazan",
+			mockSetup: func(mr *MockRepository) {
+				mr.On("ListCities", mock.Anything).Return(dictionaryEntries("Moscow", "Kazan"), nil)
+				mr.On("GetPVZ", mock.Anything, "1").Return(&repository.PVZWithReceptions{PVZ: &repository.PVZ{ID: "1", Status: "inactive"}}, nil)
+				mr.On("UpdatePVZ", mock.Anything, "1", repository.PVZUpdate{City: &kazan}).Return((*repository.PVZ)(nil), repository.ErrPVZInactive)
+			},
+			expectedErr: ErrPVZInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			tt.mockSetup(mockRepo)
+
+			s := NewService(mockRepo, &config.Config{})
+			pvz, err := s.UpdatePVZ(context.Background(), "1", repository.PVZUpdate{City: &tt.city})
+
+			switch {
+			case tt.expectedErr != nil:
+				assert.ErrorIs(t, err, tt.expectedErr)
+			case tt.errMessage != "":
+				assert.EqualError(t, err, tt.errMessage)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tt.city, pvz.City)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_ListNearbyPVZ(t *testing.T) {
+	tests := []struct {
+		name      string
+		lat, lon  float64
+		radius    float64
+		mockSetup func(*MockRepository)
+		expectErr bool
+	}{
+		{
+			name:   "successful search",
+			lat:    55.75,
+			lon:    37.61,
+			radius: 1000,
+			mockSetup: func(mr *MockRepository) {
+				mr.On("ListNearbyPVZ", mock.Anything, 55.75, 37.61, 1000.0, 10).
+					Return([]*repository.NearbyPVZ{{PVZ: repository.PVZ{ID: "1"}, Distance: 120}}, nil)
+			},
+		},
+		{
+			name:      "invalid latitude",
+			lat:       91,
+			lon:       37.61,
+			radius:    1000,
+			mockSetup: func(mr *MockRepository) {},
+			expectErr: true,
+		},
+		{
+			name:      "radius too large",
+			lat:       55.75,
+			lon:       37.61,
+			radius:    maxNearbyRadius + 1,
+			mockSetup: func(mr *MockRepository) {},
+			expectErr: true,
+		},
+		{
+			name:      "zero radius",
+			lat:       55.75,
+			lon:       37.61,
+			radius:    0,
+			mockSetup: func(mr *MockRepository) {},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			tt.mockSetup(mockRepo)
+
+			s := NewService(mockRepo, &config.Config{})
+			nearby, err := s.ListNearbyPVZ(context.Background(), tt.lat, tt.lon, tt.radius, 10)
+
+			if tt.expectErr {
+				assert.ErrorIs(t, err, ErrInvalidLocation)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, nearby, 1)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_DeactivatePVZ_ReceptionInProgress(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetPVZ", mock.Anything, "1").Return(&repository.PVZWithReceptions{PVZ: &repository.PVZ{ID: "1", Status: "active"}}, nil)
+	mockRepo.On("DeactivatePVZ", mock.Anything, "1").Return((*repository.PVZ)(nil), repository.ErrReceptionInProgress)
+
+	s := NewService(mockRepo, &config.Config{})
+	_, err := s.DeactivatePVZ(context.Background(), "1")
+
+	assert.ErrorIs(t, err, ErrReceptionInProgress)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_CreateReception_InactivePVZ(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("IsEmployeeAssigned", mock.Anything, "1", employeeID).Return(true, nil)
+	mockRepo.On("CreateReception", mock.Anything, "1").Return((*repository.Reception)(nil), repository.ErrPVZInactive)
+
+	s := NewService(mockRepo, &config.Config{})
+	_, err := s.CreateReception(context.Background(), "1", employeeID)
+
+	assert.ErrorIs(t, err, ErrPVZInactive)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_CreateReception_NotAssigned(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("IsEmployeeAssigned", mock.Anything, "1", employeeID).Return(false, nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	_, err := s.CreateReception(context.Background(), "1", employeeID)
+
+	assert.ErrorIs(t, err, ErrEmployeeNotAssigned)
+	mockRepo.AssertNotCalled(t, "CreateReception", mock.Anything, "1")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_CreateReception_DummyUser(t *testing.T) {
+	t.Run("without pvz", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.CreateReception(context.Background(), "1", "dummy_id")
+
+		assert.ErrorIs(t, err, ErrEmployeeNotAssigned)
+		mockRepo.AssertNotCalled(t, "IsEmployeeAssigned", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("other pvz", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "dummy_id", "pvz_id": "2"})
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.CreateReception(ctx, "1", "dummy_id")
+
+		assert.ErrorIs(t, err, ErrEmployeeNotAssigned)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("pvz from token", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("CreateReception", mock.Anything, "1").Return(&repository.Reception{ID: "rc1", PVZID: "1"}, nil)
+		expectAudit(mockRepo, auditActionCreate, auditEntityReception, "rc1")
+		ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "dummy_id", "pvz_id": "1"})
+
+		s := NewService(mockRepo, &config.Config{})
+		rc, err := s.CreateReception(ctx, "1", "dummy_id")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "rc1", rc.ID)
+		mockRepo.AssertNotCalled(t, "IsEmployeeAssigned", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestService_AssignEmployee(t *testing.T) {
+	tests := []struct {
+		name        string
+		repoErr     error
+		expectedErr error
+	}{
+		{
+			name: "successful assignment",
+		},
+		{
+			name:        "user not found",
+			repoErr:     repository.ErrUserNotFound,
+			expectedErr: ErrUserNotFound,
+		},
+		{
+			name:        "user is not employee",
+			repoErr:     repository.ErrUserNotEmployee,
+			expectedErr: ErrUserNotEmployee,
+		},
+		{
+			name:        "already assigned",
+			repoErr:     fmt.Errorf("error assigning employee: %w", repository.ErrAssignmentExists),
+			expectedErr: ErrAssignmentExists,
+		},
+		{
+			name:        "pvz not found",
+			repoErr:     repository.ErrPVZNotFound,
+			expectedErr: ErrPVZNotFound,
+		},
+		{
+			name:        "pvz inactive",
+			repoErr:     repository.ErrPVZInactive,
+			expectedErr: ErrPVZInactive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			employee := &repository.PVZEmployee{PVZID: "1", UserID: employeeID}
+			if tt.repoErr != nil {
+				employee = nil
+			}
+			mockRepo.On("AssignEmployee", mock.Anything, "1", employeeID).Return(employee, tt.repoErr)
+			if tt.repoErr == nil {
+				expectAudit(mockRepo, auditActionAssign, auditEntityPVZ, "1")
+			}
+
+			s := NewService(mockRepo, &config.Config{})
+			result, err := s.AssignEmployee(context.Background(), "1", employeeID)
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, employeeID, result.UserID)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestService_UnassignEmployee_NotFound(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("UnassignEmployee", mock.Anything, "1", employeeID).Return(repository.ErrAssignmentNotFound)
+
+	s := NewService(mockRepo, &config.Config{})
+	err := s.UnassignEmployee(context.Background(), "1", employeeID)
+
+	assert.ErrorIs(t, err, ErrAssignmentNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_RecordAudit(t *testing.T) {
+	mockRepo := &MockRepository{}
+	mockRepo.On("CreateWebhookSubscription", mock.Anything, "https://example.com/hook", mock.AnythingOfType("string"), []string(nil)).
+		Return(&repository.WebhookSubscription{ID: "1", URL: "https://example.com/hook", Secret: "secret"}, nil)
+
+	var record *repository.AuditRecord
+	mockRepo.On("CreateAuditRecord", mock.Anything, mock.AnythingOfType("*repository.AuditRecord")).
+		Run(func(args mock.Arguments) {
+			record = args.Get(1).(*repository.AuditRecord)
+		}).
+		Return(errors.New("db error"))
+
+	ctx := context.WithValue(context.Background(), "user", jwt.MapClaims{"user_id": "moderator1", "role": "moderator"})
+	s := NewService(mockRepo, &config.Config{})
+	subscription, err := s.CreateWebhookSubscription(ctx, "https://example.com/hook", nil)
+
+	// изменение и запись журнала выполняются в одной транзакции, ошибка журнала отменяет изменение
+	assert.EqualError(t, err, "db error")
+	assert.Nil(t, subscription)
+	assert.Equal(t, "moderator1", *record.ActorID)
+	assert.Equal(t, "moderator", *record.ActorRole)
+	assert.Nil(t, record.Before)
+	assert.NotContains(t, string(record.After), "secret")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_BeginIdempotentRequest(t *testing.T) {
+	statusCode := 201
+	tests := []struct {
+		name           string
+		reserved       bool
+		record         *repository.IdempotencyRecord
+		getErr         error
+		expectedRecord bool
+		expectedErr    error
+	}{
+		{
+			name:     "new key",
+			reserved: true,
+		},
+		{
+			name:           "replay",
+			record:         &repository.IdempotencyRecord{RequestHash: "hash", StatusCode: &statusCode},
+			expectedRecord: true,
+		},
+		{
+			name:        "different request",
+			record:      &repository.IdempotencyRecord{RequestHash: "other", StatusCode: &statusCode},
+			expectedErr: ErrIdempotencyKeyMismatch,
+		},
+		{
+			name:        "in progress",
+			record:      &repository.IdempotencyRecord{RequestHash: "hash"},
+			expectedErr: ErrIdempotencyKeyInProgress,
+		},
+		{
+			name:        "released concurrently",
+			getErr:      repository.ErrIdempotencyKeyNotFound,
+			expectedErr: ErrIdempotencyKeyInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			mockRepo.On("ReserveIdempotencyKey", mock.Anything, employeeID, "key1", "hash", mock.AnythingOfType("time.Time"), mock.AnythingOfType("time.Time")).
+				Return(tt.reserved, nil)
+			if !tt.reserved {
+				mockRepo.On("GetIdempotencyKey", mock.Anything, employeeID, "key1").Return(tt.record, tt.getErr)
+			}
+
+			s := NewService(mockRepo, &config.Config{Idempotency: config.IdempotencyConfig{TTL: time.Hour}})
+			record, err := s.BeginIdempotentRequest(context.Background(), employeeID, "key1", "hash")
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedRecord, record != nil)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReconcileProducts(t *testing.T) {
+	str := func(s string) *string { return &s }
+	product := func(id, productType string, barcode *string, minute int) *repository.Product {
+		return &repository.Product{
+			ID:             id,
+			Type:           productType,
+			ReceptionDate:  time.Date(2025, 1, 1, 0, minute, 0, 0, time.UTC),
+			ProductDetails: repository.ProductDetails{Barcode: barcode},
+		}
+	}
+
+	items := []*repository.ManifestItem{
+		{Barcode: str("111"), Quantity: 1},
+		{Barcode: str("222"), Quantity: 1},
+		{Type: str("обувь"), Quantity: 2},
+		{Type: str("одежда"), Quantity: 1},
+		{Type: str("обувь"), Quantity: 1},
+	}
+	products := []*repository.Product{
+		product("5", "электроника", nil, 5),
+		product("1", "электроника", str("111"), 1),
+		product("2", "обувь", nil, 2),
+		product("3", "обувь", str("333"), 3),
+		product("4", "электроника", str("444"), 4),
+		product("6", "электроника", nil, 6),
+	}
+
+	report := reconcileProducts(items, products)
+
+	assert.Equal(t, []*repository.ManifestItem{
+		{Barcode: str("111"), Type: str("электроника"), Quantity: 1},
+		{Type: str("обувь"), Quantity: 2},
+	}, report.Matched)
+	assert.Equal(t, []*repository.ManifestItem{
+		{Barcode: str("222"), Quantity: 1},
+		{Type: str("обувь"), Quantity: 1},
+		{Type: str("одежда"), Quantity: 1},
+	}, report.Missing)
+	assert.Equal(t, []*repository.ManifestItem{
+		{Barcode: str("444"), Type: str("электроника"), Quantity: 1},
+		{Type: str("электроника"), Quantity: 2},
+	}, report.Extra)
+	assert.True(t, report.HasDiscrepancies())
+
+	empty := reconcileProducts(nil, nil)
+	assert.NotNil(t, empty.Matched)
+	assert.NotNil(t, empty.Missing)
+	assert.NotNil(t, empty.Extra)
+	assert.False(t, empty.HasDiscrepancies())
+}
+
+func TestReconcileOnClose(t *testing.T) {
+	productType := "обувь"
+	acknowledgedBy := employeeID
+	manifest := &repository.ReceptionManifest{
+		ReceptionID:          "rc1",
+		BlockOnDiscrepancies: true,
+		Items:                []*repository.ManifestItem{{Type: &productType, Quantity: 2}},
+	}
+	products := []*repository.Product{{ID: "p1", Type: productType}}
+	acknowledged := func(products []*repository.Product) *repository.Reconciliation {
+		reconciliation := buildReconciliation(manifest, products, nil)
+		reconciliation.AcknowledgedBy = &acknowledgedBy
+		return reconciliation
+	}
+
+	t.Run("Blocks unacknowledged discrepancies", func(t *testing.T) {
+		_, err := reconcileOnClose(manifest, products, nil)
+		assert.ErrorIs(t, err, ErrUnacknowledgedDiscrepancies)
+	})
+
+	t.Run("Keeps acknowledgement of the same report", func(t *testing.T) {
+		reconciliation, err := reconcileOnClose(manifest, products, acknowledged(products))
+		assert.NoError(t, err)
+		assert.True(t, reconciliation.HasDiscrepancies)
+		assert.Equal(t, &acknowledgedBy, reconciliation.AcknowledgedBy)
+	})
+
+	t.Run("Drops acknowledgement of a changed report", func(t *testing.T) {
+		previous := acknowledged(nil)
+		_, err := reconcileOnClose(manifest, products, previous)
+		assert.ErrorIs(t, err, ErrUnacknowledgedDiscrepancies)
+	})
+
+	t.Run("Does not block without discrepancies", func(t *testing.T) {
+		reconciliation, err := reconcileOnClose(manifest, append(products, &repository.Product{ID: "p2", Type: productType}), nil)
+		assert.NoError(t, err)
+		assert.False(t, reconciliation.HasDiscrepancies)
+	})
+}
+
+func TestService_SetReceptionManifest(t *testing.T) {
+	productType := "обувь"
+	unknownType := "мебель"
+	barcode := "4601234567890"
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", PVZID: "pvz1", Status: "in_progress"}, nil)
+		mockRepo.On("IsEmployeeAssigned", mock.Anything, "pvz1", employeeID).Return(true, nil)
+		mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries(productType), nil)
+		mockRepo.On("SaveReceptionManifest", mock.Anything, mock.MatchedBy(func(manifest *repository.ReceptionManifest) bool {
+			return manifest.ReceptionID == "rc1" && manifest.BlockOnDiscrepancies && len(manifest.Items) == 2
+		})).Return(nil)
+		expectAudit(mockRepo, auditActionUploadManifest, auditEntityReception, "rc1")
+
+		s := NewService(mockRepo, &config.Config{})
+		manifest, err := s.SetReceptionManifest(context.Background(), "rc1", []*repository.ManifestItem{
+			{Barcode: &barcode, Quantity: 1},
+			{Type: &productType, Quantity: 3},
+		}, true, employeeID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, employeeID, *manifest.UploadedBy)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Closed reception", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", PVZID: "pvz1", Status: "close"}, nil)
+		mockRepo.On("IsEmployeeAssigned", mock.Anything, "pvz1", employeeID).Return(true, nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.SetReceptionManifest(context.Background(), "rc1", []*repository.ManifestItem{{Type: &productType, Quantity: 1}}, false, employeeID)
+
+		assert.ErrorIs(t, err, ErrReceptionClosed)
+		mockRepo.AssertNotCalled(t, "SaveReceptionManifest", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Reception not found", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(nil, repository.ErrReceptionNotFound)
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.SetReceptionManifest(context.Background(), "rc1", []*repository.ManifestItem{{Type: &productType, Quantity: 1}}, false, employeeID)
+
+		assert.ErrorIs(t, err, ErrReceptionNotFound)
+	})
+
+	invalid := []struct {
+		name  string
+		items []*repository.ManifestItem
+	}{
+		{name: "Empty manifest", items: nil},
+		{name: "Non-positive quantity", items: []*repository.ManifestItem{{Type: &productType, Quantity: 0}}},
+		{name: "Neither barcode nor type", items: []*repository.ManifestItem{{Quantity: 1}}},
+		{name: "Unknown type", items: []*repository.ManifestItem{{Type: &unknownType, Quantity: 1}}},
+		{name: "Barcode quantity", items: []*repository.ManifestItem{{Barcode: &barcode, Quantity: 2}}},
+		{name: "Duplicate barcode", items: []*repository.ManifestItem{{Barcode: &barcode, Quantity: 1}, {Barcode: &barcode, Quantity: 1}}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", PVZID: "pvz1", Status: "in_progress"}, nil)
+			mockRepo.On("IsEmployeeAssigned", mock.Anything, "pvz1", employeeID).Return(true, nil)
+			mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries(productType), nil)
+
+			s := NewService(mockRepo, &config.Config{})
+			_, err := s.SetReceptionManifest(context.Background(), "rc1", tt.items, false, employeeID)
+
+			assert.ErrorIs(t, err, ErrInvalidManifest)
+			mockRepo.AssertNotCalled(t, "SaveReceptionManifest", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_GetReconciliation(t *testing.T) {
+	productType := "обувь"
+
+	t.Run("Closed reception returns saved reconciliation", func(t *testing.T) {
+		saved := &repository.Reconciliation{ReceptionID: "rc1", HasDiscrepancies: true}
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", Status: "close"}, nil)
+		mockRepo.On("GetReconciliation", mock.Anything, "rc1").Return(saved, nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		reconciliation, err := s.GetReconciliation(context.Background(), "rc1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, saved, reconciliation)
+	})
+
+	t.Run("Closed reception without manifest", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", Status: "close"}, nil)
+		mockRepo.On("GetReconciliation", mock.Anything, "rc1").Return(nil, repository.ErrReconciliationNotFound)
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.GetReconciliation(context.Background(), "rc1")
+
+		assert.ErrorIs(t, err, ErrReconciliationNotFound)
+	})
+
+	t.Run("Open reception returns preview", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", Status: "in_progress"}, nil)
+		mockRepo.On("GetReconciliation", mock.Anything, "rc1").Return(nil, repository.ErrReconciliationNotFound)
+		mockRepo.On("GetReceptionManifest", mock.Anything, "rc1").Return(&repository.ReceptionManifest{
+			ReceptionID: "rc1",
+			Items:       []*repository.ManifestItem{{Type: &productType, Quantity: 2}},
+		}, nil)
+		mockRepo.On("ListProducts", mock.Anything).Return([]*repository.Product{{ID: "p1", Type: productType}}, nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		reconciliation, err := s.GetReconciliation(context.Background(), "rc1")
+
+		assert.NoError(t, err)
+		assert.True(t, reconciliation.HasDiscrepancies)
+		assert.Equal(t, []*repository.ManifestItem{{Type: &productType, Quantity: 1}}, reconciliation.Report.Missing)
+		mockRepo.AssertNotCalled(t, "SaveReconciliation", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_AcknowledgeReconciliation(t *testing.T) {
+	productType := "обувь"
+	mockRepo := &MockRepository{}
+	mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", PVZID: "pvz1", Status: "in_progress"}, nil)
+	mockRepo.On("IsEmployeeAssigned", mock.Anything, "pvz1", employeeID).Return(true, nil)
+	mockRepo.On("GetReceptionManifest", mock.Anything, "rc1").Return(&repository.ReceptionManifest{
+		ReceptionID: "rc1",
+		Items:       []*repository.ManifestItem{{Type: &productType, Quantity: 2}},
+	}, nil)
+	mockRepo.On("ListProducts", mock.Anything).Return([]*repository.Product{}, nil)
+	mockRepo.On("SaveReconciliation", mock.Anything, mock.MatchedBy(func(reconciliation *repository.Reconciliation) bool {
+		return reconciliation.ReceptionID == "rc1" && *reconciliation.AcknowledgedBy == employeeID && reconciliation.AcknowledgedAt != nil
+	})).Return(nil)
+	expectAudit(mockRepo, auditActionAcknowledge, auditEntityReception, "rc1")
+
+	s := NewService(mockRepo, &config.Config{})
+	reconciliation, err := s.AcknowledgeReconciliation(context.Background(), "rc1", employeeID)
+
+	assert.NoError(t, err)
+	assert.True(t, reconciliation.HasDiscrepancies)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_ReceptionsReport(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Defaults to daily report and drops duplicate dimensions", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		expected := repository.ReportFilter{
+			From:    from,
+			To:      to,
+			Period:  "day",
+			GroupBy: []string{repository.ReportDimensionPVZ},
+		}
+		rows := []*repository.ReceptionReportRow{{Period: from, Receptions: 2}}
+		mockRepo.On("ReceptionsReport", mock.Anything, expected).Return(rows, nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		result, err := s.ReceptionsReport(context.Background(), repository.ReportFilter{
+			From:    from,
+			To:      to,
+			GroupBy: []string{repository.ReportDimensionPVZ, repository.ReportDimensionPVZ},
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, rows, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	productType := "обувь"
+	invalid := []struct {
+		name   string
+		filter repository.ReportFilter
+	}{
+		{name: "Missing dates", filter: repository.ReportFilter{}},
+		{name: "Reversed dates", filter: repository.ReportFilter{From: to, To: from}},
+		{name: "Too long range", filter: repository.ReportFilter{From: from, To: from.AddDate(2, 0, 0)}},
+		{name: "Unknown period", filter: repository.ReportFilter{From: from, To: to, Period: "year"}},
+		{name: "Type dimension", filter: repository.ReportFilter{From: from, To: to, GroupBy: []string{repository.ReportDimensionType}}},
+		{name: "Type filter", filter: repository.ReportFilter{From: from, To: to, Type: &productType}},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			s := NewService(mockRepo, &config.Config{})
+
+			_, err := s.ReceptionsReport(context.Background(), tt.filter)
+
+			assert.ErrorIs(t, err, ErrInvalidReportFilter)
+			mockRepo.AssertNotCalled(t, "ReceptionsReport", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_ProductsReport(t *testing.T) {
+	from := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	filter := repository.ReportFilter{
+		From:    from,
+		To:      from.AddDate(0, 0, 7),
+		Period:  "week",
+		GroupBy: []string{repository.ReportDimensionType, repository.ReportDimensionCity},
+	}
+	rows := []*repository.ProductReportRow{{Period: from, Products: 5}}
+	mockRepo := &MockRepository{}
+	mockRepo.On("ProductsReport", mock.Anything, filter).Return(rows, nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	result, err := s.ProductsReport(context.Background(), filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rows, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_ExportReceptions(t *testing.T) {
+	startDate := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	rows := []*repository.ReceptionExportRow{{ReceptionID: "rc1"}, {ReceptionID: "rc2"}}
+	mockRepo := &MockRepository{}
+	mockRepo.On("ExportReceptions", mock.Anything, &startDate, (*time.Time)(nil)).Return(rows, nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	var exported []string
+	err := s.ExportReceptions(context.Background(), &startDate, nil, func(row *repository.ReceptionExportRow) error {
+		exported = append(exported, row.ReceptionID)
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"rc1", "rc2"}, exported)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_ListReceptions(t *testing.T) {
+	status := "close"
+	filter := repository.ReceptionFilter{Status: &status, Page: 1, Limit: 20}
+	receptions := []*repository.Reception{{ID: "rc1", PVZID: "pvz1", Status: status}}
+	mockRepo := &MockRepository{}
+	mockRepo.On("ListReception", mock.Anything, "pvz1", filter).Return(receptions, nil)
+
+	s := NewService(mockRepo, &config.Config{})
+	result, err := s.ListReceptions(context.Background(), "pvz1", filter)
+
+	assert.NoError(t, err)
+	assert.Equal(t, receptions, result)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_ListReceptions_InvalidFilter(t *testing.T) {
+	startDate := time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC)
+	endDate := startDate.AddDate(0, 0, -1)
+	status := "unknown"
+
+	for name, filter := range map[string]repository.ReceptionFilter{
+		"Start after end": {From: &startDate, To: &endDate, Page: 1, Limit: 20},
+		"Unknown status":  {Status: &status, Page: 1, Limit: 20},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			s := NewService(mockRepo, &config.Config{})
+			_, err := s.ListReceptions(context.Background(), "pvz1", filter)
+
+			assert.ErrorIs(t, err, ErrInvalidReceptionFilter)
+			mockRepo.AssertNotCalled(t, "ListReception", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_GetReception(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		rc := &repository.Reception{ID: "rc1", PVZID: "pvz1", Status: "close"}
+		products := []*repository.Product{{ID: "p1", ReceptionId: "rc1"}}
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(rc, nil)
+		mockRepo.On("ListProducts", mock.Anything).Return(products, nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		result, err := s.GetReception(context.Background(), "rc1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, &repository.ReceptionWithProducts{Reception: rc, Products: products}, result)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(nil, repository.ErrReceptionNotFound)
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.GetReception(context.Background(), "rc1")
+
+		assert.ErrorIs(t, err, ErrReceptionNotFound)
+		mockRepo.AssertNotCalled(t, "ListProducts", mock.Anything)
+	})
+}
+
+func TestService_ReopenReception(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		rc := &repository.Reception{ID: "rc1", PVZID: "pvz1", Status: string(Reopened)}
+		mockRepo := &MockRepository{}
+		mockRepo.On("ReopenReception", mock.Anything, "rc1", "ошибка сотрудника", "moderator1").Return(rc, nil)
+		expectAudit(mockRepo, auditActionReopen, auditEntityReception, "rc1")
+
+		s := NewService(mockRepo, &config.Config{})
+		result, err := s.ReopenReception(context.Background(), "rc1", "  ошибка сотрудника ", "moderator1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, rc, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Empty reason", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.ReopenReception(context.Background(), "rc1", " ", "moderator1")
+
+		assert.ErrorIs(t, err, ErrInvalidCorrection)
+		mockRepo.AssertNotCalled(t, "ReopenReception", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	for name, tc := range map[string]struct {
+		repoErr error
+		want    error
+	}{
+		"Newer reception exists": {repoErr: repository.ErrNewerReceptionExists, want: ErrNewerReceptionExists},
+		"Reception not closed":   {repoErr: repository.ErrReceptionNotClosed, want: ErrReceptionNotClosed},
+		"Reception not found":    {repoErr: repository.ErrReceptionNotFound, want: ErrReceptionNotFound},
+		"Inactive pvz":           {repoErr: repository.ErrPVZInactive, want: ErrPVZInactive},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+			mockRepo.On("ReopenReception", mock.Anything, "rc1", "ошибка сотрудника", "moderator1").
+				Return(nil, fmt.Errorf("error reopening reception: %w", tc.repoErr))
+
+			s := NewService(mockRepo, &config.Config{})
+			_, err := s.ReopenReception(context.Background(), "rc1", "ошибка сотрудника", "moderator1")
+
+			assert.ErrorIs(t, err, tc.want)
+			mockRepo.AssertNotCalled(t, "CreateAuditRecord", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_AmendReception(t *testing.T) {
+	newProduct := repository.NewProduct{Type: "electronics"}
+
+	t.Run("Success", func(t *testing.T) {
+		correction := &repository.ReceptionCorrection{ID: "c1", ReceptionID: "rc1", Action: repository.CorrectionActionAmend}
+		mockRepo := &MockRepository{}
+		mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("electronics"), nil)
+		mockRepo.On("AmendReception", mock.Anything, "rc1", repository.ReceptionAmendment{
+			AddProducts:      []repository.NewProduct{newProduct},
+			RemoveProductIDs: []string{"p1"},
+			Reason:           "пересорт",
+			AmendedBy:        "moderator1",
+		}).Return(correction, nil)
+		expectAudit(mockRepo, auditActionAmend, auditEntityReception, "rc1")
+
+		s := NewService(mockRepo, &config.Config{})
+		result, err := s.AmendReception(context.Background(), "rc1", repository.ReceptionAmendment{
+			AddProducts:      []repository.NewProduct{newProduct},
+			RemoveProductIDs: []string{"p1"},
+			Reason:           "пересорт",
+		}, "moderator1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, correction, result)
+		mockRepo.AssertExpectations(t)
+	})
+
+	for name, amendment := range map[string]repository.ReceptionAmendment{
+		"Nothing to change": {Reason: "пересорт"},
+		"Without reason":    {RemoveProductIDs: []string{"p1"}},
+		"Duplicate removal": {RemoveProductIDs: []string{"p1", "p1"}, Reason: "пересорт"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &MockRepository{}
+
+			s := NewService(mockRepo, &config.Config{})
+			_, err := s.AmendReception(context.Background(), "rc1", amendment, "moderator1")
+
+			assert.ErrorIs(t, err, ErrInvalidCorrection)
+			mockRepo.AssertNotCalled(t, "AmendReception", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("Unknown product type", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("ListProductTypes", mock.Anything).Return(dictionaryEntries("clothing"), nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.AmendReception(context.Background(), "rc1", repository.ReceptionAmendment{
+			AddProducts: []repository.NewProduct{newProduct},
+			Reason:      "пересорт",
+		}, "moderator1")
+
+		assert.ErrorIs(t, err, ErrInvalidProductType)
+	})
+
+	t.Run("Product already issued", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("AmendReception", mock.Anything, "rc1", mock.Anything).
+			Return(nil, fmt.Errorf("error amending reception: %w", repository.ErrProductNotAmendable))
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.AmendReception(context.Background(), "rc1", repository.ReceptionAmendment{
+			RemoveProductIDs: []string{"p1"},
+			Reason:           "пересорт",
+		}, "moderator1")
+
+		assert.ErrorIs(t, err, ErrProductNotAmendable)
+	})
+}
+
+func TestReconcileOnAmend(t *testing.T) {
+	productType := "обувь"
+	manifest := &repository.ReceptionManifest{
+		ReceptionID:          "rc1",
+		BlockOnDiscrepancies: true,
+		Items:                []*repository.ManifestItem{{Type: &productType, Quantity: 2}},
+	}
+
+	reconciliation, err := reconcileOnAmend(manifest, []*repository.Product{{ID: "p1", Type: productType}}, nil)
+
+	assert.NoError(t, err)
+	assert.True(t, reconciliation.HasDiscrepancies)
+	assert.Nil(t, reconciliation.AcknowledgedBy)
+}
+
+func TestService_ListReceptionCorrections(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		corrections := []*repository.ReceptionCorrection{{ID: "c1", ReceptionID: "rc1", Action: repository.CorrectionActionReopen}}
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(&repository.Reception{ID: "rc1", Status: string(Amended)}, nil)
+		mockRepo.On("ListReceptionCorrections", mock.Anything, "rc1").Return(corrections, nil)
+
+		s := NewService(mockRepo, &config.Config{})
+		result, err := s.ListReceptionCorrections(context.Background(), "rc1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, corrections, result)
+	})
+
+	t.Run("Reception not found", func(t *testing.T) {
+		mockRepo := &MockRepository{}
+		mockRepo.On("GetReception", mock.Anything, "rc1").Return(nil, repository.ErrReceptionNotFound)
+
+		s := NewService(mockRepo, &config.Config{})
+		_, err := s.ListReceptionCorrections(context.Background(), "rc1")
+
+		assert.ErrorIs(t, err, ErrReceptionNotFound)
+	})
+}
@@ -1,30 +1,77 @@
 package utils
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
-var jwtSecret []byte
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	refreshTokenBytes = 32
+
+	DummyUserID = "dummy_id"
+)
+
+var (
+	jwtSecret       []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+)
 
 func init() {
 	jwtSecret = []byte(os.Getenv("JWT_SECRET"))
 	if len(jwtSecret) == 0 {
 		jwtSecret = []byte("default-secret-key")
 	}
+
+	accessTokenTTL = durationFromEnv("JWT_TTL", defaultAccessTokenTTL)
+	refreshTokenTTL = durationFromEnv("JWT_REFRESH_TTL", defaultRefreshTokenTTL)
+}
+
+func durationFromEnv(key string, fallback time.Duration) time.Duration {
+	value, err := time.ParseDuration(os.Getenv(key))
+	if err != nil || value <= 0 {
+		return fallback
+	}
+	return value
 }
 
 func GenerateJWT(userID string, email string, role string) (string, error) {
-	claims := jwt.MapClaims{
+	return signJWT(accessTokenClaims(userID, email, role))
+}
+
+// GenerateDummyJWT выпускает тестовый токен без пользователя. Если передан pvzID, тестовый
+// сотрудник считается закрепленным за этим ПВЗ
+func GenerateDummyJWT(role string, pvzID string) (string, error) {
+	claims := accessTokenClaims(DummyUserID, "dummy_email", role)
+	if pvzID != "" {
+		claims["pvz_id"] = pvzID
+	}
+	return signJWT(claims)
+}
+
+func accessTokenClaims(userID string, email string, role string) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"jti":     uuid.New().String(),
 		"user_id": userID,
 		"email":   email,
 		"role":    role,
-		"exp":     time.Now().Add(time.Hour * 72).Unix(),
+		"iat":     now.Unix(),
+		"exp":     now.Add(accessTokenTTL).Unix(),
 	}
+}
 
+func signJWT(claims jwt.MapClaims) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	return token.SignedString(jwtSecret)
@@ -45,3 +92,18 @@ func ParseJWT(tokenString string) (jwt.MapClaims, error) {
 
 	return nil, errors.New("invalid token")
 }
+
+// GenerateRefreshToken возвращает непрозрачный refresh токен и время его истечения
+func GenerateRefreshToken() (string, time.Time, error) {
+	b := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", time.Time{}, err
+	}
+	return hex.EncodeToString(b), time.Now().Add(refreshTokenTTL), nil
+}
+
+// HashToken возвращает хеш refresh токена, который хранится в базе вместо самого токена
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
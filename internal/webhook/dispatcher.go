@@ -0,0 +1,220 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/DarRo9/pvz_service/config"
+	"github.com/DarRo9/pvz_service/internal/metrics"
+	"github.com/DarRo9/pvz_service/internal/repository"
+)
+
+const (
+	defaultPollInterval = 5 * time.Second
+	defaultTimeout      = 10 * time.Second
+	defaultBatchSize    = 50
+	defaultMaxAttempts  = 10
+	defaultBaseBackoff  = 30 * time.Second
+	defaultMaxBackoff   = time.Hour
+
+	secretBytes = 32
+
+	EventIDHeader   = "X-Webhook-Id"
+	EventTypeHeader = "X-Webhook-Event"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+type Repository interface {
+	FanOutOutboxEvents(ctx context.Context, now time.Time) (int64, error)
+	ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*repository.WebhookDelivery, error)
+	MarkWebhookDelivered(ctx context.Context, id string, deliveredAt time.Time) error
+	RetryWebhookDelivery(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error
+	DeadLetterWebhookDelivery(ctx context.Context, id string, lastError string) error
+}
+
+// Dispatcher разбирает outbox и доставляет события подписчикам подписанными POST запросами.
+// Неудачные доставки повторяются с экспоненциальной задержкой, после MaxAttempts попыток
+// доставка переводится в dead letter.
+type Dispatcher struct {
+	repo   Repository
+	client *http.Client
+	cfg    config.WebhookConfig
+	now    func() time.Time
+}
+
+func NewDispatcher(repo Repository, cfg config.WebhookConfig) *Dispatcher {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = defaultPollInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = defaultBatchSize
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = defaultMaxAttempts
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = defaultBaseBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = defaultMaxBackoff
+	}
+
+	return &Dispatcher{
+		repo:   repo,
+		client: &http.Client{Timeout: cfg.Timeout},
+		cfg:    cfg,
+		now:    time.Now,
+	}
+}
+
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DispatchOnce(ctx); err != nil {
+			slog.ErrorContext(ctx, "Error dispatching webhooks", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "Webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (d *Dispatcher) DispatchOnce(ctx context.Context) error {
+	now := d.now()
+	if _, err := d.repo.FanOutOutboxEvents(ctx, now); err != nil {
+		return err
+	}
+
+	// Доставки отправляются параллельно, поэтому двух таймаутов достаточно, чтобы успеть до повторного захвата
+	deliveries, err := d.repo.ClaimWebhookDeliveries(ctx, now, now.Add(2*d.cfg.Timeout), d.cfg.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *repository.WebhookDelivery) {
+			defer wg.Done()
+			d.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	return nil
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, delivery *repository.WebhookDelivery) {
+	sendErr := d.send(ctx, delivery)
+	if sendErr == nil {
+		metrics.WebhookDeliveriesTotal.WithLabelValues("delivered").Inc()
+		if err := d.repo.MarkWebhookDelivered(ctx, delivery.ID, d.now()); err != nil {
+			slog.ErrorContext(ctx, "Error marking webhook delivered", "delivery_id", delivery.ID, "error", err)
+		}
+		return
+	}
+
+	attempts := delivery.Attempts + 1
+	if attempts >= d.cfg.MaxAttempts {
+		slog.WarnContext(ctx, "Webhook moved to dead letter", "delivery_id", delivery.ID, "attempts", attempts, "error", sendErr)
+		metrics.WebhookDeliveriesTotal.WithLabelValues("dead").Inc()
+		if err := d.repo.DeadLetterWebhookDelivery(ctx, delivery.ID, sendErr.Error()); err != nil {
+			slog.ErrorContext(ctx, "Error moving webhook to dead letter", "delivery_id", delivery.ID, "error", err)
+		}
+		return
+	}
+
+	metrics.WebhookDeliveriesTotal.WithLabelValues("retry").Inc()
+	nextAttemptAt := d.now().Add(d.backoff(attempts))
+	if err := d.repo.RetryWebhookDelivery(ctx, delivery.ID, sendErr.Error(), nextAttemptAt); err != nil {
+		slog.ErrorContext(ctx, "Error scheduling webhook retry", "delivery_id", delivery.ID, "error", err)
+	}
+}
+
+func (d *Dispatcher) send(ctx context.Context, delivery *repository.WebhookDelivery) error {
+	body, err := json.Marshal(struct {
+		ID   string          `json:"id"`
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}{
+		ID:   delivery.EventID,
+		Type: delivery.EventType,
+		Data: delivery.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling webhook body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating webhook request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventIDHeader, delivery.EventID)
+	req.Header.Set(EventTypeHeader, delivery.EventType)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, "sha256="+Sign(delivery.Secret, timestamp, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected webhook response status: %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) backoff(attempt int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempt; i++ {
+		delay *= 2
+		if delay >= d.cfg.MaxBackoff {
+			return d.cfg.MaxBackoff
+		}
+	}
+	return delay
+}
+
+// Sign возвращает HMAC-SHA256 подпись тела запроса, по которой подписчик проверяет подлинность вебхука
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func GenerateSecret() (string, error) {
+	b := make([]byte, secretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,146 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/DarRo9/pvz_service/config"
+	"github.com/DarRo9/pvz_service/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) FanOutOutboxEvents(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ClaimWebhookDeliveries(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*repository.WebhookDelivery, error) {
+	args := m.Called(ctx, now, leaseUntil, limit)
+	return args.Get(0).([]*repository.WebhookDelivery), args.Error(1)
+}
+
+func (m *MockRepository) MarkWebhookDelivered(ctx context.Context, id string, deliveredAt time.Time) error {
+	args := m.Called(ctx, id, deliveredAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RetryWebhookDelivery(ctx context.Context, id string, lastError string, nextAttemptAt time.Time) error {
+	args := m.Called(ctx, id, lastError, nextAttemptAt)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeadLetterWebhookDelivery(ctx context.Context, id string, lastError string) error {
+	args := m.Called(ctx, id, lastError)
+	return args.Error(0)
+}
+
+func TestDispatcher_DispatchOnce(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := config.WebhookConfig{
+		Timeout:     time.Second,
+		BatchSize:   10,
+		MaxAttempts: 3,
+		BaseBackoff: time.Minute,
+		MaxBackoff:  time.Hour,
+	}
+
+	tests := []struct {
+		name       string
+		statusCode int
+		attempts   int
+		mockSetup  func(*MockRepository)
+	}{
+		{
+			name:       "delivered",
+			statusCode: http.StatusOK,
+			mockSetup: func(m *MockRepository) {
+				m.On("MarkWebhookDelivered", mock.Anything, "d1", now).Return(nil)
+			},
+		},
+		{
+			name:       "retried with backoff",
+			statusCode: http.StatusInternalServerError,
+			attempts:   1,
+			mockSetup: func(m *MockRepository) {
+				m.On("RetryWebhookDelivery", mock.Anything, "d1", "unexpected webhook response status: 500", now.Add(2*time.Minute)).Return(nil)
+			},
+		},
+		{
+			name:       "dead letter after max attempts",
+			statusCode: http.StatusInternalServerError,
+			attempts:   2,
+			mockSetup: func(m *MockRepository) {
+				m.On("DeadLetterWebhookDelivery", mock.Anything, "d1", "unexpected webhook response status: 500").Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received *http.Request
+			var receivedBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				received = r
+				receivedBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			delivery := &repository.WebhookDelivery{
+				ID:        "d1",
+				EventID:   "e1",
+				Attempts:  tt.attempts,
+				EventType: repository.EventPVZCreated,
+				Payload:   []byte(`{"id":"pvz1"}`),
+				URL:       server.URL,
+				Secret:    "secret",
+			}
+
+			mockRepo := new(MockRepository)
+			mockRepo.On("FanOutOutboxEvents", mock.Anything, now).Return(int64(1), nil)
+			mockRepo.On("ClaimWebhookDeliveries", mock.Anything, now, now.Add(2*time.Second), 10).
+				Return([]*repository.WebhookDelivery{delivery}, nil)
+			tt.mockSetup(mockRepo)
+
+			dispatcher := NewDispatcher(mockRepo, cfg)
+			dispatcher.now = func() time.Time { return now }
+
+			err := dispatcher.DispatchOnce(context.Background())
+			assert.NoError(t, err)
+
+			assert.Equal(t, "e1", received.Header.Get(EventIDHeader))
+			assert.Equal(t, repository.EventPVZCreated, received.Header.Get(EventTypeHeader))
+			timestamp := received.Header.Get(TimestampHeader)
+			assert.Equal(t, "sha256="+Sign("secret", timestamp, receivedBody), received.Header.Get(SignatureHeader))
+
+			var body map[string]json.RawMessage
+			assert.NoError(t, json.Unmarshal(receivedBody, &body))
+			assert.JSONEq(t, `{"id":"pvz1"}`, string(body["data"]))
+
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDispatcher_Backoff(t *testing.T) {
+	dispatcher := NewDispatcher(nil, config.WebhookConfig{
+		BaseBackoff: time.Minute,
+		MaxBackoff:  5 * time.Minute,
+	})
+
+	assert.Equal(t, time.Minute, dispatcher.backoff(1))
+	assert.Equal(t, 2*time.Minute, dispatcher.backoff(2))
+	assert.Equal(t, 4*time.Minute, dispatcher.backoff(3))
+	assert.Equal(t, 5*time.Minute, dispatcher.backoff(4))
+	assert.Equal(t, 5*time.Minute, dispatcher.backoff(10))
+}
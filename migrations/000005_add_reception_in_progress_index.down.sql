@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS reception_pvz_execution_date_idx;
+
+DROP INDEX IF EXISTS reception_pvz_in_progress_idx;
\ No newline at end of file
@@ -0,0 +1,3 @@
+CREATE UNIQUE INDEX reception_pvz_in_progress_idx ON reception (pvz_id) WHERE status = 'in_progress';
+
+CREATE INDEX reception_pvz_execution_date_idx ON reception (pvz_id, execution_date DESC);
\ No newline at end of file
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS product_status_history;
+
+ALTER TABLE product DROP COLUMN IF EXISTS status;
\ No newline at end of file
@@ -0,0 +1,17 @@
+ALTER TABLE product ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'accepted';
+
+UPDATE product p
+SET status = 'stored'
+FROM reception r
+WHERE p.reception_id = r.id AND r.status = 'close';
+
+CREATE TABLE product_status_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES product(id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    changed_by VARCHAR(64),
+    changed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX product_status_history_product_id_idx ON product_status_history (product_id, changed_at);
\ No newline at end of file
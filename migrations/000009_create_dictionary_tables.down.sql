@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS product_types;
+
+DROP TABLE IF EXISTS cities;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE pvz DROP COLUMN IF EXISTS deactivated_at;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS status;
\ No newline at end of file
@@ -0,0 +1,3 @@
+ALTER TABLE pvz ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'inactive'));
+
+ALTER TABLE pvz ADD COLUMN deactivated_at TIMESTAMP WITH TIME ZONE;
\ No newline at end of file
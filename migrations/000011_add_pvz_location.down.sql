@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS pvz_coordinates_idx;
+
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_coordinates_check;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS opening_hours;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS longitude;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS latitude;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS postal_code;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS house;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS street;
\ No newline at end of file
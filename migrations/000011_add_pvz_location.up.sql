@@ -0,0 +1,15 @@
+ALTER TABLE pvz ADD COLUMN street VARCHAR(255);
+
+ALTER TABLE pvz ADD COLUMN house VARCHAR(50);
+
+ALTER TABLE pvz ADD COLUMN postal_code VARCHAR(20);
+
+ALTER TABLE pvz ADD COLUMN latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90);
+
+ALTER TABLE pvz ADD COLUMN longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180);
+
+ALTER TABLE pvz ADD COLUMN opening_hours VARCHAR(255);
+
+ALTER TABLE pvz ADD CONSTRAINT pvz_coordinates_check CHECK ((latitude IS NULL) = (longitude IS NULL));
+
+CREATE INDEX pvz_coordinates_idx ON pvz (latitude, longitude) WHERE latitude IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pvz_employees;
\ No newline at end of file
@@ -0,0 +1,8 @@
+CREATE TABLE pvz_employees (
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pvz_id, user_id)
+);
+
+CREATE INDEX pvz_employees_user_id_idx ON pvz_employees (user_id);
\ No newline at end of file
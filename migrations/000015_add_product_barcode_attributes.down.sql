@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS product_barcode_idx;
+
+DROP INDEX IF EXISTS product_reception_barcode_idx;
+
+ALTER TABLE product DROP COLUMN IF EXISTS attributes;
+
+ALTER TABLE product DROP COLUMN IF EXISTS height_mm;
+
+ALTER TABLE product DROP COLUMN IF EXISTS width_mm;
+
+ALTER TABLE product DROP COLUMN IF EXISTS length_mm;
+
+ALTER TABLE product DROP COLUMN IF EXISTS weight_grams;
+
+ALTER TABLE product DROP COLUMN IF EXISTS barcode;
\ No newline at end of file
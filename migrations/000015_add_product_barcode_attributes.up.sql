@@ -0,0 +1,15 @@
+ALTER TABLE product ADD COLUMN barcode VARCHAR(64);
+
+ALTER TABLE product ADD COLUMN weight_grams INTEGER CHECK (weight_grams > 0);
+
+ALTER TABLE product ADD COLUMN length_mm INTEGER CHECK (length_mm > 0);
+
+ALTER TABLE product ADD COLUMN width_mm INTEGER CHECK (width_mm > 0);
+
+ALTER TABLE product ADD COLUMN height_mm INTEGER CHECK (height_mm > 0);
+
+ALTER TABLE product ADD COLUMN attributes JSONB NOT NULL DEFAULT '{}';
+
+CREATE UNIQUE INDEX product_reception_barcode_idx ON product (reception_id, barcode);
+
+CREATE INDEX product_barcode_idx ON product (barcode) WHERE barcode IS NOT NULL;
\ No newline at end of file
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS reception_reconciliation;
+
+DROP TABLE IF EXISTS reception_manifest_item;
+
+DROP TABLE IF EXISTS reception_manifest;
\ No newline at end of file
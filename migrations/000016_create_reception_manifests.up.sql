@@ -0,0 +1,25 @@
+CREATE TABLE reception_manifest (
+    reception_id UUID PRIMARY KEY REFERENCES reception(id) ON DELETE CASCADE,
+    block_on_discrepancies BOOLEAN NOT NULL DEFAULT FALSE,
+    uploaded_by VARCHAR(64),
+    uploaded_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE reception_manifest_item (
+    reception_id UUID NOT NULL REFERENCES reception_manifest(reception_id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    barcode VARCHAR(64),
+    type VARCHAR(50),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (reception_id, position),
+    CHECK (barcode IS NOT NULL OR type IS NOT NULL)
+);
+
+CREATE TABLE reception_reconciliation (
+    reception_id UUID PRIMARY KEY REFERENCES reception(id) ON DELETE CASCADE,
+    report JSONB NOT NULL,
+    has_discrepancies BOOLEAN NOT NULL,
+    acknowledged_by VARCHAR(64),
+    acknowledged_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
\ No newline at end of file
@@ -0,0 +1,13 @@
+DROP TABLE IF EXISTS reception_correction;
+
+DROP INDEX IF EXISTS reception_pvz_in_progress_idx;
+
+UPDATE reception SET status = 'close' WHERE status = 'amended';
+
+UPDATE reception SET status = 'in_progress' WHERE status = 'reopened';
+
+CREATE UNIQUE INDEX reception_pvz_in_progress_idx ON reception (pvz_id) WHERE status = 'in_progress';
+
+ALTER TABLE reception DROP CONSTRAINT IF EXISTS reception_status_check;
+
+ALTER TABLE reception ADD CONSTRAINT reception_status_check CHECK (status IN ('in_progress', 'close'));
\ No newline at end of file
@@ -0,0 +1,19 @@
+ALTER TABLE reception DROP CONSTRAINT IF EXISTS reception_status_check;
+
+ALTER TABLE reception ADD CONSTRAINT reception_status_check CHECK (status IN ('in_progress', 'close', 'reopened', 'amended'));
+
+DROP INDEX IF EXISTS reception_pvz_in_progress_idx;
+
+CREATE UNIQUE INDEX reception_pvz_in_progress_idx ON reception (pvz_id) WHERE status IN ('in_progress', 'reopened');
+
+CREATE TABLE reception_correction (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    reception_id UUID NOT NULL REFERENCES reception(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL CHECK (action IN ('reopen', 'amend')),
+    reason TEXT NOT NULL,
+    changed_by VARCHAR(64),
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX reception_correction_reception_id_idx ON reception_correction (reception_id, created_at);
\ No newline at end of file
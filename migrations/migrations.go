@@ -0,0 +1,8 @@
+package migrations
+
+import "embed"
+
+// FS содержит SQL миграции, встроенные в бинарник сервиса
+//
+//go:embed *.sql
+var FS embed.FS